@@ -3,6 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -20,17 +23,147 @@ type ServerConfig struct {
 	HTTP_Port string
 	GRPC_Port string
 	Host      string
+	// TLS applies to the gRPC listener.
+	TLS TLSConfig
+}
+
+// TLSConfig configures TLS on the gRPC listener. The files are reloaded when
+// they change.
+type TLSConfig struct {
+	Enabled  bool
+	CertFile string
+	KeyFile  string
+	// ClientCAFile turns on mutual TLS: callers must present a certificate
+	// signed by one of its CAs.
+	ClientCAFile string
+	// AllowedSANs restricts mutual TLS callers to certificates with one of
+	// these DNS, URI or IP SANs; empty allows any.
+	AllowedSANs []string
 }
 
 type AppConfig struct {
 	Environment      string
 	EventServiceAddr string
+	EventClient      EventClientConfig
+	EventClientTLS   ClientTLSConfig
+}
+
+// ClientTLSConfig configures TLS on calls to another service. The files are
+// reloaded when they change.
+type ClientTLSConfig struct {
+	Enabled bool
+	// CAFile verifies the server's certificate; the system roots are used
+	// when empty.
+	CAFile string
+	// CertFile and KeyFile are presented when the server asks for a client
+	// certificate.
+	CertFile string
+	KeyFile  string
+	// ServerName, when set, is checked against the server's certificate
+	// instead of the dialed host.
+	ServerName string
+}
+
+// EventClientConfig tunes calls to event-service.
+type EventClientConfig struct {
+	// Timeout bounds each attempt; MethodTimeouts overrides it per RPC name,
+	// e.g. "ReserveSeats".
+	Timeout        time.Duration
+	MethodTimeouts map[string]time.Duration
+	// MaxAttempts counts the first try; 1 disables retries.
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// BreakerThreshold consecutive failures open the circuit breaker for
+	// BreakerCooldown; 0 disables it.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// TokenFile holds the bearer token booking-service presents to
+	// event-service.
+	TokenFile string
+}
+
+// AuthConfig configures JWT authentication of incoming calls.
+type AuthConfig struct {
+	Enabled bool
+	// HMACSecretFile and JWKSFile hold the keys tokens are verified with;
+	// at least one is required when Enabled.
+	HMACSecretFile string
+	JWKSFile       string
+	Issuer         string
+	Audience       string
+	Leeway         time.Duration
+}
+
+type BookingConfig struct {
+	HoldTTL         time.Duration
+	ReaperInterval  time.Duration
+	ReaperBatchSize int
+
+	IdempotencyKeyTTL          time.Duration
+	IdempotencyCleanupInterval time.Duration
+}
+
+type OutboxConfig struct {
+	// Publisher is one of "stdout", "file" or "inprocess".
+	Publisher     string
+	FilePath      string
+	RelayInterval time.Duration
+	BatchSize     int
+}
+
+// HealthConfig tunes the readiness checks.
+type HealthConfig struct {
+	// CheckTimeout bounds each run of the checks; CheckInterval is how often
+	// the gRPC health service is brought up to date.
+	CheckTimeout  time.Duration
+	CheckInterval time.Duration
+	// DrainDelay is how long to keep serving after readiness turns off on
+	// shutdown, so load balancers notice first.
+	DrainDelay time.Duration
+}
+
+// QueueConfig sets up the waiting room passes. Without a secret file each
+// instance signs with a random key and only accepts its own passes.
+type QueueConfig struct {
+	PassSecretFile string
+	PassTTL        time.Duration
+	// PruneInterval is how often tickets with lapsed passes are deleted.
+	PruneInterval time.Duration
+}
+
+// RateLimitConfig limits CreateBooking calls with token buckets, refilled at
+// the rate per second up to the burst. A zero rate turns a limit off.
+type RateLimitConfig struct {
+	UserRate  float64
+	UserBurst int
+	IPRate    float64
+	IPBurst   int
+}
+
+// TracingConfig selects where OpenTelemetry spans are exported.
+type TracingConfig struct {
+	// Exporter is one of "none", "stdout", "file" or "otlp".
+	Exporter     string
+	FilePath     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+	// SampleRatio is the share of new traces recorded; calls that arrive
+	// with a trace context follow the caller's decision.
+	SampleRatio float64
 }
 
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	App      AppConfig
+	Database  DatabaseConfig
+	Server    ServerConfig
+	App       AppConfig
+	Auth      AuthConfig
+	Booking   BookingConfig
+	Outbox    OutboxConfig
+	Tracing   TracingConfig
+	Health    HealthConfig
+	RateLimit RateLimitConfig
+	Queue     QueueConfig
 }
 
 func Load() (*Config, error) {
@@ -51,10 +184,79 @@ func Load() (*Config, error) {
 			HTTP_Port: getEnv("HTTP_PORT", "8081"),
 			GRPC_Port: getEnv("GRPC_PORT", "9091"),
 			Host:      getEnv("SERVER_HOST", "0.0.0.0"),
+			TLS: TLSConfig{
+				Enabled:      getEnvBool("GRPC_TLS_ENABLED", false),
+				CertFile:     getEnv("GRPC_TLS_CERT_FILE", ""),
+				KeyFile:      getEnv("GRPC_TLS_KEY_FILE", ""),
+				ClientCAFile: getEnv("GRPC_TLS_CLIENT_CA_FILE", ""),
+				AllowedSANs:  getEnvList("GRPC_TLS_ALLOWED_SANS"),
+			},
 		},
 		App: AppConfig{
 			Environment:      getEnv("APP_ENV", "development"),
 			EventServiceAddr: getEnv("EVENT_SERVICE_ADDR", "localhost:9091"),
+			EventClient: EventClientConfig{
+				Timeout:          getEnvDuration("EVENT_CLIENT_TIMEOUT", 2*time.Second),
+				MethodTimeouts:   getEnvDurationMap("EVENT_CLIENT_METHOD_TIMEOUTS"),
+				MaxAttempts:      getEnvInt("EVENT_CLIENT_MAX_ATTEMPTS", 3),
+				BackoffBase:      getEnvDuration("EVENT_CLIENT_BACKOFF_BASE", 100*time.Millisecond),
+				BackoffMax:       getEnvDuration("EVENT_CLIENT_BACKOFF_MAX", 2*time.Second),
+				BreakerThreshold: getEnvInt("EVENT_CLIENT_BREAKER_THRESHOLD", 5),
+				BreakerCooldown:  getEnvDuration("EVENT_CLIENT_BREAKER_COOLDOWN", 30*time.Second),
+				TokenFile:        getEnv("EVENT_CLIENT_TOKEN_FILE", ""),
+			},
+			EventClientTLS: ClientTLSConfig{
+				Enabled:    getEnvBool("EVENT_CLIENT_TLS_ENABLED", false),
+				CAFile:     getEnv("EVENT_CLIENT_TLS_CA_FILE", ""),
+				CertFile:   getEnv("EVENT_CLIENT_TLS_CERT_FILE", ""),
+				KeyFile:    getEnv("EVENT_CLIENT_TLS_KEY_FILE", ""),
+				ServerName: getEnv("EVENT_CLIENT_TLS_SERVER_NAME", ""),
+			},
+		},
+		Auth: AuthConfig{
+			Enabled:        getEnvBool("AUTH_ENABLED", true),
+			HMACSecretFile: getEnv("AUTH_HMAC_SECRET_FILE", ""),
+			JWKSFile:       getEnv("AUTH_JWKS_FILE", ""),
+			Issuer:         getEnv("AUTH_ISSUER", ""),
+			Audience:       getEnv("AUTH_AUDIENCE", ""),
+			Leeway:         getEnvDuration("AUTH_LEEWAY", 30*time.Second),
+		},
+		Booking: BookingConfig{
+			HoldTTL:         getEnvDuration("BOOKING_HOLD_TTL", 15*time.Minute),
+			ReaperInterval:  getEnvDuration("HOLD_REAPER_INTERVAL", 30*time.Second),
+			ReaperBatchSize: getEnvInt("HOLD_REAPER_BATCH_SIZE", 100),
+
+			IdempotencyKeyTTL:          getEnvDuration("IDEMPOTENCY_KEY_TTL", 24*time.Hour),
+			IdempotencyCleanupInterval: getEnvDuration("IDEMPOTENCY_CLEANUP_INTERVAL", 10*time.Minute),
+		},
+		Outbox: OutboxConfig{
+			Publisher:     getEnv("OUTBOX_PUBLISHER", "stdout"),
+			FilePath:      getEnv("OUTBOX_FILE_PATH", "outbox.jsonl"),
+			RelayInterval: getEnvDuration("OUTBOX_RELAY_INTERVAL", time.Second),
+			BatchSize:     getEnvInt("OUTBOX_BATCH_SIZE", 100),
+		},
+		Tracing: TracingConfig{
+			Exporter:     getEnv("TRACING_EXPORTER", "none"),
+			FilePath:     getEnv("TRACING_FILE_PATH", "traces.jsonl"),
+			OTLPEndpoint: getEnv("TRACING_OTLP_ENDPOINT", "localhost:4317"),
+			OTLPInsecure: getEnvBool("TRACING_OTLP_INSECURE", false),
+			SampleRatio:  getEnvFloat("TRACING_SAMPLE_RATIO", 1.0),
+		},
+		Health: HealthConfig{
+			CheckTimeout:  getEnvDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+			CheckInterval: getEnvDuration("HEALTH_CHECK_INTERVAL", 5*time.Second),
+			DrainDelay:    getEnvDuration("SHUTDOWN_DRAIN_DELAY", 0),
+		},
+		RateLimit: RateLimitConfig{
+			UserRate:  getEnvFloat("RATE_LIMIT_USER_RATE", 0.5),
+			UserBurst: getEnvInt("RATE_LIMIT_USER_BURST", 5),
+			IPRate:    getEnvFloat("RATE_LIMIT_IP_RATE", 5),
+			IPBurst:   getEnvInt("RATE_LIMIT_IP_BURST", 20),
+		},
+		Queue: QueueConfig{
+			PassSecretFile: getEnv("QUEUE_PASS_SECRET_FILE", ""),
+			PassTTL:        getEnvDuration("QUEUE_PASS_TTL", 10*time.Minute),
+			PruneInterval:  getEnvDuration("QUEUE_PRUNE_INTERVAL", 10*time.Minute),
 		},
 	}
 
@@ -74,3 +276,73 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+		fmt.Printf("Invalid duration for %s, using default %s\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if n, err := strconv.Atoi(value); err == nil {
+			return n
+		}
+		fmt.Printf("Invalid integer for %s, using default %d\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+		fmt.Printf("Invalid number for %s, using default %g\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+		fmt.Printf("Invalid boolean for %s, using default %t\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+// getEnvDurationMap parses a comma-separated list of name=duration pairs,
+// skipping malformed entries.
+func getEnvDurationMap(key string) map[string]time.Duration {
+	result := make(map[string]time.Duration)
+	for _, pair := range strings.Split(os.Getenv(key), ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if !ok || err != nil {
+			fmt.Printf("Invalid entry %q in %s, ignoring it\n", pair, key)
+			continue
+		}
+		result[strings.TrimSpace(name)] = d
+	}
+	return result
+}
+
+// getEnvList parses a comma-separated list, dropping empty entries.
+func getEnvList(key string) []string {
+	var result []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -22,6 +22,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EventStatus int32
+
+const (
+	EventStatus_EVENT_STATUS_UNSPECIFIED  EventStatus = 0
+	EventStatus_EVENT_STATUS_DRAFT        EventStatus = 1
+	EventStatus_EVENT_STATUS_PUBLISHED    EventStatus = 2
+	EventStatus_EVENT_STATUS_SALES_CLOSED EventStatus = 3
+	EventStatus_EVENT_STATUS_CANCELLED    EventStatus = 4
+	EventStatus_EVENT_STATUS_COMPLETED    EventStatus = 5
+)
+
+// Enum value maps for EventStatus.
+var (
+	EventStatus_name = map[int32]string{
+		0: "EVENT_STATUS_UNSPECIFIED",
+		1: "EVENT_STATUS_DRAFT",
+		2: "EVENT_STATUS_PUBLISHED",
+		3: "EVENT_STATUS_SALES_CLOSED",
+		4: "EVENT_STATUS_CANCELLED",
+		5: "EVENT_STATUS_COMPLETED",
+	}
+	EventStatus_value = map[string]int32{
+		"EVENT_STATUS_UNSPECIFIED":  0,
+		"EVENT_STATUS_DRAFT":        1,
+		"EVENT_STATUS_PUBLISHED":    2,
+		"EVENT_STATUS_SALES_CLOSED": 3,
+		"EVENT_STATUS_CANCELLED":    4,
+		"EVENT_STATUS_COMPLETED":    5,
+	}
+)
+
+func (x EventStatus) Enum() *EventStatus {
+	p := new(EventStatus)
+	*p = x
+	return p
+}
+
+func (x EventStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventStatus) Type() protoreflect.EnumType {
+	return &file_event_event_proto_enumTypes[0]
+}
+
+func (x EventStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventStatus.Descriptor instead.
+func (EventStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{0}
+}
+
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0
+	ReservationStatus_RESERVATION_STATUS_RESERVED    ReservationStatus = 1
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 2
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_RESERVED",
+		2: "RESERVATION_STATUS_RELEASED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED": 0,
+		"RESERVATION_STATUS_RESERVED":    1,
+		"RESERVATION_STATUS_RELEASED":    2,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_event_proto_enumTypes[1].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_event_event_proto_enumTypes[1]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationStatus.Descriptor instead.
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{1}
+}
+
 type Event struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -30,8 +137,14 @@ type Event struct {
 	TotalSeats     int32                  `protobuf:"varint,4,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
 	AvailableSeats int32                  `protobuf:"varint,5,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	LayoutId       string                 `protobuf:"bytes,7,opt,name=layout_id,json=layoutId,proto3" json:"layout_id,omitempty"`
+	Status         EventStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=event.EventStatus" json:"status,omitempty"`
+	// 0 means no limit.
+	MaxTicketsPerUser int32 `protobuf:"varint,10,opt,name=max_tickets_per_user,json=maxTicketsPerUser,proto3" json:"max_tickets_per_user,omitempty"`
+	// 0 means no waiting room.
+	QueueAdmissionsPerMinute int32 `protobuf:"varint,11,opt,name=queue_admissions_per_minute,json=queueAdmissionsPerMinute,proto3" json:"queue_admissions_per_minute,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -106,6 +219,34 @@ func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Event) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+func (x *Event) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *Event) GetMaxTicketsPerUser() int32 {
+	if x != nil {
+		return x.MaxTicketsPerUser
+	}
+	return 0
+}
+
+func (x *Event) GetQueueAdmissionsPerMinute() int32 {
+	if x != nil {
+		return x.QueueAdmissionsPerMinute
+	}
+	return 0
+}
+
 type GetEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -194,28 +335,32 @@ func (x *GetEventResponse) GetEvent() *Event {
 	return nil
 }
 
-type UpdateTicketsRequest struct {
+// Only the fields booking-service reads.
+type TicketType struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SalesStart    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTicketsRequest) Reset() {
-	*x = UpdateTicketsRequest{}
+func (x *TicketType) Reset() {
+	*x = TicketType{}
 	mi := &file_event_event_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTicketsRequest) String() string {
+func (x *TicketType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTicketsRequest) ProtoMessage() {}
+func (*TicketType) ProtoMessage() {}
 
-func (x *UpdateTicketsRequest) ProtoReflect() protoreflect.Message {
+func (x *TicketType) ProtoReflect() protoreflect.Message {
 	mi := &file_event_event_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -227,48 +372,259 @@ func (x *UpdateTicketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTicketsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTicketsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TicketType.ProtoReflect.Descriptor instead.
+func (*TicketType) Descriptor() ([]byte, []int) {
 	return file_event_event_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *UpdateTicketsRequest) GetEventId() string {
+func (x *TicketType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketType) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TicketType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketType) GetSalesStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesStart
+	}
+	return nil
+}
+
+func (x *TicketType) GetSalesEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return nil
+}
+
+type ListTicketTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTypesRequest) Reset() {
+	*x = ListTicketTypesRequest{}
+	mi := &file_event_event_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTypesRequest) ProtoMessage() {}
+
+func (x *ListTicketTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketTypesRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTicketTypesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListTicketTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypes   []*TicketType          `protobuf:"bytes,1,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTypesResponse) Reset() {
+	*x = ListTicketTypesResponse{}
+	mi := &file_event_event_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTypesResponse) ProtoMessage() {}
+
+func (x *ListTicketTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketTypesResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTicketTypesResponse) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        ReservationStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=event.ReservationStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReleasedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,7,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_event_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetEventId() string {
 	if x != nil {
 		return x.EventId
 	}
 	return ""
 }
 
-func (x *UpdateTicketsRequest) GetQuantity() int32 {
+func (x *Reservation) GetQuantity() int32 {
 	if x != nil {
 		return x.Quantity
 	}
 	return 0
 }
 
-type UpdateTicketsResponse struct {
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *Reservation) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReservationItem struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	AvailableSeats int32                  `protobuf:"varint,1,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	Success        bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	TicketTypeId   string                 `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity       int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPriceMinor int64                  `protobuf:"varint,3,opt,name=unit_price_minor,json=unitPriceMinor,proto3" json:"unit_price_minor,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateTicketsResponse) Reset() {
-	*x = UpdateTicketsResponse{}
-	mi := &file_event_event_proto_msgTypes[4]
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_event_event_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTicketsResponse) String() string {
+func (x *ReservationItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTicketsResponse) ProtoMessage() {}
+func (*ReservationItem) ProtoMessage() {}
 
-func (x *UpdateTicketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_event_proto_msgTypes[4]
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,30 +635,435 @@ func (x *UpdateTicketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTicketsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTicketsResponse) Descriptor() ([]byte, []int) {
-	return file_event_event_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReservationItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReservationItem) GetUnitPriceMinor() int64 {
+	if x != nil {
+		return x.UnitPriceMinor
+	}
+	return 0
+}
+
+func (x *ReservationItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ReserveSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsRequest) Reset() {
+	*x = ReserveSeatsRequest{}
+	mi := &file_event_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateTicketsResponse) GetAvailableSeats() int32 {
+func (*ReserveSeatsRequest) ProtoMessage() {}
+
+func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReserveSeatsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReserveSeatsRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReserveSeatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reservation    *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsResponse) Reset() {
+	*x = ReserveSeatsResponse{}
+	mi := &file_event_event_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsResponse) ProtoMessage() {}
+
+func (x *ReserveSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReserveSeatsResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReserveSeatsResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+type ReleaseSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Set only for bookings made before reservations existed, which took their
+	// seats straight off the event. If nothing was ever recorded under
+	// reservation_id, the release gives these seats back once.
+	Legacy        *LegacyHold `protobuf:"bytes,2,opt,name=legacy,proto3" json:"legacy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsRequest) Reset() {
+	*x = ReleaseSeatsRequest{}
+	mi := &file_event_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsRequest) ProtoMessage() {}
+
+func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReleaseSeatsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReleaseSeatsRequest) GetLegacy() *LegacyHold {
+	if x != nil {
+		return x.Legacy
+	}
+	return nil
+}
+
+type LegacyHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegacyHold) Reset() {
+	*x = LegacyHold{}
+	mi := &file_event_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyHold) ProtoMessage() {}
+
+func (x *LegacyHold) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyHold.ProtoReflect.Descriptor instead.
+func (*LegacyHold) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LegacyHold) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *LegacyHold) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReleaseSeatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reservation    *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsResponse) Reset() {
+	*x = ReleaseSeatsResponse{}
+	mi := &file_event_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsResponse) ProtoMessage() {}
+
+func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReleaseSeatsResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReleaseSeatsResponse) GetAvailableSeats() int32 {
 	if x != nil {
 		return x.AvailableSeats
 	}
 	return 0
 }
 
-func (x *UpdateTicketsResponse) GetSuccess() bool {
+type ResizeReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeReservationRequest) Reset() {
+	*x = ResizeReservationRequest{}
+	mi := &file_event_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeReservationRequest) ProtoMessage() {}
+
+func (x *ResizeReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeReservationRequest.ProtoReflect.Descriptor instead.
+func (*ResizeReservationRequest) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ResizeReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ResizeReservationRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ResizeReservationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reservation    *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResizeReservationResponse) Reset() {
+	*x = ResizeReservationResponse{}
+	mi := &file_event_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeReservationResponse) ProtoMessage() {}
+
+func (x *ResizeReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_event_proto_msgTypes[14]
 	if x != nil {
-		return x.Success
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeReservationResponse.ProtoReflect.Descriptor instead.
+func (*ResizeReservationResponse) Descriptor() ([]byte, []int) {
+	return file_event_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResizeReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ResizeReservationResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
 }
 
 var File_event_event_proto protoreflect.FileDescriptor
 
 const file_event_event_proto_rawDesc = "" +
 	"\n" +
-	"\x11event/event.proto\x12\x05event\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x01\n" +
+	"\x11event/event.proto\x12\x05event\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa4\x03\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
@@ -312,20 +1073,86 @@ const file_event_event_proto_rawDesc = "" +
 	"totalSeats\x12'\n" +
 	"\x0favailable_seats\x18\x05 \x01(\x05R\x0eavailableSeats\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\",\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tlayout_id\x18\a \x01(\tR\blayoutId\x12*\n" +
+	"\x06status\x18\b \x01(\x0e2\x12.event.EventStatusR\x06status\x12/\n" +
+	"\x14max_tickets_per_user\x18\n" +
+	" \x01(\x05R\x11maxTicketsPerUser\x12=\n" +
+	"\x1bqueue_admissions_per_minute\x18\v \x01(\x05R\x18queueAdmissionsPerMinute\",\n" +
 	"\x0fGetEventRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\"6\n" +
 	"\x10GetEventResponse\x12\"\n" +
-	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"M\n" +
-	"\x14UpdateTicketsRequest\x12\x19\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"\xc1\x01\n" +
+	"\n" +
+	"TicketType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12;\n" +
+	"\vsales_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"salesStart\x127\n" +
+	"\tsales_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bsalesEnd\"3\n" +
+	"\x16ListTicketTypesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"O\n" +
+	"\x17ListTicketTypesResponse\x124\n" +
+	"\fticket_types\x18\x01 \x03(\v2\x11.event.TicketTypeR\vticketTypes\"\xc7\x02\n" +
+	"\vReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.event.ReservationStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreleased_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\x12\x19\n" +
+	"\bseat_ids\x18\a \x03(\tR\aseatIds\x12,\n" +
+	"\x05items\x18\b \x03(\v2\x16.event.ReservationItemR\x05items\"\x99\x01\n" +
+	"\x0fReservationItem\x12$\n" +
+	"\x0eticket_type_id\x18\x01 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12(\n" +
+	"\x10unit_price_minor\x18\x03 \x01(\x03R\x0eunitPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xbc\x01\n" +
+	"\x13ReserveSeatsRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12,\n" +
+	"\x05items\x18\x05 \x03(\v2\x16.event.ReservationItemR\x05items\"u\n" +
+	"\x14ReserveSeatsResponse\x124\n" +
+	"\vreservation\x18\x01 \x01(\v2\x12.event.ReservationR\vreservation\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\"g\n" +
+	"\x13ReleaseSeatsRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12)\n" +
+	"\x06legacy\x18\x02 \x01(\v2\x11.event.LegacyHoldR\x06legacy\"C\n" +
+	"\n" +
+	"LegacyHold\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"Z\n" +
-	"\x15UpdateTicketsResponse\x12'\n" +
-	"\x0favailable_seats\x18\x01 \x01(\x05R\x0eavailableSeats\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess2\xa0\x01\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"u\n" +
+	"\x14ReleaseSeatsResponse\x124\n" +
+	"\vreservation\x18\x01 \x01(\v2\x12.event.ReservationR\vreservation\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\"]\n" +
+	"\x18ResizeReservationRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"z\n" +
+	"\x19ResizeReservationResponse\x124\n" +
+	"\vreservation\x18\x01 \x01(\v2\x12.event.ReservationR\vreservation\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats*\xb6\x01\n" +
+	"\vEventStatus\x12\x1c\n" +
+	"\x18EVENT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EVENT_STATUS_DRAFT\x10\x01\x12\x1a\n" +
+	"\x16EVENT_STATUS_PUBLISHED\x10\x02\x12\x1d\n" +
+	"\x19EVENT_STATUS_SALES_CLOSED\x10\x03\x12\x1a\n" +
+	"\x16EVENT_STATUS_CANCELLED\x10\x04\x12\x1a\n" +
+	"\x16EVENT_STATUS_COMPLETED\x10\x05*y\n" +
+	"\x11ReservationStatus\x12\"\n" +
+	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RESERVED\x10\x01\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RELEASED\x10\x022\x87\x03\n" +
 	"\fEventService\x12;\n" +
-	"\bGetEvent\x12\x16.event.GetEventRequest\x1a\x17.event.GetEventResponse\x12S\n" +
-	"\x16UpdateAvailableTickets\x12\x1b.event.UpdateTicketsRequest\x1a\x1c.event.UpdateTicketsResponseB\tZ\a./eventb\x06proto3"
+	"\bGetEvent\x12\x16.event.GetEventRequest\x1a\x17.event.GetEventResponse\x12P\n" +
+	"\x0fListTicketTypes\x12\x1d.event.ListTicketTypesRequest\x1a\x1e.event.ListTicketTypesResponse\x12G\n" +
+	"\fReserveSeats\x12\x1a.event.ReserveSeatsRequest\x1a\x1b.event.ReserveSeatsResponse\x12G\n" +
+	"\fReleaseSeats\x12\x1a.event.ReleaseSeatsRequest\x1a\x1b.event.ReleaseSeatsResponse\x12V\n" +
+	"\x11ResizeReservation\x12\x1f.event.ResizeReservationRequest\x1a .event.ResizeReservationResponseB\tZ\a./eventb\x06proto3"
 
 var (
 	file_event_event_proto_rawDescOnce sync.Once
@@ -339,28 +1166,60 @@ func file_event_event_proto_rawDescGZIP() []byte {
 	return file_event_event_proto_rawDescData
 }
 
-var file_event_event_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_event_event_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_event_event_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_event_event_proto_goTypes = []any{
-	(*Event)(nil),                 // 0: event.Event
-	(*GetEventRequest)(nil),       // 1: event.GetEventRequest
-	(*GetEventResponse)(nil),      // 2: event.GetEventResponse
-	(*UpdateTicketsRequest)(nil),  // 3: event.UpdateTicketsRequest
-	(*UpdateTicketsResponse)(nil), // 4: event.UpdateTicketsResponse
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(EventStatus)(0),                  // 0: event.EventStatus
+	(ReservationStatus)(0),            // 1: event.ReservationStatus
+	(*Event)(nil),                     // 2: event.Event
+	(*GetEventRequest)(nil),           // 3: event.GetEventRequest
+	(*GetEventResponse)(nil),          // 4: event.GetEventResponse
+	(*TicketType)(nil),                // 5: event.TicketType
+	(*ListTicketTypesRequest)(nil),    // 6: event.ListTicketTypesRequest
+	(*ListTicketTypesResponse)(nil),   // 7: event.ListTicketTypesResponse
+	(*Reservation)(nil),               // 8: event.Reservation
+	(*ReservationItem)(nil),           // 9: event.ReservationItem
+	(*ReserveSeatsRequest)(nil),       // 10: event.ReserveSeatsRequest
+	(*ReserveSeatsResponse)(nil),      // 11: event.ReserveSeatsResponse
+	(*ReleaseSeatsRequest)(nil),       // 12: event.ReleaseSeatsRequest
+	(*LegacyHold)(nil),                // 13: event.LegacyHold
+	(*ReleaseSeatsResponse)(nil),      // 14: event.ReleaseSeatsResponse
+	(*ResizeReservationRequest)(nil),  // 15: event.ResizeReservationRequest
+	(*ResizeReservationResponse)(nil), // 16: event.ResizeReservationResponse
+	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
 }
 var file_event_event_proto_depIdxs = []int32{
-	5, // 0: event.Event.start_time:type_name -> google.protobuf.Timestamp
-	5, // 1: event.Event.created_at:type_name -> google.protobuf.Timestamp
-	0, // 2: event.GetEventResponse.event:type_name -> event.Event
-	1, // 3: event.EventService.GetEvent:input_type -> event.GetEventRequest
-	3, // 4: event.EventService.UpdateAvailableTickets:input_type -> event.UpdateTicketsRequest
-	2, // 5: event.EventService.GetEvent:output_type -> event.GetEventResponse
-	4, // 6: event.EventService.UpdateAvailableTickets:output_type -> event.UpdateTicketsResponse
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	17, // 0: event.Event.start_time:type_name -> google.protobuf.Timestamp
+	17, // 1: event.Event.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: event.Event.status:type_name -> event.EventStatus
+	2,  // 3: event.GetEventResponse.event:type_name -> event.Event
+	17, // 4: event.TicketType.sales_start:type_name -> google.protobuf.Timestamp
+	17, // 5: event.TicketType.sales_end:type_name -> google.protobuf.Timestamp
+	5,  // 6: event.ListTicketTypesResponse.ticket_types:type_name -> event.TicketType
+	1,  // 7: event.Reservation.status:type_name -> event.ReservationStatus
+	17, // 8: event.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	17, // 9: event.Reservation.released_at:type_name -> google.protobuf.Timestamp
+	9,  // 10: event.Reservation.items:type_name -> event.ReservationItem
+	9,  // 11: event.ReserveSeatsRequest.items:type_name -> event.ReservationItem
+	8,  // 12: event.ReserveSeatsResponse.reservation:type_name -> event.Reservation
+	13, // 13: event.ReleaseSeatsRequest.legacy:type_name -> event.LegacyHold
+	8,  // 14: event.ReleaseSeatsResponse.reservation:type_name -> event.Reservation
+	8,  // 15: event.ResizeReservationResponse.reservation:type_name -> event.Reservation
+	3,  // 16: event.EventService.GetEvent:input_type -> event.GetEventRequest
+	6,  // 17: event.EventService.ListTicketTypes:input_type -> event.ListTicketTypesRequest
+	10, // 18: event.EventService.ReserveSeats:input_type -> event.ReserveSeatsRequest
+	12, // 19: event.EventService.ReleaseSeats:input_type -> event.ReleaseSeatsRequest
+	15, // 20: event.EventService.ResizeReservation:input_type -> event.ResizeReservationRequest
+	4,  // 21: event.EventService.GetEvent:output_type -> event.GetEventResponse
+	7,  // 22: event.EventService.ListTicketTypes:output_type -> event.ListTicketTypesResponse
+	11, // 23: event.EventService.ReserveSeats:output_type -> event.ReserveSeatsResponse
+	14, // 24: event.EventService.ReleaseSeats:output_type -> event.ReleaseSeatsResponse
+	16, // 25: event.EventService.ResizeReservation:output_type -> event.ResizeReservationResponse
+	21, // [21:26] is the sub-list for method output_type
+	16, // [16:21] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_event_event_proto_init() }
@@ -373,13 +1232,14 @@ func file_event_event_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_event_proto_rawDesc), len(file_event_event_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_event_event_proto_goTypes,
 		DependencyIndexes: file_event_event_proto_depIdxs,
+		EnumInfos:         file_event_event_proto_enumTypes,
 		MessageInfos:      file_event_event_proto_msgTypes,
 	}.Build()
 	File_event_event_proto = out.File
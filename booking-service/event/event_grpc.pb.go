@@ -19,18 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EventService_GetEvent_FullMethodName               = "/event.EventService/GetEvent"
-	EventService_UpdateAvailableTickets_FullMethodName = "/event.EventService/UpdateAvailableTickets"
+	EventService_GetEvent_FullMethodName          = "/event.EventService/GetEvent"
+	EventService_ListTicketTypes_FullMethodName   = "/event.EventService/ListTicketTypes"
+	EventService_ReserveSeats_FullMethodName      = "/event.EventService/ReserveSeats"
+	EventService_ReleaseSeats_FullMethodName      = "/event.EventService/ReleaseSeats"
+	EventService_ResizeReservation_FullMethodName = "/event.EventService/ResizeReservation"
 )
 
 // EventServiceClient is the client API for EventService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// EventService - booking-service only needs GetEvent and UpdateAvailableTickets
+// EventService - booking-service only needs GetEvent, ListTicketTypes and the
+// reservation RPCs
 type EventServiceClient interface {
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
-	UpdateAvailableTickets(ctx context.Context, in *UpdateTicketsRequest, opts ...grpc.CallOption) (*UpdateTicketsResponse, error)
+	ListTicketTypes(ctx context.Context, in *ListTicketTypesRequest, opts ...grpc.CallOption) (*ListTicketTypesResponse, error)
+	ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error)
+	ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...grpc.CallOption) (*ReleaseSeatsResponse, error)
+	ResizeReservation(ctx context.Context, in *ResizeReservationRequest, opts ...grpc.CallOption) (*ResizeReservationResponse, error)
 }
 
 type eventServiceClient struct {
@@ -51,10 +58,40 @@ func (c *eventServiceClient) GetEvent(ctx context.Context, in *GetEventRequest,
 	return out, nil
 }
 
-func (c *eventServiceClient) UpdateAvailableTickets(ctx context.Context, in *UpdateTicketsRequest, opts ...grpc.CallOption) (*UpdateTicketsResponse, error) {
+func (c *eventServiceClient) ListTicketTypes(ctx context.Context, in *ListTicketTypesRequest, opts ...grpc.CallOption) (*ListTicketTypesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateTicketsResponse)
-	err := c.cc.Invoke(ctx, EventService_UpdateAvailableTickets_FullMethodName, in, out, cOpts...)
+	out := new(ListTicketTypesResponse)
+	err := c.cc.Invoke(ctx, EventService_ListTicketTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSeatsResponse)
+	err := c.cc.Invoke(ctx, EventService_ReserveSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...grpc.CallOption) (*ReleaseSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSeatsResponse)
+	err := c.cc.Invoke(ctx, EventService_ReleaseSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ResizeReservation(ctx context.Context, in *ResizeReservationRequest, opts ...grpc.CallOption) (*ResizeReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResizeReservationResponse)
+	err := c.cc.Invoke(ctx, EventService_ResizeReservation_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,10 +102,14 @@ func (c *eventServiceClient) UpdateAvailableTickets(ctx context.Context, in *Upd
 // All implementations must embed UnimplementedEventServiceServer
 // for forward compatibility.
 //
-// EventService - booking-service only needs GetEvent and UpdateAvailableTickets
+// EventService - booking-service only needs GetEvent, ListTicketTypes and the
+// reservation RPCs
 type EventServiceServer interface {
 	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
-	UpdateAvailableTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error)
+	ListTicketTypes(context.Context, *ListTicketTypesRequest) (*ListTicketTypesResponse, error)
+	ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error)
+	ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error)
+	ResizeReservation(context.Context, *ResizeReservationRequest) (*ResizeReservationResponse, error)
 	mustEmbedUnimplementedEventServiceServer()
 }
 
@@ -82,8 +123,17 @@ type UnimplementedEventServiceServer struct{}
 func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetEvent not implemented")
 }
-func (UnimplementedEventServiceServer) UpdateAvailableTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateAvailableTickets not implemented")
+func (UnimplementedEventServiceServer) ListTicketTypes(context.Context, *ListTicketTypesRequest) (*ListTicketTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTicketTypes not implemented")
+}
+func (UnimplementedEventServiceServer) ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveSeats not implemented")
+}
+func (UnimplementedEventServiceServer) ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseSeats not implemented")
+}
+func (UnimplementedEventServiceServer) ResizeReservation(context.Context, *ResizeReservationRequest) (*ResizeReservationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResizeReservation not implemented")
 }
 func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
 func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
@@ -124,20 +174,74 @@ func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_UpdateAvailableTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTicketsRequest)
+func _EventService_ListTicketTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketTypesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).UpdateAvailableTickets(ctx, in)
+		return srv.(EventServiceServer).ListTicketTypes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_UpdateAvailableTickets_FullMethodName,
+		FullMethod: EventService_ListTicketTypes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).UpdateAvailableTickets(ctx, req.(*UpdateTicketsRequest))
+		return srv.(EventServiceServer).ListTicketTypes(ctx, req.(*ListTicketTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReserveSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReserveSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReserveSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReserveSeats(ctx, req.(*ReserveSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReleaseSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReleaseSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReleaseSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReleaseSeats(ctx, req.(*ReleaseSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ResizeReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ResizeReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ResizeReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ResizeReservation(ctx, req.(*ResizeReservationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -154,8 +258,20 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _EventService_GetEvent_Handler,
 		},
 		{
-			MethodName: "UpdateAvailableTickets",
-			Handler:    _EventService_UpdateAvailableTickets_Handler,
+			MethodName: "ListTicketTypes",
+			Handler:    _EventService_ListTicketTypes_Handler,
+		},
+		{
+			MethodName: "ReserveSeats",
+			Handler:    _EventService_ReserveSeats_Handler,
+		},
+		{
+			MethodName: "ReleaseSeats",
+			Handler:    _EventService_ReleaseSeats_Handler,
+		},
+		{
+			MethodName: "ResizeReservation",
+			Handler:    _EventService_ResizeReservation_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1,12 +1,20 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/config"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/health"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/outbox"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/tlsconfig"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/tracing"
+	"go.uber.org/zap"
 	grpclib "google.golang.org/grpc"
 )
 
@@ -16,6 +24,16 @@ type App struct {
 	grpcServer  *grpclib.Server
 	httpServer  *http.Server
 	eventClient client.EventClient
+	publisher   outbox.Publisher
+	health      *health.Checker
+
+	tlsReloaders    []*tlsconfig.Reloader
+	shutdownTracing func(context.Context) error
+
+	// Background workers are started in start() and stopped on shutdown.
+	workers     []func(ctx context.Context)
+	stopWorkers context.CancelFunc
+	workersDone sync.WaitGroup
 }
 
 func New(cfg *config.Config) *App {
@@ -23,6 +41,10 @@ func New(cfg *config.Config) *App {
 }
 
 func (a *App) Run() error {
+	if err := a.initTracing(); err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+
 	if err := a.initDB(); err != nil {
 		return fmt.Errorf("failed to init db: %w", err)
 	}
@@ -33,3 +55,20 @@ func (a *App) Run() error {
 
 	return a.start()
 }
+
+func (a *App) initTracing() error {
+	shutdown, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName:  "booking-service",
+		Exporter:     a.cfg.Tracing.Exporter,
+		FilePath:     a.cfg.Tracing.FilePath,
+		OTLPEndpoint: a.cfg.Tracing.OTLPEndpoint,
+		OTLPInsecure: a.cfg.Tracing.OTLPInsecure,
+		SampleRatio:  a.cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return err
+	}
+	a.shutdownTracing = shutdown
+	logger.Info("Tracing ready", zap.String("exporter", a.cfg.Tracing.Exporter))
+	return nil
+}
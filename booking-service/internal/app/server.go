@@ -3,29 +3,60 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/config"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
 	grpcHandler "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/handler/grpc"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/health"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/metrics"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/outbox"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/queuepass"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/ratelimit"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/repository/postgres"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/requestid"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/tlsconfig"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/tracing"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/usecase"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/worker"
 	pb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 func (a *App) initServers() error {
 	// Event client
-	eventClient, err := client.NewEventClient(a.cfg.App.EventServiceAddr)
+	var eventCreds credentials.TransportCredentials
+	if tlsCfg := a.cfg.App.EventClientTLS; tlsCfg.Enabled {
+		reloader, err := a.newTLSReloader(tlsconfig.Config{
+			CertFile:   tlsCfg.CertFile,
+			KeyFile:    tlsCfg.KeyFile,
+			CAFile:     tlsCfg.CAFile,
+			ServerName: tlsCfg.ServerName,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to init event client TLS: %w", err)
+		}
+		eventCreds = reloader.ClientCredentials()
+	}
+	eventClient, err := client.NewEventClient(a.cfg.App.EventServiceAddr, client.Options(a.cfg.App.EventClient), eventCreds)
 	if err != nil {
 		return fmt.Errorf("failed to connect to event service: %w", err)
 	}
@@ -34,23 +65,128 @@ func (a *App) initServers() error {
 
 	// Dependencies
 	repo := postgres.NewBookingRepository(a.db)
-	svc := usecase.NewBookingUsecase(repo, a.eventClient)
-	handler := grpcHandler.NewBookingHandler(svc)
+	waitlist := postgres.NewWaitlistRepository(a.db)
+	idemRepo := postgres.NewIdempotencyRepository(a.db)
+	queue := postgres.NewQueueRepository(a.db)
+	passes, err := queuepass.LoadSigner(a.cfg.Queue.PassSecretFile, a.cfg.Queue.PassTTL)
+	if err != nil {
+		return fmt.Errorf("failed to init queue passes: %w", err)
+	}
+	if a.cfg.Queue.PassSecretFile == "" {
+		logger.Warn("No queue pass secret configured; waiting room passes only work on the instance that issued them")
+	}
+	svc := usecase.NewBookingUsecase(repo, waitlist, queue, passes, a.eventClient, a.cfg.Booking.HoldTTL)
+	idem := usecase.NewIdempotencyUsecase(idemRepo, a.cfg.Booking.IdempotencyKeyTTL)
+	handler := grpcHandler.NewBookingHandler(svc, idem)
+
+	// Health
+	a.health = health.NewChecker(a.cfg.Health.CheckTimeout, a.cfg.Health.CheckInterval, pb.BookingService_ServiceDesc.ServiceName)
+	a.health.Add("database", a.db.PingContext)
+	a.health.Add("event_service", a.eventClient.Ping)
+
+	// Outbox
+	publisher, err := newOutboxPublisher(a.cfg.Outbox)
+	if err != nil {
+		return fmt.Errorf("failed to init outbox publisher: %w", err)
+	}
+	a.publisher = publisher
+	relay := outbox.NewRelay(postgres.NewOutboxRepository(a.db), publisher, a.cfg.Outbox.RelayInterval, a.cfg.Outbox.BatchSize)
+	logger.Info("Outbox publisher ready", zap.String("publisher", a.cfg.Outbox.Publisher))
+
+	// Background workers
+	reaper := worker.NewHoldReaper(svc, a.cfg.Booking.ReaperInterval, a.cfg.Booking.ReaperBatchSize)
+	cleaner := worker.NewIdempotencyCleaner(idem, a.cfg.Booking.IdempotencyCleanupInterval)
+	pruner := worker.NewQueuePruner(svc, a.cfg.Queue.PruneInterval)
+	a.workers = append(a.workers, reaper.Run, cleaner.Run, pruner.Run, relay.Run, a.health.Run)
+
+	// Metrics
+	metrics.RegisterDB(a.db, "booking")
+	serverOpts := []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(metrics.GRPCServer.UnaryServerInterceptor()),
+		grpclib.ChainStreamInterceptor(metrics.GRPCServer.StreamServerInterceptor()),
+	}
+
+	// Tracing
+	serverOpts = append(serverOpts, grpclib.StatsHandler(otelgrpc.NewServerHandler()))
+
+	// Request IDs
+	routes := auth.GatewayRoutes(pb.File_booking_proto.Services().ByName("BookingService"))
+	serverOpts = append(serverOpts,
+		grpclib.ChainUnaryInterceptor(requestid.UnaryServerInterceptor()),
+		grpclib.ChainStreamInterceptor(requestid.StreamServerInterceptor()),
+	)
+
+	// TLS
+	if tlsCfg := a.cfg.Server.TLS; tlsCfg.Enabled {
+		if tlsCfg.CertFile == "" {
+			return errors.New("gRPC TLS is enabled but no certificate is configured")
+		}
+		reloader, err := a.newTLSReloader(tlsconfig.Config{
+			CertFile:    tlsCfg.CertFile,
+			KeyFile:     tlsCfg.KeyFile,
+			CAFile:      tlsCfg.ClientCAFile,
+			AllowedSANs: tlsCfg.AllowedSANs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to init gRPC TLS: %w", err)
+		}
+		serverOpts = append(serverOpts, grpclib.Creds(reloader.ServerCredentials()))
+		logger.Info("gRPC TLS enabled", zap.Bool("mutual", tlsCfg.ClientCAFile != ""))
+	}
+
+	// Authentication
+	gatewayOpts := []runtime.ServeMuxOption{
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithMiddlewares(tracing.GatewayMiddleware(), requestid.Middleware(routes)),
+	}
+	if a.cfg.Auth.Enabled {
+		verifier, err := auth.NewVerifier(auth.Config{
+			HMACSecretFile: a.cfg.Auth.HMACSecretFile,
+			JWKSFile:       a.cfg.Auth.JWKSFile,
+			Issuer:         a.cfg.Auth.Issuer,
+			Audience:       a.cfg.Auth.Audience,
+			Leeway:         a.cfg.Auth.Leeway,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to init authentication: %w", err)
+		}
+		serverOpts = append(serverOpts,
+			grpclib.ChainUnaryInterceptor(auth.UnaryServerInterceptor(verifier, grpcHandler.AccessPolicy)),
+			grpclib.ChainStreamInterceptor(auth.StreamServerInterceptor(verifier, grpcHandler.AccessPolicy)),
+		)
+		gatewayOpts = append(gatewayOpts, runtime.WithMiddlewares(auth.Middleware(verifier, grpcHandler.AccessPolicy, routes)))
+	} else {
+		logger.Warn("Authentication is disabled; callers are not identified")
+	}
+
+	// Rate limiting, after authentication so callers are known
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(),
+		ratelimit.Limit{Rate: a.cfg.RateLimit.UserRate, Burst: a.cfg.RateLimit.UserBurst},
+		ratelimit.Limit{Rate: a.cfg.RateLimit.IPRate, Burst: a.cfg.RateLimit.IPBurst},
+		pb.BookingService_CreateBooking_FullMethodName,
+		pb.BookingService_JoinQueue_FullMethodName,
+	)
+	serverOpts = append(serverOpts, grpclib.ChainUnaryInterceptor(ratelimit.UnaryServerInterceptor(limiter)))
+	gatewayOpts = append(gatewayOpts, runtime.WithMiddlewares(ratelimit.Middleware(limiter, routes)))
 
 	// gRPC Server
-	a.grpcServer = grpclib.NewServer()
+	a.grpcServer = grpclib.NewServer(serverOpts...)
 	pb.RegisterBookingServiceServer(a.grpcServer, handler)
+	healthpb.RegisterHealthServer(a.grpcServer, a.health.Server())
 	reflection.Register(a.grpcServer)
+	metrics.GRPCServer.InitializeMetrics(a.grpcServer)
 
 	// HTTP/gRPC-Gateway
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(gatewayOpts...)
 	if err := pb.RegisterBookingServiceHandlerServer(context.Background(), mux, handler); err != nil {
 		return err
 	}
 
 	httpMux := http.NewServeMux()
-	httpMux.Handle("/", mux)
-	httpMux.HandleFunc("/healthz", a.healthCheck)
+	httpMux.Handle("/", otelhttp.NewHandler(mux, "gateway"))
+	httpMux.HandleFunc("/livez", a.livez)
+	httpMux.HandleFunc("/readyz", a.readyz)
+	httpMux.Handle("/metrics", metrics.Handler())
 
 	httpAddr := fmt.Sprintf("%s:%s", a.cfg.Server.Host, a.cfg.Server.HTTP_Port)
 	a.httpServer = &http.Server{
@@ -61,19 +197,73 @@ func (a *App) initServers() error {
 	return nil
 }
 
-func (a *App) healthCheck(w http.ResponseWriter, r *http.Request) {
+// newTLSReloader loads the TLS files of cfg and keeps watching them until
+// shutdown.
+func (a *App) newTLSReloader(cfg tlsconfig.Config) (*tlsconfig.Reloader, error) {
+	reloader, err := tlsconfig.NewReloader(cfg)
+	if err != nil {
+		return nil, err
+	}
+	a.tlsReloaders = append(a.tlsReloaders, reloader)
+	return reloader, nil
+}
+
+func newOutboxPublisher(cfg config.OutboxConfig) (outbox.Publisher, error) {
+	switch cfg.Publisher {
+	case "stdout":
+		return outbox.NewStdoutPublisher(), nil
+	case "file":
+		return outbox.NewFilePublisher(cfg.FilePath)
+	case "inprocess":
+		publisher := outbox.NewInProcessPublisher()
+		publisher.Subscribe(func(_ context.Context, msg *domain.OutboxMessage) error {
+			logger.Debug("Outbox message published",
+				zap.String("eventType", msg.EventType),
+				zap.String("aggregateID", msg.AggregateID),
+			)
+			return nil
+		})
+		return publisher, nil
+	}
+	return nil, fmt.Errorf("unknown outbox publisher %q", cfg.Publisher)
+}
+
+// incomingHeaderMatcher forwards the Idempotency-Key header to handlers in
+// addition to the headers the gateway forwards by default.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, grpcHandler.IdempotencyKeyHeader) {
+		return grpcHandler.IdempotencyKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// livez answers as long as the process can serve HTTP at all.
+func (a *App) livez(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// readyz checks the database and event-service, and turns unavailable for
+// good once shutdown has begun.
+func (a *App) readyz(w http.ResponseWriter, r *http.Request) {
+	report := a.health.Check(r.Context())
 	status := "ok"
 	httpStatus := http.StatusOK
-
-	if err := a.db.Ping(); err != nil {
-		status = "unhealthy"
+	if !report.Ready {
+		status = "unavailable"
+		if a.health.Draining() {
+			status = "draining"
+		}
 		httpStatus = http.StatusServiceUnavailable
-		logger.Error("health check failed: db ping error", zap.Error(err))
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(httpStatus)
-	json.NewEncoder(w).Encode(map[string]string{"status": status})
+	json.NewEncoder(w).Encode(map[string]any{
+		"status":                status,
+		"checks":                report.Checks,
+		"event_service_breaker": a.eventClient.BreakerState().String(),
+	})
 }
 
 func (a *App) start() error {
@@ -103,6 +293,17 @@ func (a *App) start() error {
 		}
 	}()
 
+	// Start background workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	a.stopWorkers = stopWorkers
+	for _, run := range a.workers {
+		a.workersDone.Add(1)
+		go func(run func(ctx context.Context)) {
+			defer a.workersDone.Done()
+			run(workerCtx)
+		}(run)
+	}
+
 	<-quit
 	logger.Info("Shutting down servers...")
 
@@ -110,6 +311,14 @@ func (a *App) start() error {
 }
 
 func (a *App) shutdown() error {
+	// Stop taking traffic: readiness and the gRPC health service report not
+	// serving before the servers stop.
+	a.health.Drain()
+	if delay := a.cfg.Health.DrainDelay; delay > 0 {
+		logger.Info("Draining before shutdown", zap.Duration("delay", delay))
+		time.Sleep(delay)
+	}
+
 	// Shutdown HTTP
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -121,6 +330,19 @@ func (a *App) shutdown() error {
 	// Shutdown gRPC
 	a.grpcServer.GracefulStop()
 
+	// Stop background workers
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+		a.workersDone.Wait()
+	}
+
+	// Close outbox publisher
+	if a.publisher != nil {
+		if err := a.publisher.Close(); err != nil {
+			logger.Error("Outbox publisher close error", zap.Error(err))
+		}
+	}
+
 	// Close event client
 	if a.eventClient != nil {
 		if err := a.eventClient.Close(); err != nil {
@@ -128,6 +350,18 @@ func (a *App) shutdown() error {
 		}
 	}
 
+	// Stop watching TLS files
+	for _, reloader := range a.tlsReloaders {
+		reloader.Close()
+	}
+
+	// Flush spans
+	if a.shutdownTracing != nil {
+		if err := a.shutdownTracing(ctx); err != nil {
+			logger.Error("Tracing shutdown error", zap.Error(err))
+		}
+	}
+
 	// Close DB
 	if err := a.db.Close(); err != nil {
 		logger.Error("DB close error", zap.Error(err))
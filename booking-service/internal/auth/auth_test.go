@@ -0,0 +1,287 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func writeFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func hmacVerifier(t *testing.T) *Verifier {
+	t.Helper()
+	v, err := NewVerifier(Config{HMACSecretFile: writeFile(t, "secret", []byte(testSecret+"\n"))})
+	assert.NoError(t, err)
+	return v
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func userClaims(subject string) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func TestVerify_HMAC(t *testing.T) {
+	v := hmacVerifier(t)
+
+	identity, err := v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1")))
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "user-1", identity.Subject)
+	}
+}
+
+func TestVerify_RejectsBadTokens(t *testing.T) {
+	v := hmacVerifier(t)
+	expired := userClaims("user-1")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := userClaims("user-1")
+	noExpiry.ExpiresAt = nil
+
+	tests := map[string]string{
+		"wrong secret": sign(t, jwt.SigningMethodHS256, []byte("another-secret-another-secret-xx"), "", userClaims("user-1")),
+		"expired":      sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", expired),
+		"no expiry":    sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", noExpiry),
+		"no subject":   sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("")),
+		"garbage":      "not-a-token",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := v.Verify(token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestVerify_IssuerAndAudience(t *testing.T) {
+	v, err := NewVerifier(Config{
+		HMACSecretFile: writeFile(t, "secret", []byte(testSecret)),
+		Issuer:         "https://auth.example.com",
+		Audience:       "ticketflow",
+	})
+	assert.NoError(t, err)
+
+	claims := userClaims("user-1")
+	claims.Issuer = "https://auth.example.com"
+	claims.Audience = jwt.ClaimStrings{"ticketflow"}
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", claims))
+	assert.NoError(t, err)
+
+	claims.Audience = jwt.ClaimStrings{"other"}
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", claims))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestVerify_JWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ecPub, err := ecKey.PublicKey.Bytes()
+	assert.NoError(t, err)
+
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "alg": "RS256", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": b64(ecPub[1:33]), "y": b64(ecPub[33:])},
+	}})
+	assert.NoError(t, err)
+	v, err := NewVerifier(Config{JWKSFile: writeFile(t, "jwks.json", jwks)})
+	assert.NoError(t, err)
+
+	identity, err := v.Verify(sign(t, jwt.SigningMethodRS256, rsaKey, "rsa-1", userClaims("user-rsa")))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "user-rsa", identity.Subject)
+	}
+
+	identity, err = v.Verify(sign(t, jwt.SigningMethodES256, ecKey, "ec-1", userClaims("user-ec")))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "user-ec", identity.Subject)
+	}
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodRS256, rsaKey, "unknown", userClaims("user-rsa")))
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodRS384, rsaKey, "rsa-1", userClaims("user-rsa")))
+	assert.ErrorIs(t, err, ErrInvalidToken, "key is pinned to RS256")
+
+	_, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1")))
+	assert.ErrorIs(t, err, ErrInvalidToken, "no HMAC secret configured")
+}
+
+func TestNewVerifier_RequiresKeys(t *testing.T) {
+	_, err := NewVerifier(Config{})
+	assert.Error(t, err)
+
+	_, err = NewVerifier(Config{HMACSecretFile: writeFile(t, "secret", []byte("short"))})
+	assert.Error(t, err)
+}
+
+func TestVerify_Roles(t *testing.T) {
+	v := hmacVerifier(t)
+	organizer := claims{RegisteredClaims: userClaims("org-1"), Roles: []Role{RoleOrganizer}}
+
+	identity, err := v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", organizer))
+	if assert.NoError(t, err) {
+		assert.True(t, identity.HasRole(RoleOrganizer, RoleAdmin))
+		assert.False(t, identity.HasRole(RoleCustomer))
+	}
+
+	identity, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1")))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Role{RoleCustomer}, identity.Roles)
+	}
+}
+
+var testPolicy = Policy{
+	"/svc/Public":  Public,
+	"/svc/Private": Allow(RoleCustomer),
+	"/svc/Service": Allow(RoleService),
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	v := hmacVerifier(t)
+	intercept := UnaryServerInterceptor(v, testPolicy)
+	var got *Identity
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = FromContext(ctx)
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string) error {
+		got = nil
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+	customer := withToken(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1")))
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background(), "/svc/Private")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(withToken("bad"), "/svc/Private")))
+
+	assert.NoError(t, call(context.Background(), "/svc/Public"))
+	assert.Nil(t, got)
+
+	assert.NoError(t, call(customer, "/svc/Private"))
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "user-1", got.Subject)
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(customer, "/svc/Service")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(customer, "/svc/Unlisted")))
+}
+
+// testStream is a server stream that only has a context.
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	v := hmacVerifier(t)
+	intercept := StreamServerInterceptor(v, testPolicy)
+	var got *Identity
+	handler := func(_ any, ss grpc.ServerStream) error {
+		got, _ = FromContext(ss.Context())
+		return nil
+	}
+	call := func(ctx context.Context, method string) error {
+		got = nil
+		return intercept(nil, &testStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method}, handler)
+	}
+	token := sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1"))
+	customer := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background(), "/svc/Private")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(customer, "/svc/Service")))
+	assert.NoError(t, call(customer, "/svc/Private"))
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "user-1", got.Subject)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	v := hmacVerifier(t)
+	routes := map[string]string{
+		"GET /v1/bookings/{booking_id=*}": "/svc/Private",
+		"DELETE /v1/events/{event_id=*}":  "/svc/Service",
+	}
+	var got *Identity
+	mux := runtime.NewServeMux(runtime.WithMiddlewares(Middleware(v, testPolicy, routes)))
+	ok := func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		got, _ = FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}
+	assert.NoError(t, mux.HandlePath(http.MethodGet, "/v1/bookings/{booking_id}", ok))
+	assert.NoError(t, mux.HandlePath(http.MethodDelete, "/v1/events/{event_id}", ok))
+	assert.NoError(t, mux.HandlePath(http.MethodGet, "/v1/unlisted", ok))
+	serve := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+	token := sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1"))
+
+	rec := serve(http.MethodGet, "/v1/bookings/b-1", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrMissingToken.Error())
+
+	rec = serve(http.MethodGet, "/v1/bookings/b-1", token)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "user-1", got.Subject)
+	}
+
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "/v1/events/e-1", token).Code)
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/v1/unlisted", token).Code)
+}
@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"regexp"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type Role string
+
+const (
+	RoleCustomer  Role = "customer"
+	RoleOrganizer Role = "organizer"
+	RoleAdmin     Role = "admin"
+	// RoleService is held by the other services of the system.
+	RoleService Role = "service"
+)
+
+// Access says who may call an RPC.
+type Access struct {
+	// Public RPCs may be called without a token.
+	Public bool
+	Roles  []Role
+}
+
+var Public = Access{Public: true}
+
+func Allow(roles ...Role) Access {
+	return Access{Roles: roles}
+}
+
+// Policy maps full RPC method names to who may call them. RPCs missing from
+// the policy are denied, so a new RPC stays closed until it is listed.
+type Policy map[string]Access
+
+// check authenticates the caller of method from the value of its
+// Authorization header and applies the policy. A public method lets a caller
+// without a valid token through anonymously.
+func (p Policy) check(v *Verifier, method, header string) (*Identity, error) {
+	access, listed := p[method]
+	identity, err := v.authenticate(header)
+	if access.Public {
+		return identity, nil
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, authMessage(err))
+	}
+	if !listed || !identity.HasRole(access.Roles...) {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	return identity, nil
+}
+
+var pathVariable = regexp.MustCompile(`\{([^=}]+)\}`)
+
+// GatewayRoutes maps the HTTP bindings of a service's RPCs to their full
+// method names, so the gateway middleware can apply the same policy as the
+// interceptor. Keys are the HTTP method and the path template as printed by
+// runtime.HTTPPattern, e.g. "GET /v1/events/{event_id=*}".
+func GatewayRoutes(service protoreflect.ServiceDescriptor) map[string]string {
+	routes := make(map[string]string)
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		fullName := "/" + string(service.FullName()) + "/" + string(method.Name())
+
+		rule, _ := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if rule == nil {
+			continue
+		}
+		for _, binding := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			verb, path := httpBinding(binding)
+			if path == "" {
+				continue
+			}
+			routes[verb+" "+pathVariable.ReplaceAllString(path, "{$1=*}")] = fullName
+		}
+	}
+	return routes
+}
+
+func httpBinding(rule *annotations.HttpRule) (string, string) {
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		return "GET", pattern.Get
+	case *annotations.HttpRule_Post:
+		return "POST", pattern.Post
+	case *annotations.HttpRule_Put:
+		return "PUT", pattern.Put
+	case *annotations.HttpRule_Patch:
+		return "PATCH", pattern.Patch
+	case *annotations.HttpRule_Delete:
+		return "DELETE", pattern.Delete
+	case *annotations.HttpRule_Custom:
+		return strings.ToUpper(pattern.Custom.GetKind()), pattern.Custom.GetPath()
+	}
+	return "", ""
+}
@@ -3,45 +3,113 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/metrics"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/requestid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
 )
 
 var (
-	ErrEventNotFound     = errors.New("event not found")
-	ErrInsufficientSeats = errors.New("insufficient seats available")
-	ErrEventService      = errors.New("event service error")
+	ErrEventNotFound       = errors.New("event not found")
+	ErrInsufficientSeats   = errors.New("insufficient seats available")
+	ErrSeatUnavailable     = errors.New("one or more seats are not available")
+	ErrInvalidReservation  = errors.New("invalid seat or ticket type selection")
+	ErrTicketTypeNotOnSale = errors.New("ticket type is not on sale")
+	ErrEventNotOnSale      = errors.New("event is not on sale")
+	ErrReservationReleased = errors.New("reservation already released")
+	ErrEventService        = errors.New("event service error")
 )
 
+// EventClient reserves, resizes and releases seats under a caller-chosen
+// reservation ID. These calls are idempotent, so they are safe to retry.
+// ReserveTickets returns the reservation with the ticket type prices it was
+// taken at.
+//
+// Errors wrap one of the sentinels above in a *StatusError that keeps the
+// gRPC status event-service answered with.
 type EventClient interface {
 	GetEvent(ctx context.Context, eventID string) (*eventpb.Event, error)
-	ReserveTickets(ctx context.Context, eventID string, quantity int32) error
-	ReleaseTickets(ctx context.Context, eventID string, quantity int32) error
+	ListTicketTypes(ctx context.Context, eventID string) ([]*eventpb.TicketType, error)
+	ReserveTickets(ctx context.Context, reservationID, eventID string, quantity int32, seatIDs []string, items []*eventpb.ReservationItem) (*eventpb.Reservation, error)
+	ResizeTickets(ctx context.Context, reservationID string, quantity int32) (*eventpb.Reservation, error)
+	ReleaseTickets(ctx context.Context, reservationID string) error
+	// ReleaseLegacyTickets releases a booking made before reservations
+	// existed, giving back quantity seats of the event the first time.
+	ReleaseLegacyTickets(ctx context.Context, reservationID, eventID string, quantity int32) error
+	// Ping asks event-service's gRPC health service whether it is serving.
+	Ping(ctx context.Context) error
+	BreakerState() BreakerState
 	Close() error
 }
 
+// StatusError is a client error together with the gRPC status it was mapped
+// from. status.FromError and errors.Is both see through it.
+type StatusError struct {
+	Err    error
+	Status *status.Status
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%v: %s: %s", e.Err, e.Status.Code(), e.Status.Message())
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+func (e *StatusError) GRPCStatus() *status.Status {
+	return e.Status
+}
+
 type eventClient struct {
-	conn   *grpc.ClientConn
-	client eventpb.EventServiceClient
+	conn    *grpc.ClientConn
+	client  eventpb.EventServiceClient
+	health  healthpb.HealthClient
+	breaker *CircuitBreaker
 }
 
-// NewEventClient creates a gRPC client to event-service
-func NewEventClient(eventServiceAddr string) (EventClient, error) {
-	conn, err := grpc.NewClient(
-		eventServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+// NewEventClient creates a gRPC client to event-service. Without creds the
+// connection is plaintext.
+func NewEventClient(eventServiceAddr string, opts Options, creds credentials.TransportCredentials) (EventClient, error) {
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+	breaker := NewCircuitBreaker(opts.BreakerThreshold, opts.BreakerCooldown)
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		// Metrics sit inside the retry loop, so every attempt is recorded.
+		grpc.WithChainUnaryInterceptor(
+			requestid.UnaryClientInterceptor(),
+			unaryInterceptor(opts, breaker),
+			metrics.GRPCClient.UnaryClientInterceptor(),
+		),
+		// Each attempt gets a span and carries its trace context to
+		// event-service.
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	if opts.TokenFile != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(auth.TokenFile(opts.TokenFile)))
+	}
+	conn, err := grpc.NewClient(eventServiceAddr, dialOpts...)
 	if err != nil {
 		return nil, err
 	}
 
 	return &eventClient{
-		conn:   conn,
-		client: eventpb.NewEventServiceClient(conn),
+		conn:    conn,
+		client:  eventpb.NewEventServiceClient(conn),
+		health:  healthpb.NewHealthClient(conn),
+		breaker: breaker,
 	}, nil
 }
 
@@ -50,57 +118,148 @@ func (c *eventClient) GetEvent(ctx context.Context, eventID string) (*eventpb.Ev
 		EventId: eventID,
 	})
 	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			if st.Code() == codes.NotFound {
-				return nil, ErrEventNotFound
-			}
-		}
-		return nil, ErrEventService
+		return nil, wrapError(err, notFoundError)
 	}
 
 	return resp.Event, nil
 }
 
-func (c *eventClient) ReserveTickets(ctx context.Context, eventID string, quantity int32) error {
-	resp, err := c.client.UpdateAvailableTickets(ctx, &eventpb.UpdateTicketsRequest{
-		EventId:  eventID,
-		Quantity: quantity,
+func (c *eventClient) ListTicketTypes(ctx context.Context, eventID string) ([]*eventpb.TicketType, error) {
+	resp, err := c.client.ListTicketTypes(ctx, &eventpb.ListTicketTypesRequest{
+		EventId: eventID,
 	})
 	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			switch st.Code() {
-			case codes.NotFound:
-				return ErrEventNotFound
-			case codes.FailedPrecondition:
-				return ErrInsufficientSeats
-			}
-		}
-		return ErrEventService
+		return nil, wrapError(err, notFoundError)
 	}
 
-	if !resp.Success {
-		return ErrInsufficientSeats
+	return resp.TicketTypes, nil
+}
+
+func (c *eventClient) ReserveTickets(ctx context.Context, reservationID, eventID string, quantity int32, seatIDs []string, items []*eventpb.ReservationItem) (*eventpb.Reservation, error) {
+	resp, err := c.client.ReserveSeats(ctx, &eventpb.ReserveSeatsRequest{
+		ReservationId: reservationID,
+		EventId:       eventID,
+		Quantity:      quantity,
+		SeatIds:       seatIDs,
+		Items:         items,
+	})
+	if err != nil {
+		return nil, wrapError(err, reservationError)
 	}
 
+	return resp.Reservation, nil
+}
+
+func (c *eventClient) ResizeTickets(ctx context.Context, reservationID string, quantity int32) (*eventpb.Reservation, error) {
+	resp, err := c.client.ResizeReservation(ctx, &eventpb.ResizeReservationRequest{
+		ReservationId: reservationID,
+		Quantity:      quantity,
+	})
+	if err != nil {
+		return nil, wrapError(err, reservationError)
+	}
+
+	return resp.Reservation, nil
+}
+
+func (c *eventClient) ReleaseTickets(ctx context.Context, reservationID string) error {
+	_, err := c.client.ReleaseSeats(ctx, &eventpb.ReleaseSeatsRequest{
+		ReservationId: reservationID,
+	})
+	if err != nil {
+		return wrapError(err, notFoundError)
+	}
 	return nil
 }
 
-func (c *eventClient) ReleaseTickets(ctx context.Context, eventID string, quantity int32) error {
-	_, err := c.client.UpdateAvailableTickets(ctx, &eventpb.UpdateTicketsRequest{
-		EventId:  eventID,
-		Quantity: -quantity,
+func (c *eventClient) ReleaseLegacyTickets(ctx context.Context, reservationID, eventID string, quantity int32) error {
+	_, err := c.client.ReleaseSeats(ctx, &eventpb.ReleaseSeatsRequest{
+		ReservationId: reservationID,
+		Legacy: &eventpb.LegacyHold{
+			EventId:  eventID,
+			Quantity: quantity,
+		},
 	})
 	if err != nil {
-		if st, ok := status.FromError(err); ok {
-			if st.Code() == codes.NotFound {
-				return ErrEventNotFound
-			}
-		}
-		return ErrEventService
+		return wrapError(err, notFoundError)
+	}
+	return nil
+}
+
+func (c *eventClient) Ping(ctx context.Context) error {
+	resp, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{
+		Service: eventpb.EventService_ServiceDesc.ServiceName,
+	})
+	if err != nil {
+		return wrapError(err, func(*status.Status) error { return ErrEventService })
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%w: %s", ErrEventService, resp.Status)
 	}
 	return nil
 }
 
+func (c *eventClient) BreakerState() BreakerState {
+	return c.breaker.State()
+}
+
+// wrapError maps a failed call to a sentinel and keeps its status alongside.
+// Errors without a status, such as ErrCircuitOpen, are wrapped in
+// ErrEventService.
+func wrapError(err error, sentinel func(st *status.Status) error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("%w: %w", ErrEventService, err)
+	}
+	return &StatusError{Err: sentinel(st), Status: st}
+}
+
+func notFoundError(st *status.Status) error {
+	if st.Code() == codes.NotFound {
+		return ErrEventNotFound
+	}
+	return ErrEventService
+}
+
+func reservationError(st *status.Status) error {
+	switch st.Code() {
+	case codes.NotFound:
+		return ErrEventNotFound
+	case codes.InvalidArgument:
+		return ErrInvalidReservation
+	case codes.FailedPrecondition:
+		if hasReason(st, reasonSeatUnavailable) {
+			return ErrSeatUnavailable
+		}
+		if hasReason(st, reasonTicketTypeNotOnSale) {
+			return ErrTicketTypeNotOnSale
+		}
+		if hasReason(st, reasonEventNotOnSale) {
+			return ErrEventNotOnSale
+		}
+		return ErrInsufficientSeats
+	case codes.Aborted:
+		return ErrReservationReleased
+	}
+	return ErrEventService
+}
+
+// ErrorInfo reasons event-service attaches to FailedPrecondition errors.
+const (
+	reasonSeatUnavailable     = "SEAT_UNAVAILABLE"
+	reasonTicketTypeNotOnSale = "TICKET_TYPE_NOT_ON_SALE"
+	reasonEventNotOnSale      = "EVENT_NOT_ON_SALE"
+)
+
+func hasReason(st *status.Status, reason string) bool {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *eventClient) Close() error {
 	return c.conn.Close()
 }
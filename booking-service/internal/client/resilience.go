@@ -0,0 +1,266 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// Options tunes deadlines, retries and the circuit breaker of a client.
+type Options struct {
+	// Timeout bounds each attempt of a call, unless MethodTimeouts has an
+	// entry for the RPC's short name, e.g. "ReserveSeats".
+	Timeout        time.Duration
+	MethodTimeouts map[string]time.Duration
+	// MaxAttempts counts the first try; 1 disables retries.
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// BreakerThreshold consecutive failures open the circuit for
+	// BreakerCooldown. Zero disables the breaker.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+	// TokenFile holds the bearer token sent with every call; empty sends
+	// none.
+	TokenFile string
+}
+
+// retryableMethods are safe to send twice. The reservation RPCs are keyed by a
+// caller-chosen reservation ID and take absolute quantities, so a repeat is a
+// no-op in event-service.
+var retryableMethods = map[string]bool{
+	"GetEvent":          true,
+	"ListTicketTypes":   true,
+	"ReserveSeats":      true,
+	"ResizeReservation": true,
+	"ReleaseSeats":      true,
+}
+
+// unaryInterceptor applies the per-attempt timeout, retries transient failures
+// of retryable methods with jittered exponential backoff, and fails fast while
+// the breaker is open.
+func unaryInterceptor(opts Options, breaker *CircuitBreaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		name := path.Base(method)
+		timeout := opts.Timeout
+		if t, ok := opts.MethodTimeouts[name]; ok {
+			timeout = t
+		}
+		attempts := 1
+		if retryableMethods[name] && opts.MaxAttempts > 1 {
+			attempts = opts.MaxAttempts
+		}
+
+		var err error
+		for attempt := 0; attempt < attempts; attempt++ {
+			if attempt > 0 {
+				if !sleep(ctx, backoff(opts.BackoffBase, opts.BackoffMax, attempt)) {
+					return err
+				}
+			}
+			if !breaker.Allow() {
+				return ErrCircuitOpen
+			}
+
+			err = invokeWithTimeout(ctx, timeout, method, req, reply, cc, invoker, callOpts...)
+			breaker.Record(err)
+			if err == nil || !transient(err) || ctx.Err() != nil {
+				return err
+			}
+			logger.FromContext(ctx).Warn("event client: call failed",
+				zap.String("method", name),
+				zap.Int("attempt", attempt+1),
+				zap.Error(err),
+			)
+		}
+		return err
+	}
+}
+
+func invokeWithTimeout(ctx context.Context, timeout time.Duration, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, callOpts...)
+}
+
+// transient reports whether err looks like a failure of the downstream rather
+// than an answer from it.
+func transient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+// failure reports whether err counts against the breaker.
+func failure(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Internal, codes.Unknown:
+		return true
+	}
+	return transient(err)
+}
+
+// backoff returns a full-jitter delay for the given retry: uniformly random up
+// to base doubled per retry, capped at max.
+func backoff(base, max time.Duration, retry int) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	d := base << (retry - 1)
+	if d <= 0 || (max > 0 && d > max) {
+		d = max
+	}
+	return rand.N(d + 1)
+}
+
+func sleep(ctx context.Context, d time.Duration) bool {
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+type BreakerState int32
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half_open"
+	}
+	return "unknown"
+}
+
+// CircuitBreaker opens after a run of consecutive transient failures. Once
+// the cooldown has passed it lets a single probe through: success closes it,
+// failure opens it for another cooldown.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+func (b *CircuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerOpen && !b.now().Before(b.openedAt.Add(b.cooldown)) {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// Allow reports whether a call may go out now.
+func (b *CircuitBreaker) Allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Before(b.openedAt.Add(b.cooldown)) {
+			return false
+		}
+		b.setState(BreakerHalfOpen)
+		b.probing = true
+		return true
+	case BreakerHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	}
+	return true
+}
+
+// Record feeds the outcome of an allowed call back into the breaker. Errors
+// that are answers from the downstream, such as NotFound, count as success.
+func (b *CircuitBreaker) Record(err error) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if status.Code(err) == codes.Canceled {
+		// The caller gave up; that says nothing about the downstream.
+		return
+	}
+	if !failure(err) {
+		b.failures = 0
+		b.setState(BreakerClosed)
+		return
+	}
+
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		b.setState(BreakerOpen)
+	}
+}
+
+func (b *CircuitBreaker) setState(state BreakerState) {
+	if b.state == state {
+		return
+	}
+	logger.Warn("event client: circuit breaker state changed",
+		zap.Stringer("from", b.state),
+		zap.Stringer("to", state),
+	)
+	b.state = state
+}
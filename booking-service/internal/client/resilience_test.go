@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const getEventMethod = "/event.EventService/GetEvent"
+
+// scriptedInvoker answers successive calls with errs, then succeeds.
+func scriptedInvoker(calls *int, errs ...error) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		*calls++
+		if *calls <= len(errs) {
+			return errs[*calls-1]
+		}
+		return nil
+	}
+}
+
+func TestInterceptor_RetriesTransientErrors(t *testing.T) {
+	intercept := unaryInterceptor(Options{MaxAttempts: 3}, NewCircuitBreaker(0, 0))
+	calls := 0
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	err := intercept(context.Background(), getEventMethod, nil, nil, nil, scriptedInvoker(&calls, unavailable, unavailable))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestInterceptor_GivesUpAfterMaxAttempts(t *testing.T) {
+	intercept := unaryInterceptor(Options{MaxAttempts: 2}, NewCircuitBreaker(0, 0))
+	calls := 0
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+
+	err := intercept(context.Background(), getEventMethod, nil, nil, nil, scriptedInvoker(&calls, unavailable, unavailable, unavailable))
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, calls)
+}
+
+func TestInterceptor_DoesNotRetryAnswers(t *testing.T) {
+	intercept := unaryInterceptor(Options{MaxAttempts: 3}, NewCircuitBreaker(0, 0))
+	calls := 0
+
+	err := intercept(context.Background(), getEventMethod, nil, nil, nil, scriptedInvoker(&calls, status.Error(codes.NotFound, "event not found")))
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestInterceptor_DoesNotRetryUnlistedMethods(t *testing.T) {
+	intercept := unaryInterceptor(Options{MaxAttempts: 3}, NewCircuitBreaker(0, 0))
+	calls := 0
+
+	err := intercept(context.Background(), "/event.EventService/CreateEvent", nil, nil, nil, scriptedInvoker(&calls, status.Error(codes.Unavailable, "down")))
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, calls)
+}
+
+func TestInterceptor_AppliesMethodTimeout(t *testing.T) {
+	intercept := unaryInterceptor(Options{
+		Timeout:        time.Hour,
+		MethodTimeouts: map[string]time.Duration{"GetEvent": time.Second},
+	}, NewCircuitBreaker(0, 0))
+
+	var remaining time.Duration
+	err := intercept(context.Background(), getEventMethod, nil, nil, nil, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, _ := ctx.Deadline()
+		remaining = time.Until(deadline)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, remaining, time.Second)
+}
+
+func TestCircuitBreaker_OpensAndRecovers(t *testing.T) {
+	breaker := NewCircuitBreaker(2, time.Minute)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+	intercept := unaryInterceptor(Options{MaxAttempts: 1}, breaker)
+	calls := 0
+	invoker := scriptedInvoker(&calls, status.Error(codes.Unavailable, "down"), status.Error(codes.Unavailable, "down"))
+
+	_ = intercept(context.Background(), getEventMethod, nil, nil, nil, invoker)
+	_ = intercept(context.Background(), getEventMethod, nil, nil, nil, invoker)
+	assert.Equal(t, BreakerOpen, breaker.State())
+
+	err := intercept(context.Background(), getEventMethod, nil, nil, nil, invoker)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, 2, calls)
+
+	now = now.Add(time.Minute)
+	assert.Equal(t, BreakerHalfOpen, breaker.State())
+
+	err = intercept(context.Background(), getEventMethod, nil, nil, nil, invoker)
+	assert.NoError(t, err)
+	assert.Equal(t, BreakerClosed, breaker.State())
+}
+
+func TestCircuitBreaker_FailedProbeReopens(t *testing.T) {
+	breaker := NewCircuitBreaker(1, time.Minute)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+	down := status.Error(codes.Unavailable, "down")
+
+	assert.True(t, breaker.Allow())
+	breaker.Record(down)
+	now = now.Add(time.Minute)
+
+	assert.True(t, breaker.Allow())
+	assert.False(t, breaker.Allow(), "only one probe at a time")
+	breaker.Record(down)
+
+	assert.Equal(t, BreakerOpen, breaker.State())
+	assert.False(t, breaker.Allow())
+}
+
+func TestCircuitBreaker_AnswersCountAsSuccess(t *testing.T) {
+	breaker := NewCircuitBreaker(2, time.Minute)
+
+	breaker.Record(status.Error(codes.Unavailable, "down"))
+	breaker.Record(status.Error(codes.FailedPrecondition, "sold out"))
+	breaker.Record(status.Error(codes.Unavailable, "down"))
+
+	assert.Equal(t, BreakerClosed, breaker.State())
+}
+
+func TestWrapError_KeepsStatus(t *testing.T) {
+	st, _ := status.New(codes.FailedPrecondition, "ticket type is not on sale").
+		WithDetails(&errdetails.ErrorInfo{Reason: reasonTicketTypeNotOnSale})
+
+	err := wrapError(st.Err(), reservationError)
+
+	assert.ErrorIs(t, err, ErrTicketTypeNotOnSale)
+	got, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, got.Code())
+	assert.Len(t, got.Details(), 1)
+
+	var statusErr *StatusError
+	assert.True(t, errors.As(err, &statusErr))
+}
+
+func TestWrapError_CircuitOpen(t *testing.T) {
+	err := wrapError(ErrCircuitOpen, notFoundError)
+
+	assert.ErrorIs(t, err, ErrEventService)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+}
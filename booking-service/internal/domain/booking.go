@@ -6,16 +6,85 @@ type BookingStatus int32
 
 const (
 	BookingStatusUnspecified BookingStatus = 0
-	BookingStatusPending    BookingStatus = 1
-	BookingStatusConfirmed  BookingStatus = 2
-	BookingStatusCancelled  BookingStatus = 3
+	BookingStatusPending     BookingStatus = 1
+	BookingStatusConfirmed   BookingStatus = 2
+	BookingStatusCancelled   BookingStatus = 3
+	BookingStatusExpired     BookingStatus = 4
 )
 
 type Booking struct {
-	ID          string
-	UserID      string
-	EventID     string
+	ID            string
+	UserID        string
+	EventID       string
+	ReservationID string
+	// LegacyHold marks a booking made before reservations existed. Its seats
+	// were taken straight off the event, so event-service knows no
+	// reservation under ReservationID.
+	LegacyHold  bool
 	TicketCount int32
-	Status      BookingStatus
+	SeatIDs     []string
+	Items       []*BookingItem
+	// TotalPriceMinor is the sum of the item totals, in minor units of
+	// Currency. Both are zero for bookings of events without ticket types.
+	TotalPriceMinor int64
+	Currency        string
+	Status          BookingStatus
+	ExpiresAt       time.Time
+	CreatedAt       time.Time
+}
+
+// BookingItem is the price of one ticket type captured when the booking was
+// made, so later price changes in event-service do not rewrite it.
+type BookingItem struct {
+	TicketTypeID    string `json:"ticket_type_id"`
+	Quantity        int32  `json:"quantity"`
+	UnitPriceMinor  int64  `json:"unit_price_minor"`
+	TotalPriceMinor int64  `json:"total_price_minor"`
+	Currency        string `json:"currency"`
+}
+
+// BookingAdjustment records one change to a booking's ticket count and the
+// total price it moved between.
+type BookingAdjustment struct {
+	ID                 string
+	BookingID          string
+	PreviousCount      int32
+	NewCount           int32
+	PreviousTotalMinor int64
+	NewTotalMinor      int64
+	CreatedAt          time.Time
+}
+
+type Compensation struct {
+	ID            string
+	UserID        string
+	EventID       string
+	ReservationID string
+	TicketCount   int32
+	Reason        string
+	Attempts      int32
+	Succeeded     bool
+	CreatedAt     time.Time
+}
+
+// IdempotencyRecord is keyed by the caller's subject, the key and the
+// operation, so callers cannot collide on each other's keys.
+type IdempotencyRecord struct {
+	Subject     string
+	Key         string
+	Operation   string
+	Fingerprint string
+	Response    []byte
 	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// BookingFilter narrows a list of bookings; zero fields match every booking.
+type BookingFilter struct {
+	// CreatedFrom and CreatedBefore bound the creation time, inclusive and
+	// exclusive.
+	CreatedFrom   time.Time
+	CreatedBefore time.Time
+	Status        BookingStatus
+	EventID       string
 }
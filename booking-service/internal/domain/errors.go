@@ -3,9 +3,37 @@ package domain
 import "errors"
 
 var (
-	ErrBookingNotFound   = errors.New("booking not found")
-	ErrInvalidInput      = errors.New("invalid input")
-	ErrEventNotFound     = errors.New("event not found")
-	ErrInsufficientSeats = errors.New("insufficient seats available")
-	ErrAlreadyCancelled  = errors.New("booking already cancelled")
+	ErrBookingNotFound     = errors.New("booking not found")
+	ErrInvalidInput        = errors.New("invalid input")
+	ErrEventNotFound       = errors.New("event not found")
+	ErrInsufficientSeats   = errors.New("insufficient seats available")
+	ErrSeatUnavailable     = errors.New("one or more selected seats are not available")
+	ErrTicketTypeNotOnSale = errors.New("ticket type is not on sale")
+	ErrEventNotOnSale      = errors.New("event is not on sale")
+	ErrAlreadyCancelled    = errors.New("booking already cancelled")
+	ErrHoldExpired         = errors.New("booking hold expired")
+	ErrBookingRolledBack   = errors.New("booking failed, seat reservation released")
+	ErrCompensationFailed  = errors.New("booking failed, seat reservation could not be released")
+
+	ErrPermissionDenied = errors.New("permission denied")
+
+	ErrInvalidPageToken = errors.New("invalid page token")
+	ErrInvalidOrderBy   = errors.New("unsupported order_by")
+
+	ErrTicketLimitExceeded = errors.New("ticket limit per user for this event exceeded")
+
+	ErrBookingChanged       = errors.New("booking was modified concurrently")
+	ErrBookingNotModifiable = errors.New("only general admission bookings of at most one ticket type can be modified")
+
+	ErrNotWaitlisted       = errors.New("user is not on the waitlist")
+	ErrAlreadyWaitlisted   = errors.New("user is already on the waitlist with a different request")
+	ErrWaitlistUnavailable = errors.New("waitlist is not available for assigned-seating events")
+
+	ErrQueueNotActive      = errors.New("event has no waiting room")
+	ErrQueueTicketNotFound = errors.New("queue ticket not found")
+	ErrQueuePassRequired   = errors.New("a waiting room pass is required for this event")
+	ErrInvalidQueuePass    = errors.New("waiting room pass is invalid or expired")
+
+	ErrIdempotencyKeyReused  = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyInProgress = errors.New("request with this idempotency key is still in progress")
 )
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -19,16 +20,50 @@ func (m *MockEventClient) GetEvent(ctx context.Context, eventID string) (*eventp
 	return args.Get(0).(*eventpb.Event), args.Error(1)
 }
 
-func (m *MockEventClient) ReserveTickets(ctx context.Context, eventID string, quantity int32) error {
-	args := m.Called(ctx, eventID, quantity)
+func (m *MockEventClient) ListTicketTypes(ctx context.Context, eventID string) ([]*eventpb.TicketType, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*eventpb.TicketType), args.Error(1)
+}
+
+func (m *MockEventClient) ReserveTickets(ctx context.Context, reservationID, eventID string, quantity int32, seatIDs []string, items []*eventpb.ReservationItem) (*eventpb.Reservation, error) {
+	args := m.Called(ctx, reservationID, eventID, quantity, seatIDs, items)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*eventpb.Reservation), args.Error(1)
+}
+
+func (m *MockEventClient) ResizeTickets(ctx context.Context, reservationID string, quantity int32) (*eventpb.Reservation, error) {
+	args := m.Called(ctx, reservationID, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*eventpb.Reservation), args.Error(1)
+}
+
+func (m *MockEventClient) ReleaseTickets(ctx context.Context, reservationID string) error {
+	args := m.Called(ctx, reservationID)
 	return args.Error(0)
 }
 
-func (m *MockEventClient) ReleaseTickets(ctx context.Context, eventID string, quantity int32) error {
-	args := m.Called(ctx, eventID, quantity)
+func (m *MockEventClient) ReleaseLegacyTickets(ctx context.Context, reservationID, eventID string, quantity int32) error {
+	args := m.Called(ctx, reservationID, eventID, quantity)
 	return args.Error(0)
 }
 
+func (m *MockEventClient) Ping(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockEventClient) BreakerState() client.BreakerState {
+	args := m.Called()
+	return args.Get(0).(client.BreakerState)
+}
+
 func (m *MockEventClient) Close() error {
 	args := m.Called()
 	return args.Error(0)
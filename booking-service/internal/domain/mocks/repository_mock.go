@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -16,6 +17,16 @@ func (m *MockBookingRepository) Create(ctx context.Context, booking *domain.Book
 	return args.Error(0)
 }
 
+func (m *MockBookingRepository) CreateWithinLimit(ctx context.Context, booking *domain.Booking, limit int32) error {
+	args := m.Called(ctx, booking, limit)
+	return args.Error(0)
+}
+
+func (m *MockBookingRepository) CountLiveTickets(ctx context.Context, userID, eventID string) (int32, error) {
+	args := m.Called(ctx, userID, eventID)
+	return args.Get(0).(int32), args.Error(1)
+}
+
 func (m *MockBookingRepository) GetByID(ctx context.Context, id string) (*domain.Booking, error) {
 	args := m.Called(ctx, id)
 	if args.Get(0) == nil {
@@ -24,15 +35,176 @@ func (m *MockBookingRepository) GetByID(ctx context.Context, id string) (*domain
 	return args.Get(0).(*domain.Booking), args.Error(1)
 }
 
-func (m *MockBookingRepository) ListByUserID(ctx context.Context, userID string) ([]*domain.Booking, error) {
-	args := m.Called(ctx, userID)
+func (m *MockBookingRepository) ListByUserID(ctx context.Context, userID string, filter domain.BookingFilter, order domain.SortOrder, after *domain.PageCursor, limit int32) ([]*domain.Booking, error) {
+	args := m.Called(ctx, userID, filter, order, after, limit)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domain.Booking), args.Error(1)
 }
 
-func (m *MockBookingRepository) UpdateStatus(ctx context.Context, id string, status domain.BookingStatus) error {
-	args := m.Called(ctx, id, status)
+func (m *MockBookingRepository) CancelLive(ctx context.Context, id string, now time.Time) (bool, error) {
+	args := m.Called(ctx, id, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ConfirmPending(ctx context.Context, id string, now time.Time) (bool, error) {
+	args := m.Called(ctx, id, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ExpirePending(ctx context.Context, now time.Time, limit int) ([]*domain.Booking, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) CancelByEventID(ctx context.Context, eventID string, now time.Time) ([]*domain.Booking, error) {
+	args := m.Called(ctx, eventID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingRepository) Modify(ctx context.Context, booking *domain.Booking, adjustment *domain.BookingAdjustment, limit int32) (bool, error) {
+	args := m.Called(ctx, booking, adjustment, limit)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingRepository) ListAdjustments(ctx context.Context, bookingID string) ([]*domain.BookingAdjustment, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.BookingAdjustment), args.Error(1)
+}
+
+func (m *MockBookingRepository) RecordCompensation(ctx context.Context, compensation *domain.Compensation) error {
+	args := m.Called(ctx, compensation)
+	return args.Error(0)
+}
+
+type MockIdempotencyRepository struct {
+	mock.Mock
+}
+
+func (m *MockIdempotencyRepository) Reserve(ctx context.Context, record *domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	args := m.Called(ctx, record)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.IdempotencyRecord), args.Error(1)
+}
+
+func (m *MockIdempotencyRepository) Complete(ctx context.Context, subject, key, operation string, response []byte) error {
+	args := m.Called(ctx, subject, key, operation, response)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepository) Delete(ctx context.Context, subject, key, operation string) error {
+	args := m.Called(ctx, subject, key, operation)
+	return args.Error(0)
+}
+
+func (m *MockIdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockWaitlistRepository struct {
+	mock.Mock
+}
+
+func (m *MockWaitlistRepository) Join(ctx context.Context, entry *domain.WaitlistEntry) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, entry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) GetWaiting(ctx context.Context, eventID, userID string) (*domain.WaitlistEntry, int32, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockWaitlistRepository) Leave(ctx context.Context, eventID, userID string) (bool, error) {
+	args := m.Called(ctx, eventID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) Next(ctx context.Context, eventID string) (*domain.WaitlistEntry, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) Fulfill(ctx context.Context, entryID string, booking *domain.Booking) (domain.WaitlistStatus, error) {
+	args := m.Called(ctx, entryID, booking)
+	return args.Get(0).(domain.WaitlistStatus), args.Error(1)
+}
+
+func (m *MockWaitlistRepository) Expire(ctx context.Context, entryID string) (bool, error) {
+	args := m.Called(ctx, entryID)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) ClaimBatch(ctx context.Context, limit int, leaseUntil time.Time) ([]*domain.OutboxMessage, error) {
+	args := m.Called(ctx, limit, leaseUntil)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	args := m.Called(ctx, id, publishedAt)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) MarkFailed(ctx context.Context, id string, retryAt time.Time, lastError string) error {
+	args := m.Called(ctx, id, retryAt, lastError)
+	return args.Error(0)
+}
+
+type MockQueueRepository struct {
+	mock.Mock
+}
+
+func (m *MockQueueRepository) Join(ctx context.Context, eventID, userID string, now, staleBefore time.Time, interval time.Duration) (*domain.QueueTicket, error) {
+	args := m.Called(ctx, eventID, userID, now, staleBefore, interval)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.QueueTicket), args.Error(1)
+}
+
+func (m *MockQueueRepository) Get(ctx context.Context, ticketID string, now time.Time) (*domain.QueueTicket, int32, error) {
+	args := m.Called(ctx, ticketID, now)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.QueueTicket), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockQueueRepository) DeleteLapsed(ctx context.Context, staleBefore time.Time) (int64, error) {
+	args := m.Called(ctx, staleBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockQueueRepository) DeleteEvent(ctx context.Context, eventID string) error {
+	args := m.Called(ctx, eventID)
 	return args.Error(0)
 }
@@ -11,8 +11,8 @@ type MockBookingService struct {
 	mock.Mock
 }
 
-func (m *MockBookingService) CreateBooking(ctx context.Context, userID, eventID string, ticketCount int32) (*domain.Booking, error) {
-	args := m.Called(ctx, userID, eventID, ticketCount)
+func (m *MockBookingService) CreateBooking(ctx context.Context, userID, eventID string, ticketCount int32, seatIDs []string, items []*domain.BookingItem, queuePass string) (*domain.Booking, error) {
+	args := m.Called(ctx, userID, eventID, ticketCount, seatIDs, items, queuePass)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -27,15 +27,96 @@ func (m *MockBookingService) GetBooking(ctx context.Context, bookingID string) (
 	return args.Get(0).(*domain.Booking), args.Error(1)
 }
 
-func (m *MockBookingService) ListUserBookings(ctx context.Context, userID string) ([]*domain.Booking, error) {
-	args := m.Called(ctx, userID)
+func (m *MockBookingService) ListUserBookings(ctx context.Context, userID string, filter domain.BookingFilter, page domain.PageRequest) ([]*domain.Booking, string, error) {
+	args := m.Called(ctx, userID, filter, page)
 	if args.Get(0) == nil {
-		return nil, args.Error(1)
+		return nil, args.String(1), args.Error(2)
 	}
-	return args.Get(0).([]*domain.Booking), args.Error(1)
+	return args.Get(0).([]*domain.Booking), args.String(1), args.Error(2)
 }
 
 func (m *MockBookingService) CancelBooking(ctx context.Context, bookingID string) error {
 	args := m.Called(ctx, bookingID)
 	return args.Error(0)
 }
+
+func (m *MockBookingService) ConfirmBooking(ctx context.Context, bookingID string) (*domain.Booking, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) ModifyBooking(ctx context.Context, bookingID string, ticketCount int32, queuePass string) (*domain.Booking, error) {
+	args := m.Called(ctx, bookingID, ticketCount, queuePass)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Booking), args.Error(1)
+}
+
+func (m *MockBookingService) ListBookingAdjustments(ctx context.Context, bookingID string) ([]*domain.BookingAdjustment, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.BookingAdjustment), args.Error(1)
+}
+
+type MockIdempotencyService struct {
+	mock.Mock
+}
+
+func (m *MockIdempotencyService) Execute(ctx context.Context, subject, key, operation, fingerprint string, fn func() ([]byte, error)) ([]byte, error) {
+	args := m.Called(ctx, subject, key, operation, fingerprint, fn)
+	if rf, ok := args.Get(0).(func(context.Context, string, string, string, string, func() ([]byte, error)) ([]byte, error)); ok {
+		return rf(ctx, subject, key, operation, fingerprint, fn)
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockBookingService) CancelEventBookings(ctx context.Context, eventID string) (int32, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *MockBookingService) JoinWaitlist(ctx context.Context, eventID, userID string, ticketCount int32, ticketTypeID string) (*domain.WaitlistEntry, int32, error) {
+	args := m.Called(ctx, eventID, userID, ticketCount, ticketTypeID)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockBookingService) LeaveWaitlist(ctx context.Context, eventID, userID string) error {
+	args := m.Called(ctx, eventID, userID)
+	return args.Error(0)
+}
+
+func (m *MockBookingService) JoinQueue(ctx context.Context, eventID, userID string) (*domain.QueueStatus, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.QueueStatus), args.Error(1)
+}
+
+func (m *MockBookingService) GetQueuePosition(ctx context.Context, ticketID string) (*domain.QueueStatus, error) {
+	args := m.Called(ctx, ticketID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.QueueStatus), args.Error(1)
+}
+
+func (m *MockBookingService) GetWaitlistPosition(ctx context.Context, eventID, userID string) (*domain.WaitlistEntry, int32, error) {
+	args := m.Called(ctx, eventID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.WaitlistEntry), args.Get(1).(int32), args.Error(2)
+}
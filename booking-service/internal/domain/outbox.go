@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+const (
+	BookingEventCreated   = "booking.created"
+	BookingEventConfirmed = "booking.confirmed"
+	BookingEventCancelled = "booking.cancelled"
+	BookingEventExpired   = "booking.expired"
+	BookingEventModified  = "booking.modified"
+)
+
+// OutboxMessage is a domain event waiting to be published. It is written in the
+// same transaction as the state change it describes.
+type OutboxMessage struct {
+	ID          string
+	EventType   string
+	AggregateID string
+	Payload     []byte
+	Attempts    int32
+	CreatedAt   time.Time
+}
+
+// BookingEvent is the payload published for booking lifecycle changes.
+type BookingEvent struct {
+	Type            string         `json:"type"`
+	BookingID       string         `json:"booking_id"`
+	UserID          string         `json:"user_id"`
+	EventID         string         `json:"event_id"`
+	TicketCount     int32          `json:"ticket_count"`
+	SeatIDs         []string       `json:"seat_ids,omitempty"`
+	Items           []*BookingItem `json:"items,omitempty"`
+	TotalPriceMinor int64          `json:"total_price_minor,omitempty"`
+	Currency        string         `json:"currency,omitempty"`
+	Status          BookingStatus  `json:"status"`
+	OccurredAt      time.Time      `json:"occurred_at"`
+}
+
+// BookingEventType returns the outbox event type for a booking entering status.
+func BookingEventType(status BookingStatus) string {
+	switch status {
+	case BookingStatusPending:
+		return BookingEventCreated
+	case BookingStatusConfirmed:
+		return BookingEventConfirmed
+	case BookingStatusCancelled:
+		return BookingEventCancelled
+	case BookingStatusExpired:
+		return BookingEventExpired
+	}
+	return ""
+}
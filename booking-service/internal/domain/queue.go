@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// QueueTicket is a user's place in an event's waiting room. Tickets are given
+// admission times one slot apart in join order, so the room admits at the
+// event's rate.
+type QueueTicket struct {
+	ID        string
+	EventID   string
+	UserID    string
+	AdmitAt   time.Time
+	CreatedAt time.Time
+}
+
+// Admitted reports whether the ticket's turn has come at now.
+func (t *QueueTicket) Admitted(now time.Time) bool {
+	return !now.Before(t.AdmitAt)
+}
+
+// QueueStatus is a ticket with its place in the queue and, once admitted, the
+// pass CreateBooking takes.
+type QueueStatus struct {
+	Ticket *QueueTicket
+	// Position is 1-based among the tickets still waiting; 0 once admitted.
+	Position      int32
+	Pass          string
+	PassExpiresAt time.Time
+}
@@ -1,10 +1,92 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type BookingRepository interface {
 	Create(ctx context.Context, booking *Booking) error
+	// CreateWithinLimit creates booking unless the user would then hold more
+	// than limit tickets of the event across their pending and confirmed
+	// bookings, in which case it returns ErrTicketLimitExceeded. Concurrent
+	// calls for the same user and event are serialized.
+	CreateWithinLimit(ctx context.Context, booking *Booking, limit int32) error
+	// CountLiveTickets sums the tickets of the user's pending and confirmed
+	// bookings of an event.
+	CountLiveTickets(ctx context.Context, userID, eventID string) (int32, error)
 	GetByID(ctx context.Context, id string) (*Booking, error)
-	ListByUserID(ctx context.Context, userID string) ([]*Booking, error)
-	UpdateStatus(ctx context.Context, id string, status BookingStatus) error
+	// ListByUserID returns up to limit of the user's bookings matching
+	// filter, in order, starting after the cursor when there is one.
+	ListByUserID(ctx context.Context, userID string, filter BookingFilter, order SortOrder, after *PageCursor, limit int32) ([]*Booking, error)
+	// CancelLive cancels a pending or confirmed booking. It reports false if
+	// the booking was no longer live.
+	CancelLive(ctx context.Context, id string, now time.Time) (bool, error)
+	ConfirmPending(ctx context.Context, id string, now time.Time) (bool, error)
+	ExpirePending(ctx context.Context, now time.Time, limit int) ([]*Booking, error)
+	// CancelByEventID cancels every pending or confirmed booking of an event
+	// and returns them.
+	CancelByEventID(ctx context.Context, eventID string, now time.Time) ([]*Booking, error)
+	// Modify saves the booking's new ticket count and prices together with
+	// the adjustment, provided the booking is still live and still holds
+	// adjustment.PreviousCount tickets. It reports false otherwise. An
+	// increase that would leave the user with more than limit tickets of the
+	// event fails with ErrTicketLimitExceeded, serialized with
+	// CreateWithinLimit; a limit of 0 means no limit.
+	Modify(ctx context.Context, booking *Booking, adjustment *BookingAdjustment, limit int32) (bool, error)
+	ListAdjustments(ctx context.Context, bookingID string) ([]*BookingAdjustment, error)
+	RecordCompensation(ctx context.Context, compensation *Compensation) error
+}
+
+type WaitlistRepository interface {
+	// Join adds entry to the end of its event's waitlist, unless the user is
+	// already waiting there, in which case that entry is returned instead.
+	Join(ctx context.Context, entry *WaitlistEntry) (*WaitlistEntry, error)
+	// GetWaiting returns the user's waiting entry for an event and its
+	// 1-based position, or nil if the user is not waiting.
+	GetWaiting(ctx context.Context, eventID, userID string) (*WaitlistEntry, int32, error)
+	// Leave removes the user's waiting entry. It reports false if there was none.
+	Leave(ctx context.Context, eventID, userID string) (bool, error)
+	// Next returns the event's first waiting entry, or nil.
+	Next(ctx context.Context, eventID string) (*WaitlistEntry, error)
+	// Fulfill marks a waiting entry as offered and creates its booking in the
+	// same transaction. It returns the entry's status after the call, so a
+	// caller that lost a race can tell whether the entry was offered or left.
+	Fulfill(ctx context.Context, entryID string, booking *Booking) (WaitlistStatus, error)
+	// Expire takes a waiting entry that can never be offered off the
+	// waitlist. It reports false if the entry was no longer waiting.
+	Expire(ctx context.Context, entryID string) (bool, error)
+}
+
+type QueueRepository interface {
+	// Join returns the user's ticket for the event, unless it was admitted
+	// before staleBefore and its pass has lapsed. Otherwise it queues a new
+	// ticket, admitted interval after the event's last one and no earlier than
+	// now. Joins of an event are serialized.
+	Join(ctx context.Context, eventID, userID string, now, staleBefore time.Time, interval time.Duration) (*QueueTicket, error)
+	// Get returns a ticket and how many tickets of its event are still waiting
+	// ahead of it at now, or nil if there is none.
+	Get(ctx context.Context, ticketID string, now time.Time) (*QueueTicket, int32, error)
+	// DeleteLapsed deletes the tickets admitted before staleBefore, whose
+	// passes have lapsed. It returns the number deleted.
+	DeleteLapsed(ctx context.Context, staleBefore time.Time) (int64, error)
+	// DeleteEvent deletes the event's waiting room and all its tickets.
+	DeleteEvent(ctx context.Context, eventID string) error
+}
+
+type IdempotencyRepository interface {
+	// Reserve stores record unless a live record with the same subject, key
+	// and operation exists, in which case that record is returned instead.
+	Reserve(ctx context.Context, record *IdempotencyRecord) (*IdempotencyRecord, error)
+	Complete(ctx context.Context, subject, key, operation string, response []byte) error
+	Delete(ctx context.Context, subject, key, operation string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type OutboxRepository interface {
+	// ClaimBatch leases up to limit unpublished messages until leaseUntil so
+	// concurrent relays do not pick up the same rows.
+	ClaimBatch(ctx context.Context, limit int, leaseUntil time.Time) ([]*OutboxMessage, error)
+	MarkPublished(ctx context.Context, id string, publishedAt time.Time) error
+	MarkFailed(ctx context.Context, id string, retryAt time.Time, lastError string) error
 }
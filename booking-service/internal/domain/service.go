@@ -3,8 +3,23 @@ package domain
 import "context"
 
 type BookingService interface {
-	CreateBooking(ctx context.Context, userID, eventID string, ticketCount int32) (*Booking, error)
+	CreateBooking(ctx context.Context, userID, eventID string, ticketCount int32, seatIDs []string, items []*BookingItem, queuePass string) (*Booking, error)
 	GetBooking(ctx context.Context, bookingID string) (*Booking, error)
-	ListUserBookings(ctx context.Context, userID string) ([]*Booking, error)
+	// ListUserBookings returns a page of the user's bookings and the token of
+	// the next page, empty on the last one.
+	ListUserBookings(ctx context.Context, userID string, filter BookingFilter, page PageRequest) ([]*Booking, string, error)
 	CancelBooking(ctx context.Context, bookingID string) error
+	ConfirmBooking(ctx context.Context, bookingID string) (*Booking, error)
+	ModifyBooking(ctx context.Context, bookingID string, ticketCount int32, queuePass string) (*Booking, error)
+	ListBookingAdjustments(ctx context.Context, bookingID string) ([]*BookingAdjustment, error)
+	CancelEventBookings(ctx context.Context, eventID string) (int32, error)
+	JoinWaitlist(ctx context.Context, eventID, userID string, ticketCount int32, ticketTypeID string) (*WaitlistEntry, int32, error)
+	LeaveWaitlist(ctx context.Context, eventID, userID string) error
+	GetWaitlistPosition(ctx context.Context, eventID, userID string) (*WaitlistEntry, int32, error)
+	JoinQueue(ctx context.Context, eventID, userID string) (*QueueStatus, error)
+	GetQueuePosition(ctx context.Context, ticketID string) (*QueueStatus, error)
+}
+
+type IdempotencyService interface {
+	Execute(ctx context.Context, subject, key, operation, fingerprint string, fn func() ([]byte, error)) ([]byte, error)
 }
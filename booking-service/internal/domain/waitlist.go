@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+type WaitlistStatus int32
+
+const (
+	WaitlistStatusUnspecified WaitlistStatus = 0
+	WaitlistStatusWaiting     WaitlistStatus = 1
+	// WaitlistStatusOffered entries were given a pending booking, which the
+	// user confirms like any other hold.
+	WaitlistStatusOffered WaitlistStatus = 2
+	WaitlistStatusLeft    WaitlistStatus = 3
+	// WaitlistStatusExpired entries could never be offered and were taken
+	// off the waitlist so they do not hold up the entries behind them.
+	WaitlistStatusExpired WaitlistStatus = 4
+)
+
+// WaitlistEntry queues a user for seats of a sold-out event. Entries of an
+// event are served strictly in join order.
+type WaitlistEntry struct {
+	ID           string
+	EventID      string
+	UserID       string
+	TicketCount  int32
+	TicketTypeID string
+	Status       WaitlistStatus
+	BookingID    string
+	CreatedAt    time.Time
+}
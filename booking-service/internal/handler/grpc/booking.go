@@ -3,25 +3,58 @@ package grpc
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
 	pb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// queueWatchInterval is how often WatchQueuePosition sends the position of a
+// ticket that is not yet admitted.
+const queueWatchInterval = 2 * time.Second
+
 type BookingHandler struct {
 	pb.UnimplementedBookingServiceServer
-	svc domain.BookingService
+	svc  domain.BookingService
+	idem domain.IdempotencyService
+
+	queueWatchInterval time.Duration
 }
 
-func NewBookingHandler(svc domain.BookingService) *BookingHandler {
-	return &BookingHandler{svc: svc}
+func NewBookingHandler(svc domain.BookingService, idem domain.IdempotencyService) *BookingHandler {
+	return &BookingHandler{svc: svc, idem: idem, queueWatchInterval: queueWatchInterval}
 }
 
 func (h *BookingHandler) CreateBooking(ctx context.Context, req *pb.CreateBookingRequest) (*pb.CreateBookingResponse, error) {
-	booking, err := h.svc.CreateBooking(ctx, req.UserId, req.EventId, req.TicketCount)
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+	if key == "" {
+		return h.createBooking(ctx, req)
+	}
+
+	resp := &pb.CreateBookingResponse{}
+	err := h.idempotent(ctx, key, "CreateBooking", req, resp, func() (proto.Message, error) {
+		return h.createBooking(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (h *BookingHandler) createBooking(ctx context.Context, req *pb.CreateBookingRequest) (*pb.CreateBookingResponse, error) {
+	var items []*domain.BookingItem
+	for _, item := range req.Items {
+		items = append(items, &domain.BookingItem{
+			TicketTypeID: item.TicketTypeId,
+			Quantity:     item.Quantity,
+		})
+	}
+
+	booking, err := h.svc.CreateBooking(ctx, req.UserId, req.EventId, req.TicketCount, req.SeatIds, items, req.QueuePass)
 	if err != nil {
 		if errors.Is(err, domain.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -29,9 +62,24 @@ func (h *BookingHandler) CreateBooking(ctx context.Context, req *pb.CreateBookin
 		if errors.Is(err, domain.ErrEventNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
-		if errors.Is(err, domain.ErrInsufficientSeats) {
+		if errors.Is(err, domain.ErrInsufficientSeats) ||
+			errors.Is(err, domain.ErrSeatUnavailable) ||
+			errors.Is(err, domain.ErrTicketTypeNotOnSale) ||
+			errors.Is(err, domain.ErrEventNotOnSale) ||
+			errors.Is(err, domain.ErrTicketLimitExceeded) ||
+			errors.Is(err, domain.ErrQueuePassRequired) ||
+			errors.Is(err, domain.ErrInvalidQueuePass) {
 			return nil, status.Error(codes.FailedPrecondition, err.Error())
 		}
+		if errors.Is(err, domain.ErrBookingRolledBack) {
+			return nil, status.Error(codes.Aborted, domain.ErrBookingRolledBack.Error())
+		}
+		if errors.Is(err, domain.ErrCompensationFailed) {
+			return nil, status.Error(codes.Internal, domain.ErrCompensationFailed.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to create booking")
 	}
 
@@ -49,6 +97,9 @@ func (h *BookingHandler) GetBooking(ctx context.Context, req *pb.GetBookingReque
 		if errors.Is(err, domain.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to get booking")
 	}
 
@@ -58,11 +109,29 @@ func (h *BookingHandler) GetBooking(ctx context.Context, req *pb.GetBookingReque
 }
 
 func (h *BookingHandler) ListUserBookings(ctx context.Context, req *pb.ListUserBookingsRequest) (*pb.ListUserBookingsResponse, error) {
-	bookings, err := h.svc.ListUserBookings(ctx, req.UserId)
+	filter := domain.BookingFilter{
+		Status:  domain.BookingStatus(req.Status),
+		EventID: req.EventId,
+	}
+	if req.CreatedFrom != nil {
+		filter.CreatedFrom = req.CreatedFrom.AsTime()
+	}
+	if req.CreatedBefore != nil {
+		filter.CreatedBefore = req.CreatedBefore.AsTime()
+	}
+
+	bookings, nextPageToken, err := h.svc.ListUserBookings(ctx, req.UserId, filter, domain.PageRequest{
+		Size:    req.PageSize,
+		Token:   req.PageToken,
+		OrderBy: req.OrderBy,
+	})
 	if err != nil {
-		if errors.Is(err, domain.ErrInvalidInput) {
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrInvalidPageToken) || errors.Is(err, domain.ErrInvalidOrderBy) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to list bookings")
 	}
 
@@ -72,11 +141,28 @@ func (h *BookingHandler) ListUserBookings(ctx context.Context, req *pb.ListUserB
 	}
 
 	return &pb.ListUserBookingsResponse{
-		Bookings: pbBookings,
+		Bookings:      pbBookings,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 func (h *BookingHandler) CancelBooking(ctx context.Context, req *pb.CancelBookingRequest) (*pb.CancelBookingResponse, error) {
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+	if key == "" {
+		return h.cancelBooking(ctx, req)
+	}
+
+	resp := &pb.CancelBookingResponse{}
+	err := h.idempotent(ctx, key, "CancelBooking", req, resp, func() (proto.Message, error) {
+		return h.cancelBooking(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (h *BookingHandler) cancelBooking(ctx context.Context, req *pb.CancelBookingRequest) (*pb.CancelBookingResponse, error) {
 	err := h.svc.CancelBooking(ctx, req.BookingId)
 	if err != nil {
 		if errors.Is(err, domain.ErrBookingNotFound) {
@@ -91,9 +177,18 @@ func (h *BookingHandler) CancelBooking(ctx context.Context, req *pb.CancelBookin
 				Message: "booking already cancelled",
 			}, nil
 		}
+		if errors.Is(err, domain.ErrHoldExpired) {
+			return &pb.CancelBookingResponse{
+				Success: false,
+				Message: "booking hold expired",
+			}, nil
+		}
 		if errors.Is(err, domain.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to cancel booking")
 	}
 
@@ -103,13 +198,316 @@ func (h *BookingHandler) CancelBooking(ctx context.Context, req *pb.CancelBookin
 	}, nil
 }
 
+func (h *BookingHandler) ConfirmBooking(ctx context.Context, req *pb.ConfirmBookingRequest) (*pb.ConfirmBookingResponse, error) {
+	booking, err := h.svc.ConfirmBooking(ctx, req.BookingId)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrAlreadyCancelled) || errors.Is(err, domain.ErrHoldExpired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to confirm booking")
+	}
+
+	return &pb.ConfirmBookingResponse{
+		Booking: toProtoBooking(booking),
+	}, nil
+}
+
+func (h *BookingHandler) ModifyBooking(ctx context.Context, req *pb.ModifyBookingRequest) (*pb.ModifyBookingResponse, error) {
+	booking, err := h.svc.ModifyBooking(ctx, req.BookingId, req.TicketCount, req.QueuePass)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrBookingNotModifiable) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrAlreadyCancelled) ||
+			errors.Is(err, domain.ErrHoldExpired) ||
+			errors.Is(err, domain.ErrInsufficientSeats) ||
+			errors.Is(err, domain.ErrTicketTypeNotOnSale) ||
+			errors.Is(err, domain.ErrEventNotOnSale) ||
+			errors.Is(err, domain.ErrTicketLimitExceeded) ||
+			errors.Is(err, domain.ErrQueuePassRequired) ||
+			errors.Is(err, domain.ErrInvalidQueuePass) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrBookingChanged) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to modify booking")
+	}
+
+	return &pb.ModifyBookingResponse{
+		Booking: toProtoBooking(booking),
+	}, nil
+}
+
+func (h *BookingHandler) ListBookingAdjustments(ctx context.Context, req *pb.ListBookingAdjustmentsRequest) (*pb.ListBookingAdjustmentsResponse, error) {
+	adjustments, err := h.svc.ListBookingAdjustments(ctx, req.BookingId)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list booking adjustments")
+	}
+
+	pbAdjustments := make([]*pb.BookingAdjustment, len(adjustments))
+	for i, a := range adjustments {
+		pbAdjustments[i] = &pb.BookingAdjustment{
+			Id:                 a.ID,
+			BookingId:          a.BookingID,
+			PreviousCount:      a.PreviousCount,
+			NewCount:           a.NewCount,
+			PreviousTotalMinor: a.PreviousTotalMinor,
+			NewTotalMinor:      a.NewTotalMinor,
+			CreatedAt:          timestamppb.New(a.CreatedAt),
+		}
+	}
+
+	return &pb.ListBookingAdjustmentsResponse{
+		Adjustments: pbAdjustments,
+	}, nil
+}
+
+func (h *BookingHandler) JoinWaitlist(ctx context.Context, req *pb.JoinWaitlistRequest) (*pb.JoinWaitlistResponse, error) {
+	entry, position, err := h.svc.JoinWaitlist(ctx, req.EventId, req.UserId, req.TicketCount, req.TicketTypeId)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrWaitlistUnavailable) ||
+			errors.Is(err, domain.ErrEventNotOnSale) ||
+			errors.Is(err, domain.ErrTicketTypeNotOnSale) ||
+			errors.Is(err, domain.ErrTicketLimitExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrAlreadyWaitlisted) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to join waitlist")
+	}
+
+	return &pb.JoinWaitlistResponse{
+		Entry:    toProtoWaitlistEntry(entry),
+		Position: position,
+	}, nil
+}
+
+func (h *BookingHandler) LeaveWaitlist(ctx context.Context, req *pb.LeaveWaitlistRequest) (*pb.LeaveWaitlistResponse, error) {
+	if err := h.svc.LeaveWaitlist(ctx, req.EventId, req.UserId); err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrNotWaitlisted) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to leave waitlist")
+	}
+
+	return &pb.LeaveWaitlistResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *BookingHandler) GetWaitlistPosition(ctx context.Context, req *pb.GetWaitlistPositionRequest) (*pb.GetWaitlistPositionResponse, error) {
+	entry, position, err := h.svc.GetWaitlistPosition(ctx, req.EventId, req.UserId)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrNotWaitlisted) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get waitlist position")
+	}
+
+	return &pb.GetWaitlistPositionResponse{
+		Entry:    toProtoWaitlistEntry(entry),
+		Position: position,
+	}, nil
+}
+
+func (h *BookingHandler) JoinQueue(ctx context.Context, req *pb.JoinQueueRequest) (*pb.JoinQueueResponse, error) {
+	st, err := h.svc.JoinQueue(ctx, req.EventId, req.UserId)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrQueueNotActive) || errors.Is(err, domain.ErrEventNotOnSale) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to join queue")
+	}
+
+	return &pb.JoinQueueResponse{
+		Ticket:        toProtoQueueTicket(st.Ticket),
+		Position:      st.Position,
+		Pass:          st.Pass,
+		PassExpiresAt: passExpiry(st),
+	}, nil
+}
+
+func (h *BookingHandler) GetQueuePosition(ctx context.Context, req *pb.GetQueuePositionRequest) (*pb.GetQueuePositionResponse, error) {
+	st, err := h.svc.GetQueuePosition(ctx, req.Token)
+	if err != nil {
+		return nil, queuePositionError(err)
+	}
+
+	return toQueuePositionResponse(st), nil
+}
+
+// WatchQueuePosition sends the ticket's position every queueWatchInterval,
+// and as soon as it is admitted, after which the stream ends.
+func (h *BookingHandler) WatchQueuePosition(req *pb.GetQueuePositionRequest, stream pb.BookingService_WatchQueuePositionServer) error {
+	ctx := stream.Context()
+	for {
+		st, err := h.svc.GetQueuePosition(ctx, req.Token)
+		if err != nil {
+			return queuePositionError(err)
+		}
+		if err := stream.Send(toQueuePositionResponse(st)); err != nil {
+			return err
+		}
+		if st.Pass != "" {
+			return nil
+		}
+
+		wait := min(h.queueWatchInterval, time.Until(st.Ticket.AdmitAt))
+		timer := time.NewTimer(max(wait, 0))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func queuePositionError(err error) error {
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrQueueTicketNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, "failed to get queue position")
+}
+
+func (h *BookingHandler) CancelEventBookings(ctx context.Context, req *pb.CancelEventBookingsRequest) (*pb.CancelEventBookingsResponse, error) {
+	cancelled, err := h.svc.CancelEventBookings(ctx, req.EventId)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to cancel event bookings")
+	}
+
+	return &pb.CancelEventBookingsResponse{
+		CancelledCount: cancelled,
+	}, nil
+}
+
 func toProtoBooking(b *domain.Booking) *pb.Booking {
-	return &pb.Booking{
-		Id:          b.ID,
-		UserId:      b.UserID,
-		EventId:     b.EventID,
-		TicketCount: b.TicketCount,
-		Status:      pb.BookingStatus(b.Status),
-		CreatedAt:   timestamppb.New(b.CreatedAt),
+	booking := &pb.Booking{
+		Id:              b.ID,
+		UserId:          b.UserID,
+		EventId:         b.EventID,
+		TicketCount:     b.TicketCount,
+		SeatIds:         b.SeatIDs,
+		TotalPriceMinor: b.TotalPriceMinor,
+		Currency:        b.Currency,
+		Status:          pb.BookingStatus(b.Status),
+		CreatedAt:       timestamppb.New(b.CreatedAt),
+	}
+	if !b.ExpiresAt.IsZero() {
+		booking.ExpiresAt = timestamppb.New(b.ExpiresAt)
+	}
+	for _, item := range b.Items {
+		booking.Items = append(booking.Items, &pb.BookingItem{
+			TicketTypeId:    item.TicketTypeID,
+			Quantity:        item.Quantity,
+			UnitPriceMinor:  item.UnitPriceMinor,
+			TotalPriceMinor: item.TotalPriceMinor,
+			Currency:        item.Currency,
+		})
+	}
+	return booking
+}
+
+func toProtoQueueTicket(t *domain.QueueTicket) *pb.QueueTicket {
+	return &pb.QueueTicket{
+		Token:     t.ID,
+		EventId:   t.EventID,
+		UserId:    t.UserID,
+		AdmitAt:   timestamppb.New(t.AdmitAt),
+		CreatedAt: timestamppb.New(t.CreatedAt),
+	}
+}
+
+func toQueuePositionResponse(st *domain.QueueStatus) *pb.GetQueuePositionResponse {
+	return &pb.GetQueuePositionResponse{
+		Ticket:        toProtoQueueTicket(st.Ticket),
+		Position:      st.Position,
+		Pass:          st.Pass,
+		PassExpiresAt: passExpiry(st),
+	}
+}
+
+func passExpiry(st *domain.QueueStatus) *timestamppb.Timestamp {
+	if st.Pass == "" {
+		return nil
+	}
+	return timestamppb.New(st.PassExpiresAt)
+}
+
+func toProtoWaitlistEntry(e *domain.WaitlistEntry) *pb.WaitlistEntry {
+	return &pb.WaitlistEntry{
+		Id:           e.ID,
+		EventId:      e.EventID,
+		UserId:       e.UserID,
+		TicketCount:  e.TicketCount,
+		TicketTypeId: e.TicketTypeID,
+		Status:       pb.WaitlistStatus(e.Status),
+		BookingId:    e.BookingID,
+		CreatedAt:    timestamppb.New(e.CreatedAt),
 	}
 }
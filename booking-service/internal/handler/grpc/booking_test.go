@@ -2,23 +2,36 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain/mocks"
 	pb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func newTestHandler() (*BookingHandler, *mocks.MockBookingService) {
-	svc := new(mocks.MockBookingService)
-	handler := NewBookingHandler(svc)
+	handler, svc, _ := newTestHandlerWithIdempotency()
 	return handler, svc
 }
 
+func newTestHandlerWithIdempotency() (*BookingHandler, *mocks.MockBookingService, *mocks.MockIdempotencyService) {
+	svc := new(mocks.MockBookingService)
+	idem := new(mocks.MockIdempotencyService)
+	handler := NewBookingHandler(svc, idem)
+	return handler, svc, idem
+}
+
 func TestCreateBooking_Success(t *testing.T) {
 	h, svc := newTestHandler()
 	ctx := context.Background()
@@ -31,7 +44,7 @@ func TestCreateBooking_Success(t *testing.T) {
 		Status:      domain.BookingStatusPending,
 		CreatedAt:   time.Now(),
 	}
-	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2)).Return(booking, nil)
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").Return(booking, nil)
 
 	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
 		UserId:      "user-1",
@@ -49,7 +62,7 @@ func TestCreateBooking_InvalidInput(t *testing.T) {
 	h, svc := newTestHandler()
 	ctx := context.Background()
 
-	svc.On("CreateBooking", ctx, "", "event-1", int32(2)).Return(nil, domain.ErrInvalidInput)
+	svc.On("CreateBooking", ctx, "", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").Return(nil, domain.ErrInvalidInput)
 
 	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
 		UserId:      "",
@@ -67,7 +80,7 @@ func TestCreateBooking_EventNotFound(t *testing.T) {
 	h, svc := newTestHandler()
 	ctx := context.Background()
 
-	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2)).Return(nil, domain.ErrEventNotFound)
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").Return(nil, domain.ErrEventNotFound)
 
 	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
 		UserId:      "user-1",
@@ -85,7 +98,7 @@ func TestCreateBooking_InsufficientSeats(t *testing.T) {
 	h, svc := newTestHandler()
 	ctx := context.Background()
 
-	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(100)).Return(nil, domain.ErrInsufficientSeats)
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(100), []string(nil), []*domain.BookingItem(nil), "").Return(nil, domain.ErrInsufficientSeats)
 
 	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
 		UserId:      "user-1",
@@ -99,6 +112,118 @@ func TestCreateBooking_InsufficientSeats(t *testing.T) {
 	assert.Equal(t, codes.FailedPrecondition, st.Code())
 }
 
+func TestCreateBooking_SeatUnavailable(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(0), []string{"seat-a1", "seat-a2"}, []*domain.BookingItem(nil), "").Return(nil, domain.ErrSeatUnavailable)
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:  "user-1",
+		EventId: "event-1",
+		SeatIds: []string{"seat-a1", "seat-a2"},
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, domain.ErrSeatUnavailable.Error(), st.Message())
+}
+
+func TestCreateBooking_WithItems(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:          "booking-1",
+		UserID:      "user-1",
+		EventID:     "event-1",
+		TicketCount: 2,
+		Items: []*domain.BookingItem{
+			{TicketTypeID: "vip", Quantity: 2, UnitPriceMinor: 15000, TotalPriceMinor: 30000, Currency: "USD"},
+		},
+		TotalPriceMinor: 30000,
+		Currency:        "USD",
+		Status:          domain.BookingStatusPending,
+		CreatedAt:       time.Now(),
+	}
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(0), []string(nil), []*domain.BookingItem{
+		{TicketTypeID: "vip", Quantity: 2},
+	}, "").Return(booking, nil)
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:  "user-1",
+		EventId: "event-1",
+		Items:   []*pb.LineItem{{TicketTypeId: "vip", Quantity: 2}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30000), resp.Booking.TotalPriceMinor)
+	assert.Equal(t, "USD", resp.Booking.Currency)
+	if assert.Len(t, resp.Booking.Items, 1) {
+		assert.Equal(t, int64(15000), resp.Booking.Items[0].UnitPriceMinor)
+	}
+}
+
+func TestCreateBooking_TicketTypeNotOnSale(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(0), []string(nil), mock.Anything, "").Return(nil, domain.ErrTicketTypeNotOnSale)
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:  "user-1",
+		EventId: "event-1",
+		Items:   []*pb.LineItem{{TicketTypeId: "early-bird", Quantity: 1}},
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestCreateBooking_RolledBack(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").
+		Return(nil, fmt.Errorf("%w: %v", domain.ErrBookingRolledBack, errors.New("db down")))
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:      "user-1",
+		EventId:     "event-1",
+		TicketCount: 2,
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+	assert.NotContains(t, st.Message(), "db down")
+}
+
+func TestCreateBooking_CompensationFailed(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").
+		Return(nil, fmt.Errorf("%w: %v", domain.ErrCompensationFailed, errors.New("db down")))
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:      "user-1",
+		EventId:     "event-1",
+		TicketCount: 2,
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Internal, st.Code())
+	assert.Equal(t, domain.ErrCompensationFailed.Error(), st.Message())
+}
+
 func TestGetBooking_Success(t *testing.T) {
 	h, svc := newTestHandler()
 	ctx := context.Background()
@@ -133,6 +258,20 @@ func TestGetBooking_NotFound(t *testing.T) {
 	assert.Equal(t, codes.NotFound, st.Code())
 }
 
+func TestGetBooking_PermissionDenied(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("GetBooking", ctx, "booking-1").Return(nil, domain.ErrPermissionDenied)
+
+	resp, err := h.GetBooking(ctx, &pb.GetBookingRequest{BookingId: "booking-1"})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.PermissionDenied, st.Code())
+}
+
 func TestListUserBookings_Success(t *testing.T) {
 	h, svc := newTestHandler()
 	ctx := context.Background()
@@ -141,19 +280,33 @@ func TestListUserBookings_Success(t *testing.T) {
 		{ID: "b-1", UserID: "user-1", EventID: "event-1", TicketCount: 2, CreatedAt: time.Now()},
 		{ID: "b-2", UserID: "user-1", EventID: "event-2", TicketCount: 1, CreatedAt: time.Now()},
 	}
-	svc.On("ListUserBookings", ctx, "user-1").Return(bookings, nil)
+	from := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	svc.On("ListUserBookings", ctx, "user-1", domain.BookingFilter{
+		CreatedFrom: from,
+		Status:      domain.BookingStatusConfirmed,
+		EventID:     "event-1",
+	}, domain.PageRequest{Size: 2, Token: "token-1", OrderBy: "created_at"}).Return(bookings, "token-2", nil)
 
-	resp, err := h.ListUserBookings(ctx, &pb.ListUserBookingsRequest{UserId: "user-1"})
+	resp, err := h.ListUserBookings(ctx, &pb.ListUserBookingsRequest{
+		UserId:      "user-1",
+		PageSize:    2,
+		PageToken:   "token-1",
+		CreatedFrom: timestamppb.New(from),
+		Status:      pb.BookingStatus_BOOKING_STATUS_CONFIRMED,
+		EventId:     "event-1",
+		OrderBy:     "created_at",
+	})
 
 	assert.NoError(t, err)
 	assert.Len(t, resp.Bookings, 2)
+	assert.Equal(t, "token-2", resp.NextPageToken)
 }
 
 func TestListUserBookings_InvalidInput(t *testing.T) {
 	h, svc := newTestHandler()
 	ctx := context.Background()
 
-	svc.On("ListUserBookings", ctx, "").Return(nil, domain.ErrInvalidInput)
+	svc.On("ListUserBookings", ctx, "", domain.BookingFilter{}, domain.PageRequest{}).Return(nil, "", domain.ErrInvalidInput)
 
 	resp, err := h.ListUserBookings(ctx, &pb.ListUserBookingsRequest{UserId: ""})
 
@@ -201,3 +354,469 @@ func TestCancelBooking_AlreadyCancelled(t *testing.T) {
 	assert.False(t, resp.Success)
 	assert.Equal(t, "booking already cancelled", resp.Message)
 }
+
+func TestCreateBooking_EventNotOnSale(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").Return(nil, domain.ErrEventNotOnSale)
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:      "user-1",
+		EventId:     "event-1",
+		TicketCount: 2,
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestCancelEventBookings_Success(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("CancelEventBookings", ctx, "event-1").Return(int32(5), nil)
+
+	resp, err := h.CancelEventBookings(ctx, &pb.CancelEventBookingsRequest{EventId: "event-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), resp.CancelledCount)
+}
+
+func TestModifyBooking_Success(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("ModifyBooking", ctx, "booking-1", int32(3), "").Return(&domain.Booking{
+		ID:          "booking-1",
+		TicketCount: 3,
+		Status:      domain.BookingStatusConfirmed,
+		CreatedAt:   time.Now(),
+	}, nil)
+
+	resp, err := h.ModifyBooking(ctx, &pb.ModifyBookingRequest{BookingId: "booking-1", TicketCount: 3})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), resp.Booking.TicketCount)
+}
+
+func TestModifyBooking_ErrorCodes(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{domain.ErrBookingNotFound, codes.NotFound},
+		{domain.ErrBookingNotModifiable, codes.InvalidArgument},
+		{domain.ErrInsufficientSeats, codes.FailedPrecondition},
+		{domain.ErrHoldExpired, codes.FailedPrecondition},
+		{domain.ErrTicketLimitExceeded, codes.FailedPrecondition},
+		{domain.ErrQueuePassRequired, codes.FailedPrecondition},
+		{domain.ErrInvalidQueuePass, codes.FailedPrecondition},
+		{domain.ErrBookingChanged, codes.Aborted},
+	}
+
+	for _, tc := range cases {
+		h, svc := newTestHandler()
+		svc.On("ModifyBooking", mock.Anything, "booking-1", int32(7), "").Return(nil, tc.err)
+
+		_, err := h.ModifyBooking(context.Background(), &pb.ModifyBookingRequest{BookingId: "booking-1", TicketCount: 7})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, tc.code, st.Code(), tc.err.Error())
+	}
+}
+
+func TestListBookingAdjustments_Success(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("ListBookingAdjustments", ctx, "booking-1").Return([]*domain.BookingAdjustment{
+		{ID: "adj-1", BookingID: "booking-1", PreviousCount: 5, NewCount: 3, CreatedAt: time.Now()},
+	}, nil)
+
+	resp, err := h.ListBookingAdjustments(ctx, &pb.ListBookingAdjustmentsRequest{BookingId: "booking-1"})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Adjustments, 1)
+	assert.Equal(t, int32(5), resp.Adjustments[0].PreviousCount)
+	assert.Equal(t, int32(3), resp.Adjustments[0].NewCount)
+}
+
+func TestJoinWaitlist_Success(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	entry := &domain.WaitlistEntry{
+		ID:          "entry-1",
+		EventID:     "event-1",
+		UserID:      "user-1",
+		TicketCount: 2,
+		Status:      domain.WaitlistStatusWaiting,
+		CreatedAt:   time.Now(),
+	}
+	svc.On("JoinWaitlist", ctx, "event-1", "user-1", int32(2), "").Return(entry, int32(3), nil)
+
+	resp, err := h.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{
+		EventId:     "event-1",
+		UserId:      "user-1",
+		TicketCount: 2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "entry-1", resp.Entry.Id)
+	assert.Equal(t, pb.WaitlistStatus_WAITLIST_STATUS_WAITING, resp.Entry.Status)
+	assert.Equal(t, int32(3), resp.Position)
+}
+
+func TestJoinWaitlist_AlreadyWaitlisted(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("JoinWaitlist", ctx, "event-1", "user-1", int32(2), "").Return(nil, int32(0), domain.ErrAlreadyWaitlisted)
+
+	resp, err := h.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{
+		EventId:     "event-1",
+		UserId:      "user-1",
+		TicketCount: 2,
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+}
+
+func TestJoinWaitlist_TicketTypeNotOnSale(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("JoinWaitlist", ctx, "event-1", "user-1", int32(2), "tt-1").Return(nil, int32(0), domain.ErrTicketTypeNotOnSale)
+
+	_, err := h.JoinWaitlist(ctx, &pb.JoinWaitlistRequest{
+		EventId:      "event-1",
+		UserId:       "user-1",
+		TicketCount:  2,
+		TicketTypeId: "tt-1",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestLeaveWaitlist_NotWaitlisted(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("LeaveWaitlist", ctx, "event-1", "user-1").Return(domain.ErrNotWaitlisted)
+
+	resp, err := h.LeaveWaitlist(ctx, &pb.LeaveWaitlistRequest{EventId: "event-1", UserId: "user-1"})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestGetWaitlistPosition_Success(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	entry := &domain.WaitlistEntry{
+		ID:        "entry-1",
+		EventID:   "event-1",
+		UserID:    "user-1",
+		Status:    domain.WaitlistStatusWaiting,
+		CreatedAt: time.Now(),
+	}
+	svc.On("GetWaitlistPosition", ctx, "event-1", "user-1").Return(entry, int32(1), nil)
+
+	resp, err := h.GetWaitlistPosition(ctx, &pb.GetWaitlistPositionRequest{EventId: "event-1", UserId: "user-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Position)
+}
+
+func TestConfirmBooking_Success(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:        "booking-1",
+		UserID:    "user-1",
+		EventID:   "event-1",
+		Status:    domain.BookingStatusConfirmed,
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+	svc.On("ConfirmBooking", ctx, "booking-1").Return(booking, nil)
+
+	resp, err := h.ConfirmBooking(ctx, &pb.ConfirmBookingRequest{BookingId: "booking-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BookingStatus_BOOKING_STATUS_CONFIRMED, resp.Booking.Status)
+	assert.NotNil(t, resp.Booking.ExpiresAt)
+}
+
+func TestConfirmBooking_HoldExpired(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("ConfirmBooking", ctx, "booking-1").Return(nil, domain.ErrHoldExpired)
+
+	resp, err := h.ConfirmBooking(ctx, &pb.ConfirmBookingRequest{BookingId: "booking-1"})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestConfirmBooking_NotFound(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx := context.Background()
+
+	svc.On("ConfirmBooking", ctx, "booking-1").Return(nil, domain.ErrBookingNotFound)
+
+	resp, err := h.ConfirmBooking(ctx, &pb.ConfirmBookingRequest{BookingId: "booking-1"})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestCreateBooking_IdempotencyKeyFromRequest(t *testing.T) {
+	h, svc, idem := newTestHandlerWithIdempotency()
+	ctx := context.Background()
+
+	booking := &domain.Booking{ID: "booking-1", UserID: "user-1", EventID: "event-1", TicketCount: 2, CreatedAt: time.Now()}
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").Return(booking, nil)
+	idem.On("Execute", ctx, "", "key-1", "CreateBooking", mock.AnythingOfType("string"), mock.Anything).
+		Return(func(_ context.Context, _, _, _, _ string, fn func() ([]byte, error)) ([]byte, error) {
+			return fn()
+		})
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:         "user-1",
+		EventId:        "event-1",
+		TicketCount:    2,
+		IdempotencyKey: "key-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "booking-1", resp.Booking.Id)
+	svc.AssertExpectations(t)
+}
+
+func TestCreateBooking_IdempotencyKeyFromHeaderReplays(t *testing.T) {
+	h, svc, idem := newTestHandlerWithIdempotency()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, "key-1"))
+
+	stored, _ := proto.Marshal(&pb.CreateBookingResponse{Booking: &pb.Booking{Id: "booking-1"}})
+	idem.On("Execute", ctx, "", "key-1", "CreateBooking", mock.AnythingOfType("string"), mock.Anything).Return(stored, nil)
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:      "user-1",
+		EventId:     "event-1",
+		TicketCount: 2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "booking-1", resp.Booking.Id)
+	svc.AssertNotCalled(t, "CreateBooking", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_IdempotencyKeyReused(t *testing.T) {
+	h, _, idem := newTestHandlerWithIdempotency()
+	ctx := context.Background()
+
+	idem.On("Execute", ctx, "", "key-1", "CreateBooking", mock.AnythingOfType("string"), mock.Anything).
+		Return(nil, domain.ErrIdempotencyKeyReused)
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:         "user-1",
+		EventId:        "event-1",
+		TicketCount:    2,
+		IdempotencyKey: "key-1",
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestCreateBooking_IdempotencyInProgress(t *testing.T) {
+	h, _, idem := newTestHandlerWithIdempotency()
+	ctx := context.Background()
+
+	idem.On("Execute", ctx, "", "key-1", "CreateBooking", mock.AnythingOfType("string"), mock.Anything).
+		Return(nil, domain.ErrIdempotencyInProgress)
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:         "user-1",
+		EventId:        "event-1",
+		TicketCount:    2,
+		IdempotencyKey: "key-1",
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+}
+
+func TestCreateBooking_IdempotentCallKeepsErrorCode(t *testing.T) {
+	h, svc, idem := newTestHandlerWithIdempotency()
+	ctx := context.Background()
+
+	svc.On("CreateBooking", ctx, "user-1", "event-1", int32(2), []string(nil), []*domain.BookingItem(nil), "").Return(nil, domain.ErrInsufficientSeats)
+	idem.On("Execute", ctx, "", "key-1", "CreateBooking", mock.AnythingOfType("string"), mock.Anything).
+		Return(func(_ context.Context, _, _, _, _ string, fn func() ([]byte, error)) ([]byte, error) {
+			return fn()
+		})
+
+	resp, err := h.CreateBooking(ctx, &pb.CreateBookingRequest{
+		UserId:         "user-1",
+		EventId:        "event-1",
+		TicketCount:    2,
+		IdempotencyKey: "key-1",
+	})
+
+	assert.Nil(t, resp)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestCancelBooking_IdempotencyReplay(t *testing.T) {
+	h, svc, idem := newTestHandlerWithIdempotency()
+	ctx := context.Background()
+
+	stored, _ := proto.Marshal(&pb.CancelBookingResponse{Success: true, Message: "booking cancelled successfully"})
+	idem.On("Execute", ctx, "", "key-1", "CancelBooking", mock.AnythingOfType("string"), mock.Anything).Return(stored, nil)
+
+	resp, err := h.CancelBooking(ctx, &pb.CancelBookingRequest{BookingId: "booking-1", IdempotencyKey: "key-1"})
+
+	assert.NoError(t, err)
+	assert.True(t, resp.Success)
+	svc.AssertNotCalled(t, "CancelBooking", mock.Anything, mock.Anything)
+}
+
+func TestRequestFingerprint_IgnoresIdempotencyKey(t *testing.T) {
+	a, err := requestFingerprint(&pb.CreateBookingRequest{UserId: "user-1", EventId: "event-1", TicketCount: 2, IdempotencyKey: "key-1"})
+	assert.NoError(t, err)
+	b, err := requestFingerprint(&pb.CreateBookingRequest{UserId: "user-1", EventId: "event-1", TicketCount: 2})
+	assert.NoError(t, err)
+	c, err := requestFingerprint(&pb.CreateBookingRequest{UserId: "user-1", EventId: "event-1", TicketCount: 3})
+	assert.NoError(t, err)
+
+	assert.Equal(t, a, b)
+	assert.NotEqual(t, a, c)
+}
+
+func TestCreateBooking_IdempotencyScopedToCaller(t *testing.T) {
+	h, _, idem := newTestHandlerWithIdempotency()
+	req := &pb.CreateBookingRequest{EventId: "event-1", TicketCount: 2, IdempotencyKey: "key-1"}
+	stored, _ := proto.Marshal(&pb.CreateBookingResponse{Booking: &pb.Booking{Id: "booking-1"}})
+
+	var subjects, fingerprints []string
+	idem.On("Execute", mock.Anything, mock.AnythingOfType("string"), "key-1", "CreateBooking", mock.AnythingOfType("string"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			subjects = append(subjects, args.String(1))
+			fingerprints = append(fingerprints, args.String(4))
+		}).
+		Return(stored, nil)
+
+	for _, subject := range []string{"user-1", "user-2"} {
+		ctx := auth.WithIdentity(context.Background(), &auth.Identity{Subject: subject})
+		_, err := h.CreateBooking(ctx, req)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"user-1", "user-2"}, subjects)
+	// The same request is the same request whoever sends it.
+	if assert.Len(t, fingerprints, 2) {
+		assert.Equal(t, fingerprints[0], fingerprints[1])
+	}
+}
+
+func TestJoinQueue_ErrorCodes(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{domain.ErrInvalidInput, codes.InvalidArgument},
+		{domain.ErrEventNotFound, codes.NotFound},
+		{domain.ErrQueueNotActive, codes.FailedPrecondition},
+		{domain.ErrEventNotOnSale, codes.FailedPrecondition},
+		{domain.ErrPermissionDenied, codes.PermissionDenied},
+	}
+
+	for _, tc := range cases {
+		h, svc := newTestHandler()
+		svc.On("JoinQueue", mock.Anything, "event-1", "user-1").Return(nil, tc.err)
+
+		_, err := h.JoinQueue(context.Background(), &pb.JoinQueueRequest{EventId: "event-1", UserId: "user-1"})
+
+		assert.Equal(t, tc.code, status.Code(err), tc.err.Error())
+	}
+}
+
+// queueStream collects what WatchQueuePosition sends.
+type queueStream struct {
+	pb.BookingService_WatchQueuePositionServer
+	ctx  context.Context
+	sent []*pb.GetQueuePositionResponse
+}
+
+func (s *queueStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *queueStream) Send(resp *pb.GetQueuePositionResponse) error {
+	s.sent = append(s.sent, resp)
+	return nil
+}
+
+func TestWatchQueuePosition_UntilAdmitted(t *testing.T) {
+	h, svc := newTestHandler()
+	h.queueWatchInterval = time.Millisecond
+	stream := &queueStream{ctx: context.Background()}
+
+	ticket := &domain.QueueTicket{ID: "ticket-1", EventID: "event-1", UserID: "user-1", AdmitAt: time.Now().Add(time.Hour)}
+	svc.On("GetQueuePosition", mock.Anything, "ticket-1").Return(&domain.QueueStatus{Ticket: ticket, Position: 2}, nil).Once()
+	svc.On("GetQueuePosition", mock.Anything, "ticket-1").Return(&domain.QueueStatus{Ticket: ticket, Position: 1}, nil).Once()
+	svc.On("GetQueuePosition", mock.Anything, "ticket-1").Return(&domain.QueueStatus{
+		Ticket:        ticket,
+		Pass:          "pass-1",
+		PassExpiresAt: time.Now().Add(10 * time.Minute),
+	}, nil).Once()
+
+	err := h.WatchQueuePosition(&pb.GetQueuePositionRequest{Token: "ticket-1"}, stream)
+
+	assert.NoError(t, err)
+	if assert.Len(t, stream.sent, 3) {
+		assert.Equal(t, int32(2), stream.sent[0].Position)
+		assert.Equal(t, int32(1), stream.sent[1].Position)
+		assert.Equal(t, "pass-1", stream.sent[2].Pass)
+		assert.NotNil(t, stream.sent[2].PassExpiresAt)
+	}
+}
+
+func TestWatchQueuePosition_Cancelled(t *testing.T) {
+	h, svc := newTestHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream := &queueStream{ctx: ctx}
+
+	ticket := &domain.QueueTicket{ID: "ticket-1", AdmitAt: time.Now().Add(time.Hour)}
+	svc.On("GetQueuePosition", mock.Anything, "ticket-1").Return(&domain.QueueStatus{Ticket: ticket, Position: 3}, nil)
+
+	err := h.WatchQueuePosition(&pb.GetQueuePositionRequest{Token: "ticket-1"}, stream)
+
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Len(t, stream.sent, 1)
+}
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IdempotencyKeyHeader is the metadata key carrying the idempotency key. The
+// gateway maps the Idempotency-Key HTTP header onto it.
+const IdempotencyKeyHeader = "idempotency-key"
+
+// idempotencyKey prefers the key sent in the request body over the header.
+func idempotencyKey(ctx context.Context, fromRequest string) string {
+	if fromRequest != "" {
+		return fromRequest
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(IdempotencyKeyHeader); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// idempotent runs fn through the idempotency service and decodes the stored or
+// fresh response into resp. Errors returned by fn pass through unchanged.
+func (h *BookingHandler) idempotent(ctx context.Context, key, operation string, req, resp proto.Message, fn func() (proto.Message, error)) error {
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		return status.Error(codes.Internal, "failed to fingerprint request")
+	}
+	// Keys are scoped to the caller, so one user's key neither replays nor
+	// blocks another user's request.
+	var subject string
+	if identity, ok := auth.FromContext(ctx); ok {
+		subject = identity.Subject
+	}
+
+	data, err := h.idem.Execute(ctx, subject, key, operation, fingerprint, func() ([]byte, error) {
+		out, err := fn()
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(out)
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrIdempotencyKeyReused) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrIdempotencyInProgress) {
+			return status.Error(codes.Aborted, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return status.Error(codes.InvalidArgument, "invalid idempotency key")
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, "idempotency check failed")
+	}
+
+	if err := proto.Unmarshal(data, resp); err != nil {
+		return status.Error(codes.Internal, "failed to decode stored response")
+	}
+	return nil
+}
+
+// requestFingerprint hashes the request without its idempotency key, so the
+// same payload sent with the key in the body or in the header matches. The
+// waiting room pass is left out too, as a retry may carry a fresh one.
+func requestFingerprint(req proto.Message) (string, error) {
+	clone := proto.Clone(req)
+	msg := clone.ProtoReflect()
+	for _, name := range []protoreflect.Name{"idempotency_key", "queue_pass"} {
+		if fd := msg.Descriptor().Fields().ByName(name); fd != nil {
+			msg.Clear(fd)
+		}
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/proto"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// users may act on their own bookings and waitlist entries; admins on anyone's.
+var users = auth.Allow(auth.RoleCustomer, auth.RoleOrganizer, auth.RoleAdmin)
+
+// AccessPolicy says who may call each BookingService RPC.
+var AccessPolicy = auth.Policy{
+	pb.BookingService_CreateBooking_FullMethodName:          users,
+	pb.BookingService_GetBooking_FullMethodName:             users,
+	pb.BookingService_ListUserBookings_FullMethodName:       users,
+	pb.BookingService_CancelBooking_FullMethodName:          users,
+	pb.BookingService_ConfirmBooking_FullMethodName:         users,
+	pb.BookingService_ModifyBooking_FullMethodName:          users,
+	pb.BookingService_ListBookingAdjustments_FullMethodName: users,
+	pb.BookingService_JoinWaitlist_FullMethodName:           users,
+	pb.BookingService_LeaveWaitlist_FullMethodName:          users,
+	pb.BookingService_GetWaitlistPosition_FullMethodName:    users,
+	pb.BookingService_JoinQueue_FullMethodName:              users,
+	pb.BookingService_GetQueuePosition_FullMethodName:       users,
+	pb.BookingService_WatchQueuePosition_FullMethodName:     users,
+	// Called by event-service when an event is cancelled.
+	pb.BookingService_CancelEventBookings_FullMethodName: auth.Allow(auth.RoleService, auth.RoleAdmin),
+	// Probed by load balancers.
+	healthpb.Health_Check_FullMethodName: auth.Public,
+	healthpb.Health_List_FullMethodName:  auth.Public,
+	healthpb.Health_Watch_FullMethodName: auth.Public,
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/proto"
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestAccessPolicy_CoversEveryRPC(t *testing.T) {
+	service := pb.File_booking_proto.Services().ByName("BookingService")
+	methods := service.Methods()
+
+	for i := 0; i < methods.Len(); i++ {
+		name := "/" + string(service.FullName()) + "/" + string(methods.Get(i).Name())
+		assert.Contains(t, AccessPolicy, name)
+	}
+}
+
+func TestAccessPolicy_HealthIsPublic(t *testing.T) {
+	desc := healthpb.Health_ServiceDesc
+	var names []string
+	for _, m := range desc.Methods {
+		names = append(names, m.MethodName)
+	}
+	for _, s := range desc.Streams {
+		names = append(names, s.StreamName)
+	}
+
+	for _, name := range names {
+		access, ok := AccessPolicy["/"+desc.ServiceName+"/"+name]
+		assert.True(t, ok, name)
+		assert.True(t, access.Public, name)
+	}
+}
+
+func TestGatewayRoutes(t *testing.T) {
+	routes := auth.GatewayRoutes(pb.File_booking_proto.Services().ByName("BookingService"))
+
+	assert.Equal(t, pb.BookingService_GetBooking_FullMethodName, routes["GET /v1/bookings/{booking_id=*}"])
+	assert.Equal(t, pb.BookingService_LeaveWaitlist_FullMethodName, routes["DELETE /v1/events/{event_id=*}/waitlist/{user_id=*}"])
+	assert.Equal(t, pb.BookingService_JoinQueue_FullMethodName, routes["POST /v1/events/{event_id=*}/queue"])
+	// CancelEventBookings and WatchQueuePosition have no HTTP binding.
+	assert.Len(t, routes, 12)
+}
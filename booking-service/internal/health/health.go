@@ -0,0 +1,147 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check reports whether a dependency is usable. It is given a context bounded
+// by the checker's timeout.
+type Check func(ctx context.Context) error
+
+// Checker decides readiness from a set of dependency checks. The HTTP readiness
+// probe runs them on demand; the grpc.health.v1 service publishes the outcome
+// of a periodic run. Once drained for shutdown, it reports not serving for
+// good.
+type Checker struct {
+	timeout  time.Duration
+	interval time.Duration
+	services []string
+	names    []string
+	checks   map[string]Check
+
+	server   *grpchealth.Server
+	draining atomic.Bool
+	drained  chan struct{}
+}
+
+// NewChecker publishes the overall status ("") and that of each of services,
+// e.g. "booking.BookingService", which stay not serving until the first
+// periodic run passes.
+func NewChecker(timeout, interval time.Duration, services ...string) *Checker {
+	c := &Checker{
+		timeout:  timeout,
+		interval: interval,
+		services: append([]string{""}, services...),
+		checks:   make(map[string]Check),
+		server:   grpchealth.NewServer(),
+		drained:  make(chan struct{}),
+	}
+	c.publish(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Add registers a check under name, e.g. "database".
+func (c *Checker) Add(name string, check Check) {
+	c.names = append(c.names, name)
+	c.checks[name] = check
+}
+
+// Server is the grpc.health.v1 service to register on the gRPC server.
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+// Report is the outcome of one run of the checks.
+type Report struct {
+	Ready bool
+	// Checks holds "ok" or the error of each check, by name.
+	Checks map[string]string
+}
+
+// Check runs all checks concurrently, each bounded by the timeout. A draining
+// checker is never ready.
+func (c *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	errs := make([]error, len(c.names))
+	var wg sync.WaitGroup
+	for i, name := range c.names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = c.checks[name](ctx)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Ready: !c.draining.Load(), Checks: make(map[string]string, len(c.names))}
+	for i, name := range c.names {
+		report.Checks[name] = "ok"
+		if errs[i] != nil {
+			report.Ready = false
+			report.Checks[name] = errs[i].Error()
+		}
+	}
+	return report
+}
+
+// Run checks every interval and publishes the outcome on the gRPC health
+// service, logging when readiness changes. It returns when ctx is done or the
+// checker is drained.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	ready := false
+	for {
+		report := c.Check(ctx)
+		if report.Ready != ready && !c.draining.Load() {
+			ready = report.Ready
+			if ready {
+				c.publish(healthpb.HealthCheckResponse_SERVING)
+				logger.Info("Service is ready")
+			} else {
+				c.publish(healthpb.HealthCheckResponse_NOT_SERVING)
+				logger.Warn("Service is not ready", zap.Any("checks", report.Checks))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.drained:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Drain marks the service not serving on both the readiness probe and the gRPC
+// health service, so load balancers stop sending traffic before the servers
+// stop. Watchers of the gRPC health service are told straight away.
+func (c *Checker) Drain() {
+	if c.draining.Swap(true) {
+		return
+	}
+	c.server.Shutdown()
+	close(c.drained)
+}
+
+func (c *Checker) Draining() bool {
+	return c.draining.Load()
+}
+
+func (c *Checker) publish(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+}
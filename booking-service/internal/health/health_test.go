@@ -0,0 +1,82 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const service = "booking.BookingService"
+
+func ok(context.Context) error { return nil }
+
+func servingStatus(t *testing.T, c *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := c.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN
+	}
+	return resp.Status
+}
+
+func TestCheck_AllPass(t *testing.T) {
+	c := NewChecker(time.Second, time.Hour)
+	c.Add("database", ok)
+	c.Add("event_service", ok)
+
+	report := c.Check(context.Background())
+
+	assert.True(t, report.Ready)
+	assert.Equal(t, map[string]string{"database": "ok", "event_service": "ok"}, report.Checks)
+}
+
+func TestCheck_Failure(t *testing.T) {
+	c := NewChecker(time.Second, time.Hour)
+	c.Add("database", ok)
+	c.Add("event_service", func(context.Context) error { return errors.New("connection refused") })
+
+	report := c.Check(context.Background())
+
+	assert.False(t, report.Ready)
+	assert.Equal(t, "connection refused", report.Checks["event_service"])
+}
+
+func TestCheck_Timeout(t *testing.T) {
+	c := NewChecker(20*time.Millisecond, time.Hour)
+	c.Add("database", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	report := c.Check(context.Background())
+
+	assert.False(t, report.Ready)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["database"])
+}
+
+func TestRun_PublishesAndDrains(t *testing.T) {
+	c := NewChecker(time.Second, 10*time.Millisecond, service)
+	c.Add("database", ok)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, service))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Run(context.Background())
+	}()
+	assert.Eventually(t, func() bool {
+		return servingStatus(t, c, service) == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, c, ""))
+
+	c.Drain()
+
+	<-done
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, service))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, c, ""))
+	assert.False(t, c.Check(context.Background()).Ready)
+}
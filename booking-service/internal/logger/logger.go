@@ -1,6 +1,10 @@
 package logger
 
 import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -59,6 +63,32 @@ func With(fields ...zap.Field) *zap.Logger {
 	return Get().With(fields...)
 }
 
+type fieldsKey struct{}
+
+// WithFields returns a copy of ctx whose FromContext logger adds fields, on
+// top of any added before.
+func WithFields(ctx context.Context, fields ...zap.Field) context.Context {
+	existing, _ := ctx.Value(fieldsKey{}).([]zap.Field)
+	return context.WithValue(ctx, fieldsKey{}, append(slices.Clip(existing), fields...))
+}
+
+// FromContext returns a logger that tags lines with the fields put into ctx
+// by WithFields, such as the request ID, RPC method and user ID of a call, and
+// with the trace and span IDs of the span in ctx, if there is one.
+func FromContext(ctx context.Context) *zap.Logger {
+	l := Get().WithOptions(zap.AddCallerSkip(-1))
+	if fields, ok := ctx.Value(fieldsKey{}).([]zap.Field); ok {
+		l = l.With(fields...)
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		l = l.With(
+			zap.String("traceID", sc.TraceID().String()),
+			zap.String("spanID", sc.SpanID().String()),
+		)
+	}
+	return l
+}
+
 func Sync() {
 	if log != nil {
 		_ = log.Sync()
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds everything served on /metrics.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+// GRPCServer and GRPCClient count calls and time them per method and status
+// code. GRPCClient sees every attempt of a retried call.
+var (
+	GRPCServer = grpcprom.NewServerMetrics(grpcprom.WithServerHandlingTimeHistogram())
+	GRPCClient = grpcprom.NewClientMetrics(grpcprom.WithClientHandlingTimeHistogram())
+)
+
+var (
+	// BookingsCreated counts new pending bookings by source: "direct" or
+	// "waitlist".
+	BookingsCreated = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "booking",
+		Name:      "bookings_created_total",
+		Help:      "Bookings created, by source.",
+	}, []string{"source"})
+
+	// BookingsCancelled counts bookings cancelled by reason: "user" or
+	// "event_cancelled".
+	BookingsCancelled = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "booking",
+		Name:      "bookings_cancelled_total",
+		Help:      "Bookings cancelled, by reason.",
+	}, []string{"reason"})
+
+	HoldsExpired = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: "booking",
+		Name:      "holds_expired_total",
+		Help:      "Pending bookings whose hold lapsed.",
+	})
+
+	// InsufficientSeats counts requests turned away because the event had
+	// too few seats left, by operation: "create" or "modify".
+	InsufficientSeats = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "booking",
+		Name:      "insufficient_seats_rejections_total",
+		Help:      "Requests rejected for lack of seats, by operation.",
+	}, []string{"operation"})
+
+	// CompensationFailures counts reservations that could not be released
+	// after their booking failed or expired, i.e. leaked seats, by
+	// operation: "create", "reserve", "cancel" or "expire".
+	CompensationFailures = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "booking",
+		Name:      "compensation_failures_total",
+		Help:      "Seat releases that failed after retries, by operation.",
+	}, []string{"operation"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		GRPCServer,
+		GRPCClient,
+	)
+}
+
+// RegisterDB exports the connection pool stats of db.
+func RegisterDB(db *sql.DB, name string) {
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,113 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+)
+
+// Publisher delivers outbox messages to consumers. Delivery is at-least-once,
+// so consumers must tolerate duplicates, keyed by message ID.
+type Publisher interface {
+	Publish(ctx context.Context, msg *domain.OutboxMessage) error
+	Close() error
+}
+
+type Handler func(ctx context.Context, msg *domain.OutboxMessage) error
+
+// InProcessPublisher hands messages to handlers registered in the same process.
+// A handler error fails the publish, so the message is retried.
+type InProcessPublisher struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+func NewInProcessPublisher() *InProcessPublisher {
+	return &InProcessPublisher{}
+}
+
+func (p *InProcessPublisher) Subscribe(h Handler) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = append(p.handlers, h)
+}
+
+func (p *InProcessPublisher) Publish(ctx context.Context, msg *domain.OutboxMessage) error {
+	p.mu.RLock()
+	handlers := p.handlers
+	p.mu.RUnlock()
+
+	for _, h := range handlers {
+		if err := h(ctx, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *InProcessPublisher) Close() error {
+	return nil
+}
+
+// WriterPublisher writes each message as one JSON line. It backs the stdout and
+// file publishers used for local runs without a broker.
+type WriterPublisher struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+type writtenMessage struct {
+	ID          string          `json:"id"`
+	EventType   string          `json:"event_type"`
+	AggregateID string          `json:"aggregate_id"`
+	Payload     json.RawMessage `json:"payload"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+func NewWriterPublisher(w io.Writer) *WriterPublisher {
+	return &WriterPublisher{w: w}
+}
+
+func NewStdoutPublisher() *WriterPublisher {
+	return NewWriterPublisher(os.Stdout)
+}
+
+func NewFilePublisher(path string) (*WriterPublisher, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &WriterPublisher{w: f, closer: f}, nil
+}
+
+func (p *WriterPublisher) Publish(_ context.Context, msg *domain.OutboxMessage) error {
+	line, err := json.Marshal(writtenMessage{
+		ID:          msg.ID,
+		EventType:   msg.EventType,
+		AggregateID: msg.AggregateID,
+		Payload:     msg.Payload,
+		CreatedAt:   msg.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, err = p.w.Write(line)
+	return err
+}
+
+func (p *WriterPublisher) Close() error {
+	if p.closer != nil {
+		return p.closer.Close()
+	}
+	return nil
+}
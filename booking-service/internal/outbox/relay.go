@@ -0,0 +1,107 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultLease     = 30 * time.Second
+	maxRetryBackoff  = 5 * time.Minute
+	baseRetryBackoff = time.Second
+)
+
+// Relay polls the outbox and publishes pending messages. A message is marked
+// published only after Publish succeeds, giving at-least-once delivery.
+type Relay struct {
+	repo      domain.OutboxRepository
+	publisher Publisher
+	interval  time.Duration
+	batchSize int
+	now       func() time.Time
+}
+
+func NewRelay(repo domain.OutboxRepository, publisher Publisher, interval time.Duration, batchSize int) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		interval:  interval,
+		batchSize: batchSize,
+		now:       time.Now,
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	logger.Info("Outbox relay started", zap.Duration("interval", r.interval))
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+			for {
+				published, err := r.RelayBatch(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						logger.Error("Outbox relay: batch failed", zap.Error(err))
+					}
+					break
+				}
+				if published < r.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// RelayBatch claims and publishes one batch. It returns how many messages were
+// claimed; failed messages are rescheduled with exponential backoff.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	messages, err := r.repo.ClaimBatch(ctx, r.batchSize, r.now().Add(defaultLease))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, msg := range messages {
+		if err := r.publisher.Publish(ctx, msg); err != nil {
+			retryAt := r.now().Add(retryBackoff(msg.Attempts))
+			logger.Warn("Outbox relay: publish failed",
+				zap.String("messageID", msg.ID),
+				zap.String("eventType", msg.EventType),
+				zap.Int32("attempts", msg.Attempts+1),
+				zap.Time("retryAt", retryAt),
+				zap.Error(err),
+			)
+			if err := r.repo.MarkFailed(ctx, msg.ID, retryAt, err.Error()); err != nil {
+				return len(messages), err
+			}
+			continue
+		}
+
+		if err := r.repo.MarkPublished(ctx, msg.ID, r.now()); err != nil {
+			// The message will be published again once its lease runs out.
+			return len(messages), err
+		}
+	}
+
+	return len(messages), nil
+}
+
+func retryBackoff(attempts int32) time.Duration {
+	backoff := baseRetryBackoff
+	for i := int32(0); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= maxRetryBackoff {
+			return maxRetryBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,114 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testNow = time.Date(2026, 3, 15, 8, 0, 0, 0, time.UTC)
+
+func newTestRelay(publisher Publisher) (*Relay, *mocks.MockOutboxRepository) {
+	repo := new(mocks.MockOutboxRepository)
+	relay := NewRelay(repo, publisher, time.Second, 10)
+	relay.now = func() time.Time { return testNow }
+	return relay, repo
+}
+
+func TestRelayBatch_PublishesAndMarks(t *testing.T) {
+	publisher := NewInProcessPublisher()
+	var received []string
+	publisher.Subscribe(func(_ context.Context, msg *domain.OutboxMessage) error {
+		received = append(received, msg.ID)
+		return nil
+	})
+	relay, repo := newTestRelay(publisher)
+	ctx := context.Background()
+
+	messages := []*domain.OutboxMessage{
+		{ID: "m-1", EventType: domain.BookingEventCreated},
+		{ID: "m-2", EventType: domain.BookingEventConfirmed},
+	}
+	repo.On("ClaimBatch", ctx, 10, testNow.Add(defaultLease)).Return(messages, nil)
+	repo.On("MarkPublished", ctx, "m-1", testNow).Return(nil)
+	repo.On("MarkPublished", ctx, "m-2", testNow).Return(nil)
+
+	count, err := relay.RelayBatch(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{"m-1", "m-2"}, received)
+	repo.AssertExpectations(t)
+}
+
+func TestRelayBatch_PublishFailureReschedules(t *testing.T) {
+	publisher := NewInProcessPublisher()
+	publisher.Subscribe(func(_ context.Context, msg *domain.OutboxMessage) error {
+		if msg.ID == "m-1" {
+			return errors.New("broker down")
+		}
+		return nil
+	})
+	relay, repo := newTestRelay(publisher)
+	ctx := context.Background()
+
+	messages := []*domain.OutboxMessage{
+		{ID: "m-1", Attempts: 2},
+		{ID: "m-2"},
+	}
+	repo.On("ClaimBatch", ctx, 10, testNow.Add(defaultLease)).Return(messages, nil)
+	repo.On("MarkFailed", ctx, "m-1", testNow.Add(4*time.Second), "broker down").Return(nil)
+	repo.On("MarkPublished", ctx, "m-2", testNow).Return(nil)
+
+	count, err := relay.RelayBatch(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "MarkPublished", ctx, "m-1", mock.Anything)
+}
+
+func TestRelayBatch_ClaimError(t *testing.T) {
+	relay, repo := newTestRelay(NewInProcessPublisher())
+	ctx := context.Background()
+
+	repo.On("ClaimBatch", ctx, 10, mock.Anything).Return(nil, errors.New("db error"))
+
+	count, err := relay.RelayBatch(ctx)
+
+	assert.Error(t, err)
+	assert.Zero(t, count)
+}
+
+func TestRetryBackoff_Capped(t *testing.T) {
+	assert.Equal(t, time.Second, retryBackoff(0))
+	assert.Equal(t, 8*time.Second, retryBackoff(3))
+	assert.Equal(t, maxRetryBackoff, retryBackoff(30))
+}
+
+func TestWriterPublisher_WritesJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	publisher := NewWriterPublisher(&buf)
+
+	err := publisher.Publish(context.Background(), &domain.OutboxMessage{
+		ID:          "m-1",
+		EventType:   domain.BookingEventCancelled,
+		AggregateID: "booking-1",
+		Payload:     []byte(`{"booking_id":"booking-1"}`),
+	})
+	assert.NoError(t, err)
+
+	var line map[string]any
+	assert.NoError(t, json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &line))
+	assert.Equal(t, "m-1", line["id"])
+	assert.Equal(t, domain.BookingEventCancelled, line["event_type"])
+	assert.Equal(t, "booking-1", line["payload"].(map[string]any)["booking_id"])
+}
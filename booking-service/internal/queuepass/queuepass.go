@@ -0,0 +1,106 @@
+package queuepass
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPass = errors.New("invalid queue pass")
+	ErrPassExpired = errors.New("queue pass expired")
+)
+
+// Signer issues and verifies the passes the waiting room hands to admitted
+// users. A pass names the event and the user and is valid for the TTL after
+// admission.
+type Signer struct {
+	key []byte
+	ttl time.Duration
+}
+
+func NewSigner(key []byte, ttl time.Duration) *Signer {
+	return &Signer{key: key, ttl: ttl}
+}
+
+// LoadSigner reads the key from secretFile. Without a file a random key is
+// used, so passes are only accepted by the instance that issued them.
+func LoadSigner(secretFile string, ttl time.Duration) (*Signer, error) {
+	if secretFile == "" {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		return NewSigner(key, ttl), nil
+	}
+
+	data, err := os.ReadFile(secretFile)
+	if err != nil {
+		return nil, fmt.Errorf("queuepass: reading secret: %w", err)
+	}
+	key := bytes.TrimSpace(data)
+	if len(key) < 32 {
+		return nil, errors.New("queuepass: secret must be at least 32 bytes")
+	}
+	return NewSigner(key, ttl), nil
+}
+
+// Issue returns the pass of a user admitted to an event at admittedAt and when
+// it expires. Issuing again for the same admission gives the same pass.
+func (s *Signer) Issue(eventID, userID string, admittedAt time.Time) (string, time.Time) {
+	expiresAt := admittedAt.Add(s.ttl).Truncate(time.Second)
+	payload := eventID + "\n" + userID + "\n" + strconv.FormatInt(expiresAt.Unix(), 10)
+
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(payload)) + "." + enc.EncodeToString(s.sign(payload)), expiresAt
+}
+
+// Verify checks that pass was issued by this signer for the user and event
+// and has not expired at now.
+func (s *Signer) Verify(pass, eventID, userID string, now time.Time) error {
+	enc := base64.RawURLEncoding
+	encodedPayload, encodedMAC, ok := strings.Cut(pass, ".")
+	if !ok {
+		return ErrInvalidPass
+	}
+	payload, err := enc.DecodeString(encodedPayload)
+	if err != nil {
+		return ErrInvalidPass
+	}
+	mac, err := enc.DecodeString(encodedMAC)
+	if err != nil || !hmac.Equal(mac, s.sign(string(payload))) {
+		return ErrInvalidPass
+	}
+
+	fields := strings.Split(string(payload), "\n")
+	if len(fields) != 3 || fields[0] != eventID || fields[1] != userID {
+		return ErrInvalidPass
+	}
+	expires, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return ErrInvalidPass
+	}
+	if !now.Before(time.Unix(expires, 0)) {
+		return ErrPassExpired
+	}
+	return nil
+}
+
+// TTL is how long a pass stays valid after admission.
+func (s *Signer) TTL() time.Duration {
+	return s.ttl
+}
+
+func (s *Signer) sign(payload string) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
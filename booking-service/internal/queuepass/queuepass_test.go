@@ -0,0 +1,55 @@
+package queuepass
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var admittedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestSigner() *Signer {
+	return NewSigner([]byte(strings.Repeat("k", 32)), 5*time.Minute)
+}
+
+func TestIssueVerify(t *testing.T) {
+	s := newTestSigner()
+
+	pass, expiresAt := s.Issue("event-1", "user-1", admittedAt)
+
+	assert.Equal(t, admittedAt.Add(5*time.Minute), expiresAt)
+	assert.NoError(t, s.Verify(pass, "event-1", "user-1", admittedAt.Add(time.Minute)))
+	again, _ := s.Issue("event-1", "user-1", admittedAt)
+	assert.Equal(t, pass, again)
+}
+
+func TestVerify_Rejects(t *testing.T) {
+	s := newTestSigner()
+	pass, _ := s.Issue("event-1", "user-1", admittedAt)
+	other, _ := NewSigner([]byte(strings.Repeat("x", 32)), 5*time.Minute).Issue("event-1", "user-1", admittedAt)
+	now := admittedAt.Add(time.Minute)
+
+	assert.ErrorIs(t, s.Verify(pass, "event-2", "user-1", now), ErrInvalidPass)
+	assert.ErrorIs(t, s.Verify(pass, "event-1", "user-2", now), ErrInvalidPass)
+	assert.ErrorIs(t, s.Verify(other, "event-1", "user-1", now), ErrInvalidPass)
+	assert.ErrorIs(t, s.Verify("garbage", "event-1", "user-1", now), ErrInvalidPass)
+	assert.ErrorIs(t, s.Verify(pass, "event-1", "user-1", admittedAt.Add(5*time.Minute)), ErrPassExpired)
+}
+
+func TestLoadSigner(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secret")
+	os.WriteFile(path, []byte(strings.Repeat("k", 32)+"\n"), 0o600)
+
+	s, err := LoadSigner(path, 5*time.Minute)
+	assert.NoError(t, err)
+	pass, _ := s.Issue("event-1", "user-1", admittedAt)
+	assert.NoError(t, newTestSigner().Verify(pass, "event-1", "user-1", admittedAt))
+
+	os.WriteFile(path, []byte("short"), 0o600)
+	_, err = LoadSigner(path, 5*time.Minute)
+	assert.Error(t, err)
+}
@@ -0,0 +1,163 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RetryAfterHeader tells a limited caller, in whole seconds, when to try
+// again. It is set in the gRPC response header and on HTTP 429 responses.
+const RetryAfterHeader = "retry-after"
+
+// Limiter limits calls of some methods per authenticated user and per client
+// IP address.
+type Limiter struct {
+	store   Store
+	user    Limit
+	ip      Limit
+	methods []string
+}
+
+// NewLimiter limits calls of methods, given as full gRPC method names. The
+// user limit only applies to authenticated calls.
+func NewLimiter(store Store, user, ip Limit, methods ...string) *Limiter {
+	return &Limiter{
+		store:   store,
+		user:    withBurst(user),
+		ip:      withBurst(ip),
+		methods: methods,
+	}
+}
+
+// withBurst lets an enabled limit through at least one call at a time.
+func withBurst(l Limit) Limit {
+	if l.enabled() && l.Burst < 1 {
+		l.Burst = 1
+	}
+	return l
+}
+
+// UnaryServerInterceptor rejects calls over the limit with ResourceExhausted.
+// It must run after authentication so the caller is known.
+func UnaryServerInterceptor(l *Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var addr string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			addr = p.Addr.String()
+		}
+
+		if wait := l.check(ctx, info.FullMethod, addr); wait > 0 {
+			grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, retryAfter(wait)))
+			return nil, exhausted(wait).Err()
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Middleware does for the gateway what UnaryServerInterceptor does for gRPC,
+// answering 429 with a Retry-After header. routes, from auth.GatewayRoutes,
+// tells which RPC a request is for.
+func Middleware(l *Limiter, routes map[string]string) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			var method string
+			if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+				method = routes[r.Method+" "+pattern.String()]
+			}
+
+			if wait := l.check(r.Context(), method, r.RemoteAddr); wait > 0 {
+				body, _ := protojson.Marshal(exhausted(wait).Proto())
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set(RetryAfterHeader, retryAfter(wait))
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write(body)
+				return
+			}
+
+			next(w, r, pathParams)
+		}
+	}
+}
+
+// charge is a bucket a call counts against.
+type charge struct {
+	key   string
+	limit Limit
+}
+
+// check spends a token from each bucket the call counts against and returns
+// how long the caller must wait if one was empty. A refused call costs
+// nothing: it stops at the first empty bucket and refunds the ones before. The
+// store failing lets the call through: losing the limit is better than losing
+// bookings.
+func (l *Limiter) check(ctx context.Context, method, addr string) time.Duration {
+	if !slices.Contains(l.methods, method) {
+		return 0
+	}
+
+	var charges []charge
+	if host := clientIP(addr); l.ip.enabled() && host != "" {
+		charges = append(charges, charge{"ip:" + host, l.ip})
+	}
+	if identity, ok := auth.FromContext(ctx); ok && l.user.enabled() {
+		charges = append(charges, charge{"user:" + identity.Subject, l.user})
+	}
+
+	var taken []charge
+	for _, c := range charges {
+		wait, err := l.store.Take(ctx, c.key, c.limit)
+		if err != nil {
+			logger.FromContext(ctx).Warn("ratelimit: store failed, allowing call", zap.String("key", c.key), zap.Error(err))
+			continue
+		}
+		if wait > 0 {
+			for _, t := range taken {
+				if err := l.store.Refund(ctx, t.key, t.limit); err != nil {
+					logger.FromContext(ctx).Warn("ratelimit: refund failed", zap.String("key", t.key), zap.Error(err))
+				}
+			}
+			return wait
+		}
+		taken = append(taken, c)
+	}
+	return 0
+}
+
+func clientIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+func exhausted(wait time.Duration) *status.Status {
+	st := status.New(codes.ResourceExhausted, "rate limit exceeded, retry after "+retryAfter(wait)+"s")
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)}); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// retryAfter rounds wait up to whole seconds, as Retry-After takes.
+func retryAfter(wait time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(wait.Seconds())))
+}
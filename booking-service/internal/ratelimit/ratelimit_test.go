@@ -0,0 +1,178 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const createBooking = "/booking.BookingService/CreateBooking"
+
+func newTestStore(now *time.Time) *MemoryStore {
+	s := NewMemoryStore()
+	s.now = func() time.Time { return *now }
+	s.lastSweep = *now
+	return s
+}
+
+func TestMemoryStore_BurstThenRefill(t *testing.T) {
+	now := time.Now()
+	s := newTestStore(&now)
+	limit := Limit{Rate: 2, Burst: 2}
+	ctx := context.Background()
+
+	for range 2 {
+		wait, err := s.Take(ctx, "user:u1", limit)
+		assert.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+	wait, _ := s.Take(ctx, "user:u1", limit)
+	assert.Equal(t, 500*time.Millisecond, wait)
+
+	now = now.Add(500 * time.Millisecond)
+	wait, _ = s.Take(ctx, "user:u1", limit)
+	assert.Zero(t, wait)
+
+	wait, _ = s.Take(ctx, "user:u2", limit)
+	assert.Zero(t, wait, "buckets are per key")
+}
+
+func TestMemoryStore_SweepsFullBuckets(t *testing.T) {
+	now := time.Now()
+	s := newTestStore(&now)
+	limit := Limit{Rate: 1, Burst: 1}
+
+	s.Take(context.Background(), "ip:10.0.0.1", limit)
+	now = now.Add(sweepInterval)
+	s.Take(context.Background(), "ip:10.0.0.2", limit)
+
+	assert.NotContains(t, s.buckets, "ip:10.0.0.1")
+	assert.Contains(t, s.buckets, "ip:10.0.0.2")
+}
+
+// call runs the interceptor for method as user from addr.
+func call(l *Limiter, method, user, addr string) error {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+	if user != "" {
+		ctx = auth.WithIdentity(ctx, &auth.Identity{Subject: user})
+	}
+	_, err := UnaryServerInterceptor(l)(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestUnaryServerInterceptor_PerUser(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), Limit{Rate: 0.1, Burst: 1}, Limit{}, createBooking)
+
+	assert.NoError(t, call(l, createBooking, "user-1", "10.0.0.1"))
+	err := call(l, createBooking, "user-1", "10.0.0.2")
+	assert.NoError(t, call(l, createBooking, "user-2", "10.0.0.1"))
+
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		info := st.Details()[0].(*errdetails.RetryInfo)
+		assert.InDelta(t, 10*time.Second, info.RetryDelay.AsDuration(), float64(time.Second))
+	}
+}
+
+func TestUnaryServerInterceptor_PerIP(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), Limit{}, Limit{Rate: 0.1, Burst: 2}, createBooking)
+
+	assert.NoError(t, call(l, createBooking, "user-1", "10.0.0.1"))
+	assert.NoError(t, call(l, createBooking, "", "10.0.0.1"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(l, createBooking, "user-2", "10.0.0.1")))
+	assert.NoError(t, call(l, createBooking, "user-2", "10.0.0.2"))
+}
+
+func TestUnaryServerInterceptor_RefusedCallsCostNothing(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), Limit{Rate: 0.1, Burst: 1}, Limit{Rate: 0.1, Burst: 1}, createBooking)
+
+	// The IP is used up by another user; refusing user-1 there leaves their
+	// own bucket alone.
+	assert.NoError(t, call(l, createBooking, "user-2", "10.0.0.1"))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(l, createBooking, "user-1", "10.0.0.1")))
+	assert.NoError(t, call(l, createBooking, "user-1", "10.0.0.2"))
+
+	// Now user-1 is used up; refusing them on a fresh IP gives its token back.
+	assert.Equal(t, codes.ResourceExhausted, status.Code(call(l, createBooking, "user-1", "10.0.0.3")))
+	assert.NoError(t, call(l, createBooking, "user-3", "10.0.0.3"))
+}
+
+func TestMemoryStore_Refund(t *testing.T) {
+	now := time.Now()
+	s := newTestStore(&now)
+	limit := Limit{Rate: 1, Burst: 1}
+	ctx := context.Background()
+
+	s.Take(ctx, "ip:10.0.0.1", limit)
+	assert.NoError(t, s.Refund(ctx, "ip:10.0.0.1", limit))
+	wait, _ := s.Take(ctx, "ip:10.0.0.1", limit)
+	assert.Zero(t, wait)
+
+	// Refunds never overfill a bucket.
+	s.Refund(ctx, "ip:10.0.0.1", limit)
+	s.Refund(ctx, "ip:10.0.0.1", limit)
+	s.Take(ctx, "ip:10.0.0.1", limit)
+	wait, _ = s.Take(ctx, "ip:10.0.0.1", limit)
+	assert.Equal(t, time.Second, wait)
+}
+
+func TestUnaryServerInterceptor_OtherMethods(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), Limit{Rate: 0.1, Burst: 1}, Limit{}, createBooking)
+
+	for range 3 {
+		assert.NoError(t, call(l, "/booking.BookingService/GetBooking", "user-1", "10.0.0.1"))
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Limit) (time.Duration, error) {
+	return 0, errors.New("store down")
+}
+
+func (failingStore) Refund(context.Context, string, Limit) error {
+	return errors.New("store down")
+}
+
+func TestUnaryServerInterceptor_StoreFailureAllows(t *testing.T) {
+	l := NewLimiter(failingStore{}, Limit{Rate: 0.1, Burst: 1}, Limit{Rate: 0.1, Burst: 1}, createBooking)
+
+	assert.NoError(t, call(l, createBooking, "user-1", "10.0.0.1"))
+}
+
+func TestMiddleware_TooManyRequests(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), Limit{}, Limit{Rate: 0.5, Burst: 1}, createBooking)
+	// Outside a gateway mux there is no route pattern, so the method is "".
+	l.methods = append(l.methods, "")
+
+	serve := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/v1/bookings", nil)
+		req.RemoteAddr = "10.0.0.1:40000"
+		rec := httptest.NewRecorder()
+		Middleware(l, nil)(func(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+			w.WriteHeader(http.StatusOK)
+		})(rec, req, nil)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, serve().Code)
+	rec := serve()
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+	assert.Contains(t, rec.Body.String(), "rate limit exceeded")
+}
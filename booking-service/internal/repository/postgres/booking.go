@@ -3,12 +3,17 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const bookingColumns = `id, user_id, event_id, reservation_id, legacy_hold, ticket_count, seat_ids, items, total_price_minor, currency, status, expires_at, created_at`
+
 type BookingRepository struct {
 	db *sql.DB
 }
@@ -18,44 +23,55 @@ func NewBookingRepository(db *sql.DB) *BookingRepository {
 }
 
 func (r *BookingRepository) Create(ctx context.Context, booking *domain.Booking) error {
-	booking.ID = uuid.New().String()
-	booking.CreatedAt = time.Now()
-	booking.Status = domain.BookingStatusPending
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		return insertBooking(ctx, tx, booking)
+	})
+}
+
+func (r *BookingRepository) CreateWithinLimit(ctx context.Context, booking *domain.Booking, limit int32) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// Without the lock, concurrent bookings of the same user would each
+		// see the total from before the other.
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2))`, booking.UserID, booking.EventID); err != nil {
+			return err
+		}
+
+		held, err := countLiveTickets(ctx, tx, booking.UserID, booking.EventID)
+		if err != nil {
+			return err
+		}
+		if held+booking.TicketCount > limit {
+			return domain.ErrTicketLimitExceeded
+		}
 
+		return insertBooking(ctx, tx, booking)
+	})
+}
+
+func (r *BookingRepository) CountLiveTickets(ctx context.Context, userID, eventID string) (int32, error) {
+	return countLiveTickets(ctx, r.db, userID, eventID)
+}
+
+func countLiveTickets(ctx context.Context, q execer, userID, eventID string) (int32, error) {
 	query := `
-		INSERT INTO bookings (id, user_id, event_id, ticket_count, status, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		SELECT COALESCE(SUM(ticket_count), 0)
+		FROM bookings
+		WHERE user_id = $1 AND event_id = $2 AND status IN ($3, $4)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
-		booking.ID,
-		booking.UserID,
-		booking.EventID,
-		booking.TicketCount,
-		booking.Status,
-		booking.CreatedAt,
-	)
-
-	return err
+	var held int32
+	err := q.QueryRowContext(ctx, query, userID, eventID, domain.BookingStatusPending, domain.BookingStatusConfirmed).Scan(&held)
+	return held, err
 }
 
 func (r *BookingRepository) GetByID(ctx context.Context, id string) (*domain.Booking, error) {
 	query := `
-		SELECT id, user_id, event_id, ticket_count, status, created_at
+		SELECT ` + bookingColumns + `
 		FROM bookings
 		WHERE id = $1
 	`
 
-	booking := &domain.Booking{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&booking.ID,
-		&booking.UserID,
-		&booking.EventID,
-		&booking.TicketCount,
-		&booking.Status,
-		&booking.CreatedAt,
-	)
-
+	booking, err := scanBooking(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -66,55 +82,423 @@ func (r *BookingRepository) GetByID(ctx context.Context, id string) (*domain.Boo
 	return booking, nil
 }
 
-func (r *BookingRepository) ListByUserID(ctx context.Context, userID string) ([]*domain.Booking, error) {
+func (r *BookingRepository) ListByUserID(ctx context.Context, userID string, filter domain.BookingFilter, order domain.SortOrder, after *domain.PageCursor, limit int32) ([]*domain.Booking, error) {
+	// Only created_at is backed by an index on (user_id, created_at, id).
+	if order.Column != "created_at" {
+		return nil, fmt.Errorf("postgres: cannot sort bookings by %q", order.Column)
+	}
+
+	var q listQuery
+	q.where("user_id = " + q.arg(userID))
+	if !filter.CreatedFrom.IsZero() {
+		q.where("created_at >= " + q.arg(filter.CreatedFrom))
+	}
+	if !filter.CreatedBefore.IsZero() {
+		q.where("created_at < " + q.arg(filter.CreatedBefore))
+	}
+	if filter.Status != domain.BookingStatusUnspecified {
+		q.where("status = " + q.arg(filter.Status))
+	}
+	if filter.EventID != "" {
+		q.where("event_id = " + q.arg(filter.EventID))
+	}
+	q.after(order, after)
+
+	query := `SELECT ` + bookingColumns + ` FROM bookings` + q.clauses(order, limit)
+	rows, err := r.db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBookings(rows)
+}
+
+// CancelLive cancels a booking only while it is pending or confirmed. It
+// reports false if it was already cancelled or expired, so of concurrent
+// cancels and the reaper only one releases the booking's seats.
+func (r *BookingRepository) CancelLive(ctx context.Context, id string, now time.Time) (bool, error) {
 	query := `
-		SELECT id, user_id, event_id, ticket_count, status, created_at
-		FROM bookings
-		WHERE user_id = $1
-		ORDER BY created_at DESC
+		UPDATE bookings
+		SET status = $1
+		WHERE id = $2 AND status IN ($3, $4)
+		RETURNING ` + bookingColumns
+
+	cancelled := false
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		booking, err := scanBooking(tx.QueryRowContext(ctx, query,
+			domain.BookingStatusCancelled,
+			id,
+			domain.BookingStatusPending,
+			domain.BookingStatusConfirmed,
+		))
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		cancelled = true
+		return insertBookingEvent(ctx, tx, booking, now)
+	})
+
+	return cancelled, err
+}
+
+// ConfirmPending confirms a booking only while its hold is still live, so a
+// confirmation can never race the reaper into confirming released seats.
+func (r *BookingRepository) ConfirmPending(ctx context.Context, id string, now time.Time) (bool, error) {
+	query := `
+		UPDATE bookings
+		SET status = $1
+		WHERE id = $2 AND status = $3 AND (expires_at IS NULL OR expires_at > $4)
+		RETURNING ` + bookingColumns
+
+	confirmed := false
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		booking, err := scanBooking(tx.QueryRowContext(ctx, query,
+			domain.BookingStatusConfirmed,
+			id,
+			domain.BookingStatusPending,
+			now,
+		))
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		confirmed = true
+		return insertBookingEvent(ctx, tx, booking, now)
+	})
+
+	return confirmed, err
+}
+
+// ExpirePending marks up to limit stale pending bookings as expired and returns
+// them. SKIP LOCKED lets several reaper replicas claim disjoint batches.
+func (r *BookingRepository) ExpirePending(ctx context.Context, now time.Time, limit int) ([]*domain.Booking, error) {
+	query := `
+		UPDATE bookings
+		SET status = $1
+		WHERE id IN (
+			SELECT id
+			FROM bookings
+			WHERE status = $2 AND expires_at <= $3
+			ORDER BY expires_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + bookingColumns
+
+	var bookings []*domain.Booking
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query,
+			domain.BookingStatusExpired,
+			domain.BookingStatusPending,
+			now,
+			limit,
+		)
+		if err != nil {
+			return err
+		}
+		bookings, err = scanBookings(rows)
+		rows.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, booking := range bookings {
+			if err := insertBookingEvent(ctx, tx, booking, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+func (r *BookingRepository) CancelByEventID(ctx context.Context, eventID string, now time.Time) ([]*domain.Booking, error) {
+	query := `
+		UPDATE bookings
+		SET status = $1
+		WHERE event_id = $2 AND status IN ($3, $4)
+		RETURNING ` + bookingColumns
+
+	var bookings []*domain.Booking
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, query,
+			domain.BookingStatusCancelled,
+			eventID,
+			domain.BookingStatusPending,
+			domain.BookingStatusConfirmed,
+		)
+		if err != nil {
+			return err
+		}
+		bookings, err = scanBookings(rows)
+		rows.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, booking := range bookings {
+			if err := insertBookingEvent(ctx, tx, booking, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+func (r *BookingRepository) Modify(ctx context.Context, booking *domain.Booking, adjustment *domain.BookingAdjustment, limit int32) (bool, error) {
+	items, err := itemsJSON(booking.Items)
+	if err != nil {
+		return false, err
+	}
+
+	adjustment.ID = uuid.New().String()
+	adjustment.BookingID = booking.ID
+
+	// A pending booking whose hold has lapsed belongs to the reaper, even
+	// before it has been marked expired.
+	query := `
+		UPDATE bookings
+		SET ticket_count = $1, items = $2, total_price_minor = $3, currency = $4
+		WHERE id = $5 AND ticket_count = $6
+			AND (status = $7 OR (status = $8 AND (expires_at IS NULL OR expires_at > $9)))
+		RETURNING ` + bookingColumns
+
+	modified := false
+	err = withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if limit > 0 && adjustment.NewCount > adjustment.PreviousCount {
+			// The same lock as CreateWithinLimit, so a new booking and this
+			// increase cannot both fit under the limit on their own.
+			if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2))`, booking.UserID, booking.EventID); err != nil {
+				return err
+			}
+
+			held, err := countLiveTickets(ctx, tx, booking.UserID, booking.EventID)
+			if err != nil {
+				return err
+			}
+			if held-adjustment.PreviousCount+adjustment.NewCount > limit {
+				return domain.ErrTicketLimitExceeded
+			}
+		}
+
+		updated, err := scanBooking(tx.QueryRowContext(ctx, query,
+			booking.TicketCount,
+			items,
+			booking.TotalPriceMinor,
+			booking.Currency,
+			booking.ID,
+			adjustment.PreviousCount,
+			domain.BookingStatusConfirmed,
+			domain.BookingStatusPending,
+			adjustment.CreatedAt,
+		))
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO booking_adjustments (id, booking_id, previous_count, new_count, previous_total_minor, new_total_minor, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+		`,
+			adjustment.ID,
+			adjustment.BookingID,
+			adjustment.PreviousCount,
+			adjustment.NewCount,
+			adjustment.PreviousTotalMinor,
+			adjustment.NewTotalMinor,
+			adjustment.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		modified = true
+		return insertBookingEventType(ctx, tx, domain.BookingEventModified, updated, adjustment.CreatedAt)
+	})
+
+	return modified, err
+}
+
+func (r *BookingRepository) ListAdjustments(ctx context.Context, bookingID string) ([]*domain.BookingAdjustment, error) {
+	query := `
+		SELECT id, booking_id, previous_count, new_count, previous_total_minor, new_total_minor, created_at
+		FROM booking_adjustments
+		WHERE booking_id = $1
+		ORDER BY created_at, id
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, userID)
+	rows, err := r.db.QueryContext(ctx, query, bookingID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var bookings []*domain.Booking
+	var adjustments []*domain.BookingAdjustment
 	for rows.Next() {
-		booking := &domain.Booking{}
+		adjustment := &domain.BookingAdjustment{}
 		err := rows.Scan(
-			&booking.ID,
-			&booking.UserID,
-			&booking.EventID,
-			&booking.TicketCount,
-			&booking.Status,
-			&booking.CreatedAt,
+			&adjustment.ID,
+			&adjustment.BookingID,
+			&adjustment.PreviousCount,
+			&adjustment.NewCount,
+			&adjustment.PreviousTotalMinor,
+			&adjustment.NewTotalMinor,
+			&adjustment.CreatedAt,
 		)
 		if err != nil {
 			return nil, err
 		}
-		bookings = append(bookings, booking)
+		adjustments = append(adjustments, adjustment)
 	}
 
-	return bookings, rows.Err()
+	return adjustments, rows.Err()
 }
 
-func (r *BookingRepository) UpdateStatus(ctx context.Context, id string, status domain.BookingStatus) error {
-	query := `UPDATE bookings SET status = $1 WHERE id = $2`
-	result, err := r.db.ExecContext(ctx, query, status, id)
+func (r *BookingRepository) RecordCompensation(ctx context.Context, compensation *domain.Compensation) error {
+	compensation.ID = uuid.New().String()
+	compensation.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO booking_compensations (id, user_id, event_id, reservation_id, ticket_count, reason, attempts, succeeded, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		compensation.ID,
+		compensation.UserID,
+		compensation.EventID,
+		compensation.ReservationID,
+		compensation.TicketCount,
+		compensation.Reason,
+		compensation.Attempts,
+		compensation.Succeeded,
+		compensation.CreatedAt,
+	)
+
+	return err
+}
+
+// insertBooking stores a new pending booking together with its
+// booking.created outbox message.
+func insertBooking(ctx context.Context, tx *sql.Tx, booking *domain.Booking) error {
+	booking.ID = uuid.New().String()
+	booking.CreatedAt = time.Now()
+	booking.Status = domain.BookingStatusPending
+
+	items, err := itemsJSON(booking.Items)
 	if err != nil {
 		return err
 	}
 
-	rowsAffected, err := result.RowsAffected()
+	query := `
+		INSERT INTO bookings (id, user_id, event_id, reservation_id, ticket_count, seat_ids, items, total_price_minor, currency, status, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+	_, err = tx.ExecContext(ctx, query,
+		booking.ID,
+		booking.UserID,
+		booking.EventID,
+		booking.ReservationID,
+		booking.TicketCount,
+		seatIDsArray(booking.SeatIDs),
+		items,
+		booking.TotalPriceMinor,
+		booking.Currency,
+		booking.Status,
+		nullTime(booking.ExpiresAt),
+		booking.CreatedAt,
+	)
 	if err != nil {
 		return err
 	}
 
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
+	return insertBookingEvent(ctx, tx, booking, booking.CreatedAt)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBooking(row rowScanner) (*domain.Booking, error) {
+	booking := &domain.Booking{}
+	var items []byte
+	var expiresAt sql.NullTime
+	err := row.Scan(
+		&booking.ID,
+		&booking.UserID,
+		&booking.EventID,
+		&booking.ReservationID,
+		&booking.LegacyHold,
+		&booking.TicketCount,
+		pq.Array(&booking.SeatIDs),
+		&items,
+		&booking.TotalPriceMinor,
+		&booking.Currency,
+		&booking.Status,
+		&expiresAt,
+		&booking.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
 	}
+	if err := json.Unmarshal(items, &booking.Items); err != nil {
+		return nil, err
+	}
+	booking.ExpiresAt = expiresAt.Time
+
+	return booking, nil
+}
+
+func scanBookings(rows *sql.Rows) ([]*domain.Booking, error) {
+	var bookings []*domain.Booking
+	for rows.Next() {
+		booking, err := scanBooking(rows)
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+
+	return bookings, rows.Err()
+}
 
-	return nil
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+// seatIDsArray encodes seat IDs for a NOT NULL text[] column; pq encodes a nil
+// slice as NULL.
+func seatIDsArray(ids []string) any {
+	if ids == nil {
+		ids = []string{}
+	}
+	return pq.Array(ids)
+}
+
+// itemsJSON encodes booking items for the NOT NULL jsonb column.
+func itemsJSON(items []*domain.BookingItem) ([]byte, error) {
+	if items == nil {
+		items = []*domain.BookingItem{}
+	}
+	return json.Marshal(items)
 }
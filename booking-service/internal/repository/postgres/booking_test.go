@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDB connects to the migrated database in TEST_DATABASE_URL and skips the
+// test when it is not set.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	db, err := sql.Open("postgres", url)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	require.NoError(t, db.Ping())
+	return db
+}
+
+func TestBookingRepository_ConcurrentCreateAndModifyStayWithinLimit(t *testing.T) {
+	repo := NewBookingRepository(testDB(t))
+	ctx := context.Background()
+	const limit = 4
+
+	for i := 0; i < 20; i++ {
+		userID := uuid.New().String()
+		eventID := uuid.New().String()
+
+		existing := &domain.Booking{UserID: userID, EventID: eventID, ReservationID: uuid.New().String(), TicketCount: 2}
+		require.NoError(t, repo.CreateWithinLimit(ctx, existing, limit))
+
+		// Each fits on its own; together they would take the user to 6.
+		var createErr, modifyErr error
+		var modified bool
+		var wg sync.WaitGroup
+		start := make(chan struct{})
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			<-start
+			createErr = repo.CreateWithinLimit(ctx, &domain.Booking{
+				UserID:        userID,
+				EventID:       eventID,
+				ReservationID: uuid.New().String(),
+				TicketCount:   2,
+			}, limit)
+		}()
+		go func() {
+			defer wg.Done()
+			<-start
+			increased := *existing
+			increased.TicketCount = 4
+			modified, modifyErr = repo.Modify(ctx, &increased, &domain.BookingAdjustment{
+				PreviousCount: 2,
+				NewCount:      4,
+				CreatedAt:     time.Now(),
+			}, limit)
+		}()
+		close(start)
+		wg.Wait()
+
+		if createErr == nil {
+			assert.ErrorIs(t, modifyErr, domain.ErrTicketLimitExceeded)
+			assert.False(t, modified)
+		} else {
+			assert.ErrorIs(t, createErr, domain.ErrTicketLimitExceeded)
+			assert.NoError(t, modifyErr)
+			assert.True(t, modified)
+		}
+
+		held, err := repo.CountLiveTickets(ctx, userID, eventID)
+		require.NoError(t, err)
+		assert.Equal(t, int32(limit), held)
+	}
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+)
+
+type IdempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewIdempotencyRepository(db *sql.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record *domain.IdempotencyRecord) (*domain.IdempotencyRecord, error) {
+	// An expired record is taken over as if it did not exist; a live one is
+	// left untouched and returned to the caller.
+	query := `
+		INSERT INTO idempotency_keys (subject, key, operation, fingerprint, response, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, NULL, $5, $6)
+		ON CONFLICT (subject, key, operation) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint,
+			response = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+		RETURNING key
+	`
+
+	var key string
+	err := r.db.QueryRowContext(ctx, query,
+		record.Subject,
+		record.Key,
+		record.Operation,
+		record.Fingerprint,
+		record.CreatedAt,
+		record.ExpiresAt,
+	).Scan(&key)
+	if err == nil {
+		return nil, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	existing := &domain.IdempotencyRecord{}
+	err = r.db.QueryRowContext(ctx, `
+		SELECT subject, key, operation, fingerprint, response, created_at, expires_at
+		FROM idempotency_keys
+		WHERE subject = $1 AND key = $2 AND operation = $3
+	`, record.Subject, record.Key, record.Operation).Scan(
+		&existing.Subject,
+		&existing.Key,
+		&existing.Operation,
+		&existing.Fingerprint,
+		&existing.Response,
+		&existing.CreatedAt,
+		&existing.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, subject, key, operation string, response []byte) error {
+	query := `UPDATE idempotency_keys SET response = $1 WHERE subject = $2 AND key = $3 AND operation = $4`
+	result, err := r.db.ExecContext(ctx, query, response, subject, key, operation)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) Delete(ctx context.Context, subject, key, operation string) error {
+	query := `DELETE FROM idempotency_keys WHERE subject = $1 AND key = $2 AND operation = $3 AND response IS NULL`
+	_, err := r.db.ExecContext(ctx, query, subject, key, operation)
+	return err
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+	result, err := r.db.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+)
+
+// listQuery builds the WHERE, ORDER BY and LIMIT clauses of a keyset
+// paginated list. Column names must come from the caller's allow-list; only
+// values are passed as arguments.
+type listQuery struct {
+	conds []string
+	args  []any
+}
+
+// arg adds an argument and returns its placeholder.
+func (q *listQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *listQuery) where(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+// after skips the rows up to and including the cursor.
+func (q *listQuery) after(order domain.SortOrder, cursor *domain.PageCursor) {
+	if cursor == nil {
+		return
+	}
+	cmp := ">"
+	if order.Desc {
+		cmp = "<"
+	}
+	q.where(fmt.Sprintf("(%s, id) %s (%s, %s)", order.Column, cmp, q.arg(cursor.Key), q.arg(cursor.ID)))
+}
+
+func (q *listQuery) clauses(order domain.SortOrder, limit int32) string {
+	var b strings.Builder
+	if len(q.conds) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(q.conds, " AND "))
+	}
+	dir := "ASC"
+	if order.Desc {
+		dir = "DESC"
+	}
+	fmt.Fprintf(&b, " ORDER BY %s %s, id %s LIMIT %s", order.Column, dir, dir, q.arg(limit))
+	return b.String()
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+type OutboxRepository struct {
+	db *sql.DB
+}
+
+func NewOutboxRepository(db *sql.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+func (r *OutboxRepository) ClaimBatch(ctx context.Context, limit int, leaseUntil time.Time) ([]*domain.OutboxMessage, error) {
+	query := `
+		UPDATE outbox
+		SET locked_until = $1
+		WHERE id IN (
+			SELECT id
+			FROM outbox
+			WHERE published_at IS NULL AND (locked_until IS NULL OR locked_until < NOW())
+			ORDER BY created_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, event_type, aggregate_id, payload, attempts, created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []*domain.OutboxMessage
+	for rows.Next() {
+		msg := &domain.OutboxMessage{}
+		err := rows.Scan(
+			&msg.ID,
+			&msg.EventType,
+			&msg.AggregateID,
+			&msg.Payload,
+			&msg.Attempts,
+			&msg.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// UPDATE ... RETURNING does not preserve the subquery order.
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].CreatedAt.Before(messages[j].CreatedAt)
+	})
+	return messages, nil
+}
+
+func (r *OutboxRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
+	query := `UPDATE outbox SET published_at = $1, locked_until = NULL WHERE id = $2`
+	_, err := r.db.ExecContext(ctx, query, publishedAt, id)
+	return err
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id string, retryAt time.Time, lastError string) error {
+	query := `
+		UPDATE outbox
+		SET attempts = attempts + 1, locked_until = $1, last_error = $2
+		WHERE id = $3
+	`
+	_, err := r.db.ExecContext(ctx, query, retryAt, lastError, id)
+	return err
+}
+
+// insertBookingEvent writes the outbox row for a booking status change. It must
+// run on the same transaction as the change itself.
+func insertBookingEvent(ctx context.Context, q execer, booking *domain.Booking, occurredAt time.Time) error {
+	return insertBookingEventType(ctx, q, domain.BookingEventType(booking.Status), booking, occurredAt)
+}
+
+func insertBookingEventType(ctx context.Context, q execer, eventType string, booking *domain.Booking, occurredAt time.Time) error {
+	payload, err := json.Marshal(domain.BookingEvent{
+		Type:            eventType,
+		BookingID:       booking.ID,
+		UserID:          booking.UserID,
+		EventID:         booking.EventID,
+		TicketCount:     booking.TicketCount,
+		SeatIDs:         booking.SeatIDs,
+		Items:           booking.Items,
+		TotalPriceMinor: booking.TotalPriceMinor,
+		Currency:        booking.Currency,
+		Status:          booking.Status,
+		OccurredAt:      occurredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO outbox (id, event_type, aggregate_id, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err = q.ExecContext(ctx, query,
+		uuid.New().String(),
+		eventType,
+		booking.ID,
+		payload,
+		occurredAt,
+	)
+	return err
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+const queueTicketColumns = `id, event_id, user_id, admit_at, created_at`
+
+type QueueRepository struct {
+	db *sql.DB
+}
+
+func NewQueueRepository(db *sql.DB) *QueueRepository {
+	return &QueueRepository{db: db}
+}
+
+func (r *QueueRepository) Join(ctx context.Context, eventID, userID string, now, staleBefore time.Time, interval time.Duration) (*domain.QueueTicket, error) {
+	var ticket *domain.QueueTicket
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// Every join locks the event's queue row, so slots are handed out in
+		// join order and a user's concurrent joins cannot both add a ticket.
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO event_queues (event_id, next_admit_at)
+			VALUES ($1, $2)
+			ON CONFLICT (event_id) DO NOTHING
+		`, eventID, now)
+		if err != nil {
+			return err
+		}
+
+		var next time.Time
+		err = tx.QueryRowContext(ctx, `
+			SELECT next_admit_at FROM event_queues WHERE event_id = $1 FOR UPDATE
+		`, eventID).Scan(&next)
+		if err != nil {
+			return err
+		}
+
+		existing, err := scanQueueTicket(tx.QueryRowContext(ctx, `
+			SELECT `+queueTicketColumns+`
+			FROM queue_tickets
+			WHERE event_id = $1 AND user_id = $2
+		`, eventID, userID))
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if existing != nil && existing.AdmitAt.After(staleBefore) {
+			ticket = existing
+			return nil
+		}
+
+		ticket = &domain.QueueTicket{
+			ID:        uuid.New().String(),
+			EventID:   eventID,
+			UserID:    userID,
+			AdmitAt:   next,
+			CreatedAt: now,
+		}
+		if ticket.AdmitAt.Before(now) {
+			ticket.AdmitAt = now
+		}
+
+		// A lapsed ticket is replaced, sending the user to the back.
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO queue_tickets (id, event_id, user_id, admit_at, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (event_id, user_id)
+			DO UPDATE SET id = EXCLUDED.id, admit_at = EXCLUDED.admit_at, created_at = EXCLUDED.created_at
+		`, ticket.ID, ticket.EventID, ticket.UserID, ticket.AdmitAt, ticket.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE event_queues SET next_admit_at = $1 WHERE event_id = $2
+		`, ticket.AdmitAt.Add(interval), eventID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+func (r *QueueRepository) Get(ctx context.Context, ticketID string, now time.Time) (*domain.QueueTicket, int32, error) {
+	query := `
+		SELECT ` + queueTicketColumns + `,
+			(SELECT COUNT(*) FROM queue_tickets ahead
+			 WHERE ahead.event_id = queue_tickets.event_id
+			   AND ahead.admit_at > $2
+			   AND ahead.admit_at < queue_tickets.admit_at)
+		FROM queue_tickets
+		WHERE id = $1
+	`
+
+	var ahead int32
+	ticket, err := scanQueueTicket(r.db.QueryRowContext(ctx, query, ticketID, now), &ahead)
+	if err == sql.ErrNoRows {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ticket, ahead, nil
+}
+
+func (r *QueueRepository) DeleteLapsed(ctx context.Context, staleBefore time.Time) (int64, error) {
+	query := `DELETE FROM queue_tickets WHERE admit_at <= $1`
+	result, err := r.db.ExecContext(ctx, query, staleBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *QueueRepository) DeleteEvent(ctx context.Context, eventID string) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM queue_tickets WHERE event_id = $1`, eventID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `DELETE FROM event_queues WHERE event_id = $1`, eventID)
+		return err
+	})
+}
+
+func scanQueueTicket(row rowScanner, extra ...any) (*domain.QueueTicket, error) {
+	ticket := &domain.QueueTicket{}
+	dest := []any{
+		&ticket.ID,
+		&ticket.EventID,
+		&ticket.UserID,
+		&ticket.AdmitAt,
+		&ticket.CreatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
@@ -0,0 +1,198 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+const waitlistColumns = `id, event_id, user_id, ticket_count, ticket_type_id, status, booking_id, created_at`
+
+type WaitlistRepository struct {
+	db *sql.DB
+}
+
+func NewWaitlistRepository(db *sql.DB) *WaitlistRepository {
+	return &WaitlistRepository{db: db}
+}
+
+func (r *WaitlistRepository) Join(ctx context.Context, entry *domain.WaitlistEntry) (*domain.WaitlistEntry, error) {
+	entry.ID = uuid.New().String()
+	entry.CreatedAt = time.Now()
+	entry.Status = domain.WaitlistStatusWaiting
+
+	query := `
+		INSERT INTO waitlist_entries (id, event_id, user_id, ticket_count, ticket_type_id, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (event_id, user_id) WHERE status = 1 DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		entry.ID,
+		entry.EventID,
+		entry.UserID,
+		entry.TicketCount,
+		entry.TicketTypeID,
+		entry.Status,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected > 0 {
+		return entry, nil
+	}
+
+	existing, _, err := r.GetWaiting(ctx, entry.EventID, entry.UserID)
+	return existing, err
+}
+
+func (r *WaitlistRepository) GetWaiting(ctx context.Context, eventID, userID string) (*domain.WaitlistEntry, int32, error) {
+	query := `
+		SELECT ` + waitlistColumns + `,
+			(SELECT COUNT(*) FROM waitlist_entries ahead
+			 WHERE ahead.event_id = waitlist_entries.event_id
+			   AND ahead.status = waitlist_entries.status
+			   AND ahead.seq <= waitlist_entries.seq)
+		FROM waitlist_entries
+		WHERE event_id = $1 AND user_id = $2 AND status = $3
+	`
+
+	var position int32
+	entry, err := scanWaitlistEntry(r.db.QueryRowContext(ctx, query, eventID, userID, domain.WaitlistStatusWaiting), &position)
+	if err == sql.ErrNoRows {
+		return nil, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return entry, position, nil
+}
+
+func (r *WaitlistRepository) Leave(ctx context.Context, eventID, userID string) (bool, error) {
+	query := `
+		UPDATE waitlist_entries
+		SET status = $1
+		WHERE event_id = $2 AND user_id = $3 AND status = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, query, domain.WaitlistStatusLeft, eventID, userID, domain.WaitlistStatusWaiting)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *WaitlistRepository) Next(ctx context.Context, eventID string) (*domain.WaitlistEntry, error) {
+	query := `
+		SELECT ` + waitlistColumns + `
+		FROM waitlist_entries
+		WHERE event_id = $1 AND status = $2
+		ORDER BY seq
+		LIMIT 1
+	`
+
+	entry, err := scanWaitlistEntry(r.db.QueryRowContext(ctx, query, eventID, domain.WaitlistStatusWaiting))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (r *WaitlistRepository) Fulfill(ctx context.Context, entryID string, booking *domain.Booking) (domain.WaitlistStatus, error) {
+	var status domain.WaitlistStatus
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// Locking the entry keeps a concurrent Leave from slipping in between
+		// the check and the booking insert.
+		err := tx.QueryRowContext(ctx, `
+			SELECT status FROM waitlist_entries WHERE id = $1 FOR UPDATE
+		`, entryID).Scan(&status)
+		if err != nil {
+			return err
+		}
+		if status != domain.WaitlistStatusWaiting {
+			return nil
+		}
+
+		if err := insertBooking(ctx, tx, booking); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE waitlist_entries
+			SET status = $1, booking_id = $2
+			WHERE id = $3
+		`, domain.WaitlistStatusOffered, booking.ID, entryID)
+		if err != nil {
+			return err
+		}
+
+		status = domain.WaitlistStatusOffered
+		return nil
+	})
+	if err != nil {
+		return domain.WaitlistStatusUnspecified, err
+	}
+
+	return status, nil
+}
+
+func (r *WaitlistRepository) Expire(ctx context.Context, entryID string) (bool, error) {
+	query := `
+		UPDATE waitlist_entries
+		SET status = $1
+		WHERE id = $2 AND status = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, domain.WaitlistStatusExpired, entryID, domain.WaitlistStatusWaiting)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func scanWaitlistEntry(row rowScanner, extra ...any) (*domain.WaitlistEntry, error) {
+	entry := &domain.WaitlistEntry{}
+	var bookingID sql.NullString
+	dest := []any{
+		&entry.ID,
+		&entry.EventID,
+		&entry.UserID,
+		&entry.TicketCount,
+		&entry.TicketTypeID,
+		&entry.Status,
+		&bookingID,
+		&entry.CreatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	entry.BookingID = bookingID.String
+
+	return entry, nil
+}
@@ -0,0 +1,131 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Header carries the request ID in gRPC metadata and in HTTP requests and
+// responses.
+const Header = "x-request-id"
+
+// maxLength bounds caller-chosen IDs, which end up in every log line.
+const maxLength = 128
+
+type idKey struct{}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, idKey{}, id)
+}
+
+// FromContext returns the request ID put into ctx by the interceptor or the
+// gateway middleware.
+func FromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(idKey{}).(string)
+	return id, ok && id != ""
+}
+
+// UnaryServerInterceptor takes the request ID from the incoming metadata, or
+// generates one, and sends it back in the response header. The ID and the
+// method are added to the context's logger fields.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(Header); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		id = resolve(id)
+		grpc.SetHeader(ctx, metadata.Pairs(Header, id))
+
+		return handler(start(ctx, id, info.FullMethod), req)
+	}
+}
+
+// StreamServerInterceptor does for streaming calls what
+// UnaryServerInterceptor does for unary ones.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		var id string
+		if md, ok := metadata.FromIncomingContext(ss.Context()); ok {
+			if values := md.Get(Header); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		id = resolve(id)
+		ss.SetHeader(metadata.Pairs(Header, id))
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: start(ss.Context(), id, info.FullMethod)})
+	}
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// Middleware does for the gateway what UnaryServerInterceptor does for gRPC,
+// using the X-Request-Id header. routes, from auth.GatewayRoutes, tells which
+// RPC a request is for.
+func Middleware(routes map[string]string) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			var method string
+			if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+				method = routes[r.Method+" "+pattern.String()]
+			}
+
+			id := resolve(r.Header.Get(Header))
+			w.Header().Set(Header, id)
+
+			next(w, r.WithContext(start(r.Context(), id, method)), pathParams)
+		}
+	}
+}
+
+// UnaryClientInterceptor forwards the request ID of the context to the
+// service being called.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if id, ok := FromContext(ctx); ok {
+			ctx = metadata.AppendToOutgoingContext(ctx, Header, id)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func start(ctx context.Context, id, method string) context.Context {
+	ctx = NewContext(ctx, id)
+	return logger.WithFields(ctx, zap.String("requestID", id), zap.String("method", method))
+}
+
+// resolve returns id if it is fit to be logged, and a new ID otherwise.
+func resolve(id string) string {
+	if id == "" || len(id) > maxLength {
+		return uuid.NewString()
+	}
+	for _, c := range id {
+		if !isIDChar(c) {
+			return uuid.NewString()
+		}
+	}
+	return id
+}
+
+func isIDChar(c rune) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '-' || c == '_' || c == '.' || c == ':'
+}
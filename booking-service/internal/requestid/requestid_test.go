@@ -0,0 +1,105 @@
+package requestid
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const createBooking = "/booking.BookingService/CreateBooking"
+
+// serve runs the interceptor with md as the incoming metadata and returns the
+// request ID the handler saw.
+func serve(t *testing.T, md metadata.MD) string {
+	t.Helper()
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	var seen string
+	_, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: createBooking}, func(ctx context.Context, _ any) (any, error) {
+		seen, _ = FromContext(ctx)
+		return nil, nil
+	})
+	assert.NoError(t, err)
+	return seen
+}
+
+func TestUnaryServerInterceptor_KeepsIncomingID(t *testing.T) {
+	assert.Equal(t, "req-42", serve(t, metadata.Pairs(Header, "req-42")))
+}
+
+func TestUnaryServerInterceptor_GeneratesID(t *testing.T) {
+	for name, md := range map[string]metadata.MD{
+		"missing":   metadata.MD{},
+		"too long":  metadata.Pairs(Header, strings.Repeat("a", maxLength+1)),
+		"bad chars": metadata.Pairs(Header, "req\n42"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := uuid.Parse(serve(t, md))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/bookings", nil)
+	req.Header.Set("X-Request-Id", "req-42")
+	rec := httptest.NewRecorder()
+
+	var seen string
+	Middleware(nil)(func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		seen, _ = FromContext(r.Context())
+	})(rec, req, nil)
+
+	assert.Equal(t, "req-42", seen)
+	assert.Equal(t, "req-42", rec.Header().Get("X-Request-Id"))
+}
+
+func TestUnaryClientInterceptor_ForwardsID(t *testing.T) {
+	ctx := NewContext(context.Background(), "req-42")
+
+	var forwarded []string
+	err := UnaryClientInterceptor()(ctx, "/event.EventService/ReserveSeats", nil, nil, nil, func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		forwarded = md.Get(Header)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"req-42"}, forwarded)
+}
+
+// testStream is a server stream that records the header it is sent.
+type testStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	ss := &testStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(Header, "req-42"))}
+
+	var seen string
+	err := StreamServerInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: "/booking.BookingService/WatchQueuePosition"}, func(_ any, ss grpc.ServerStream) error {
+		seen, _ = FromContext(ss.Context())
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "req-42", seen)
+	assert.Equal(t, []string{"req-42"}, ss.header.Get(Header))
+}
@@ -0,0 +1,264 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+)
+
+var ErrSANNotAllowed = errors.New("certificate SAN not allowed")
+
+// Config points at PEM files. For a server, CertFile and KeyFile are
+// required and CAFile turns on mutual TLS. For a client, CAFile verifies the
+// server (the system pool is used when empty) and CertFile and KeyFile are
+// presented when the server asks for a certificate.
+type Config struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+	// AllowedSANs restricts the peer to certificates with one of these DNS,
+	// URI or IP SANs. Empty allows any certificate the CA signed.
+	AllowedSANs []string
+	// ServerName overrides the name a client checks the server's
+	// certificate against; by default it is the host being dialed.
+	ServerName string
+}
+
+// Reloader keeps the certificate and CA pool of a Config current: the files
+// are watched and read again when they change, so rotated certificates are
+// used for new connections without a restart. A change that fails to load
+// is logged and the previous files stay in use.
+type Reloader struct {
+	cfg Config
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+	pool *x509.CertPool
+
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+}
+
+func NewReloader(cfg Config) (*Reloader, error) {
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.New("tls: cert file and key file must be set together")
+	}
+	if len(cfg.AllowedSANs) > 0 && cfg.CAFile == "" {
+		return nil, errors.New("tls: allowed SANs need a CA file to verify peers with")
+	}
+
+	r := &Reloader{cfg: cfg, done: make(chan struct{})}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("tls: watching files: %w", err)
+	}
+	// Directories rather than files are watched, so certificates replaced
+	// by a rename (or a Kubernetes secret's symlink swap) are noticed.
+	for _, dir := range r.dirs() {
+		if err := watcher.Add(dir); err != nil {
+			watcher.Close()
+			return nil, fmt.Errorf("tls: watching %s: %w", dir, err)
+		}
+	}
+	r.watcher = watcher
+	go r.watch()
+
+	return r, nil
+}
+
+func (r *Reloader) files() []string {
+	var files []string
+	for _, f := range []string{r.cfg.CertFile, r.cfg.KeyFile, r.cfg.CAFile} {
+		if f != "" {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+func (r *Reloader) dirs() []string {
+	var dirs []string
+	for _, f := range r.files() {
+		if dir := filepath.Dir(f); !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs
+}
+
+func (r *Reloader) load() error {
+	var cert *tls.Certificate
+	if r.cfg.CertFile != "" {
+		pair, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("tls: loading key pair: %w", err)
+		}
+		cert = &pair
+	}
+
+	var pool *x509.CertPool
+	if r.cfg.CAFile != "" {
+		data, err := os.ReadFile(r.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("tls: reading CA file: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return errors.New("tls: no certificates in CA file")
+		}
+	}
+
+	r.mu.Lock()
+	r.cert, r.pool = cert, pool
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *Reloader) watch() {
+	for {
+		select {
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				return
+			}
+			if event.Has(fsnotify.Chmod) {
+				continue
+			}
+			if err := r.load(); err != nil {
+				logger.Warn("TLS files changed but could not be loaded; keeping the previous ones",
+					zap.String("file", event.Name), zap.Error(err))
+				continue
+			}
+			logger.Debug("TLS files reloaded", zap.String("file", event.Name))
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.Error("TLS file watcher error", zap.Error(err))
+		case <-r.done:
+			return
+		}
+	}
+}
+
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, r.pool
+}
+
+// ServerCredentials are for a gRPC listener. With a CA file, clients must
+// present a certificate it signed.
+func (r *Reloader) ServerCredentials() credentials.TransportCredentials {
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.current()
+			if cert == nil {
+				return nil, errors.New("tls: no server certificate configured")
+			}
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+			if pool != nil {
+				cfg.ClientCAs = pool
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.VerifyConnection = r.checkSAN
+			}
+			return cfg, nil
+		},
+	})
+}
+
+// ClientCredentials are for dialing another service.
+func (r *Reloader) ClientCredentials() credentials.TransportCredentials {
+	return &clientCredentials{
+		TransportCredentials: credentials.NewTLS(r.clientConfig()),
+		reloader:             r,
+	}
+}
+
+func (r *Reloader) clientConfig() *tls.Config {
+	_, pool := r.current()
+	cfg := &tls.Config{
+		MinVersion:       tls.VersionTLS12,
+		RootCAs:          pool,
+		VerifyConnection: r.checkSAN,
+	}
+	if r.cfg.CertFile != "" {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+			return cert, nil
+		}
+	}
+	return cfg
+}
+
+// clientCredentials build the TLS config afresh for every handshake, since
+// RootCAs cannot change once a config is in use. The server is checked
+// against ServerName instead of the dialed host when it is set.
+type clientCredentials struct {
+	credentials.TransportCredentials
+	reloader *Reloader
+}
+
+func (c *clientCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	if c.reloader.cfg.ServerName != "" {
+		authority = c.reloader.cfg.ServerName
+	}
+	return credentials.NewTLS(c.reloader.clientConfig()).ClientHandshake(ctx, authority, conn)
+}
+
+func (c *clientCredentials) Clone() credentials.TransportCredentials {
+	return &clientCredentials{TransportCredentials: c.TransportCredentials.Clone(), reloader: c.reloader}
+}
+
+// checkSAN runs after the chain has been verified.
+func (r *Reloader) checkSAN(cs tls.ConnectionState) error {
+	if len(r.cfg.AllowedSANs) == 0 {
+		return nil
+	}
+	if len(cs.PeerCertificates) == 0 {
+		return ErrSANNotAllowed
+	}
+	if slices.ContainsFunc(sans(cs.PeerCertificates[0]), func(san string) bool {
+		return slices.Contains(r.cfg.AllowedSANs, san)
+	}) {
+		return nil
+	}
+	return ErrSANNotAllowed
+}
+
+func sans(cert *x509.Certificate) []string {
+	names := slices.Clone(cert.DNSNames)
+	for _, uri := range cert.URIs {
+		names = append(names, uri.String())
+	}
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	return names
+}
+
+func (r *Reloader) Close() error {
+	close(r.done)
+	return r.watcher.Close()
+}
@@ -0,0 +1,42 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestInit_FileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	shutdown, err := Init(context.Background(), Config{
+		ServiceName: "booking-service",
+		Exporter:    "file",
+		FilePath:    path,
+		SampleRatio: 1,
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "CreateBooking")
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	span.End()
+	assert.NoError(t, shutdown(context.Background()))
+
+	assert.Contains(t, carrier.Get("traceparent"), span.SpanContext().TraceID().String())
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"Name":"CreateBooking"`)
+	assert.Contains(t, string(data), "booking-service")
+}
+
+func TestInit_UnknownExporter(t *testing.T) {
+	_, err := Init(context.Background(), Config{Exporter: "zipkin"})
+	assert.Error(t, err)
+}
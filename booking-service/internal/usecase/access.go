@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+)
+
+// callerUserID resolves the user a call acts for. An authenticated caller may
+// only act for itself, unless it is an admin, and may leave userID empty to
+// mean itself. Without an identity, as with authentication disabled or for
+// background workers, userID is taken as given.
+func callerUserID(ctx context.Context, userID string) (string, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return userID, nil
+	}
+	if userID == "" {
+		return identity.Subject, nil
+	}
+	if userID != identity.Subject && !identity.HasRole(auth.RoleAdmin) {
+		return "", domain.ErrPermissionDenied
+	}
+	return userID, nil
+}
+
+// authorizeBooking checks that an authenticated caller owns the booking or is
+// an admin.
+func authorizeBooking(ctx context.Context, booking *domain.Booking) error {
+	_, err := callerUserID(ctx, booking.UserID)
+	return err
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func callerContext(subject string, roles ...auth.Role) context.Context {
+	if len(roles) == 0 {
+		roles = []auth.Role{auth.RoleCustomer}
+	}
+	return auth.WithIdentity(context.Background(), &auth.Identity{Subject: subject, Roles: roles})
+}
+
+func TestGetBooking_OtherUsersBooking(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := callerContext("user-2")
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+
+	booking, err := uc.GetBooking(ctx, "booking-1")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestGetBooking_OwnBooking(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := callerContext("user-1")
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+
+	booking, err := uc.GetBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "booking-1", booking.ID)
+}
+
+func TestGetBooking_AdminSeesAnyBooking(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := callerContext("admin-1", auth.RoleAdmin)
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+
+	booking, err := uc.GetBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", booking.UserID)
+}
+
+func TestCancelBooking_OtherUsersBooking(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := callerContext("user-2")
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	eventClient.AssertNotCalled(t, "ReleaseTickets", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "CancelLive", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_UsesCaller(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := callerContext("user-1")
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 10,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).
+		Return(&eventpb.Reservation{}, nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(b *domain.Booking) bool {
+		return b.UserID == "user-1"
+	})).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "", "event-1", 2, nil, nil, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", booking.UserID)
+}
+
+func TestCreateBooking_ForAnotherUser(t *testing.T) {
+	uc, _, eventClient := newTestUsecase()
+
+	_, err := uc.CreateBooking(callerContext("user-2"), "user-1", "event-1", 2, nil, nil, "")
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	eventClient.AssertNotCalled(t, "GetEvent", mock.Anything, mock.Anything)
+}
+
+func TestListUserBookings_ForAnotherUser(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+
+	_, _, err := uc.ListUserBookings(callerContext("user-2"), "user-1", domain.BookingFilter{}, domain.PageRequest{})
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	repo.AssertNotCalled(t, "ListByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestLeaveWaitlist_ForAnotherUser(t *testing.T) {
+	uc, _, _, waitlist := newTestUsecaseWithWaitlist()
+
+	err := uc.LeaveWaitlist(callerContext("user-2"), "event-1", "user-1")
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	waitlist.AssertNotCalled(t, "Leave", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -3,29 +3,84 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/metrics"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/queuepass"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
+const (
+	defaultReleaseAttempts = 3
+	defaultReleaseBackoff  = 200 * time.Millisecond
+)
+
 type BookingUsecase struct {
 	repo        domain.BookingRepository
+	waitlist    domain.WaitlistRepository
+	queue       domain.QueueRepository
+	passes      *queuepass.Signer
 	eventClient client.EventClient
+	holdTTL     time.Duration
+	now         func() time.Time
+
+	releaseAttempts int
+	releaseBackoff  time.Duration
 }
 
-func NewBookingUsecase(repo domain.BookingRepository, eventClient client.EventClient) *BookingUsecase {
+func NewBookingUsecase(repo domain.BookingRepository, waitlist domain.WaitlistRepository, queue domain.QueueRepository, passes *queuepass.Signer, eventClient client.EventClient, holdTTL time.Duration) *BookingUsecase {
 	return &BookingUsecase{
-		repo:        repo,
-		eventClient: eventClient,
+		repo:            repo,
+		waitlist:        waitlist,
+		queue:           queue,
+		passes:          passes,
+		eventClient:     eventClient,
+		holdTTL:         holdTTL,
+		now:             time.Now,
+		releaseAttempts: defaultReleaseAttempts,
+		releaseBackoff:  defaultReleaseBackoff,
 	}
 }
 
-func (u *BookingUsecase) CreateBooking(ctx context.Context, userID, eventID string, ticketCount int32) (*domain.Booking, error) {
+// CreateBooking books ticketCount general admission tickets, or the given seats
+// of an assigned-seating event. Events with ticket types are booked by items,
+// whose prices are captured from the reservation. With seat IDs or items,
+// ticketCount may be left zero. While the event's waiting room is on, the
+// user's pass from it is required.
+func (u *BookingUsecase) CreateBooking(ctx context.Context, userID, eventID string, ticketCount int32, seatIDs []string, items []*domain.BookingItem, queuePass string) (*domain.Booking, error) {
+	userID, err := callerUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
 	if userID == "" || eventID == "" {
 		return nil, domain.ErrInvalidInput
 	}
+	if len(items) > 0 {
+		itemTotal, ok := itemQuantity(items)
+		if !ok {
+			return nil, domain.ErrInvalidInput
+		}
+		if ticketCount == 0 {
+			ticketCount = itemTotal
+		}
+		if ticketCount != itemTotal {
+			return nil, domain.ErrInvalidInput
+		}
+	}
+	if len(seatIDs) > 0 {
+		if ticketCount == 0 {
+			ticketCount = int32(len(seatIDs))
+		}
+		if ticketCount != int32(len(seatIDs)) {
+			return nil, domain.ErrInvalidInput
+		}
+	}
 	if ticketCount <= 0 {
 		return nil, domain.ErrInvalidInput
 	}
@@ -38,27 +93,84 @@ func (u *BookingUsecase) CreateBooking(ctx context.Context, userID, eventID stri
 		return nil, err
 	}
 
+	// Event-service enforces this too; checking here saves a reserve call.
+	if event.Status != eventpb.EventStatus_EVENT_STATUS_PUBLISHED {
+		return nil, domain.ErrEventNotOnSale
+	}
+	if event.QueueAdmissionsPerMinute > 0 {
+		if err := u.checkQueuePass(queuePass, eventID, userID); err != nil {
+			return nil, err
+		}
+	}
 	if event.AvailableSeats < ticketCount {
+		metrics.InsufficientSeats.WithLabelValues("create").Inc()
 		return nil, domain.ErrInsufficientSeats
 	}
+	// Checked again under a lock when the booking is stored; failing here
+	// avoids reserving seats that would only be released.
+	if err := u.checkTicketLimit(ctx, userID, eventID, event.MaxTicketsPerUser, ticketCount); err != nil {
+		return nil, err
+	}
 
-	if err := u.eventClient.ReserveTickets(ctx, eventID, ticketCount); err != nil {
+	// The reservation ID is chosen here so a retried ReserveTickets after a
+	// lost response is recognised by event-service instead of reserving twice.
+	reservationID := uuid.New().String()
+	reservation, err := u.eventClient.ReserveTickets(ctx, reservationID, eventID, ticketCount, seatIDs, reservationItems(items))
+	if err != nil {
 		if errors.Is(err, client.ErrInsufficientSeats) {
+			metrics.InsufficientSeats.WithLabelValues("create").Inc()
 			return nil, domain.ErrInsufficientSeats
 		}
+		if errors.Is(err, client.ErrSeatUnavailable) {
+			return nil, domain.ErrSeatUnavailable
+		}
+		if errors.Is(err, client.ErrTicketTypeNotOnSale) {
+			return nil, domain.ErrTicketTypeNotOnSale
+		}
+		if errors.Is(err, client.ErrEventNotOnSale) {
+			return nil, domain.ErrEventNotOnSale
+		}
+		if errors.Is(err, client.ErrInvalidReservation) {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+		}
+		if errors.Is(err, client.ErrEventNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		// A timeout, an unavailable event-service or an open breaker does not
+		// say whether the reserve committed, so release it to be sure.
+		u.abandonReservation(ctx, &domain.Booking{
+			UserID:        userID,
+			EventID:       eventID,
+			ReservationID: reservationID,
+			TicketCount:   ticketCount,
+		}, err)
 		return nil, err
 	}
 
 	booking := &domain.Booking{
-		UserID:      userID,
-		EventID:     eventID,
-		TicketCount: ticketCount,
+		UserID:        userID,
+		EventID:       eventID,
+		ReservationID: reservationID,
+		TicketCount:   ticketCount,
+		SeatIDs:       seatIDs,
+		ExpiresAt:     u.now().Add(u.holdTTL),
 	}
+	priceItems(booking, reservation.GetItems())
 
-	if err := u.repo.Create(ctx, booking); err != nil {
-		return nil, err
+	if event.MaxTicketsPerUser > 0 {
+		err = u.repo.CreateWithinLimit(ctx, booking, event.MaxTicketsPerUser)
+	} else {
+		err = u.repo.Create(ctx, booking)
+	}
+	if err != nil {
+		compensateErr := u.compensateReservation(ctx, booking, err)
+		if errors.Is(err, domain.ErrTicketLimitExceeded) && errors.Is(compensateErr, domain.ErrBookingRolledBack) {
+			return nil, domain.ErrTicketLimitExceeded
+		}
+		return nil, compensateErr
 	}
 
+	metrics.BookingsCreated.WithLabelValues("direct").Inc()
 	return booking, nil
 }
 
@@ -74,16 +186,61 @@ func (u *BookingUsecase) GetBooking(ctx context.Context, bookingID string) (*dom
 	if booking == nil {
 		return nil, domain.ErrBookingNotFound
 	}
+	if err := authorizeBooking(ctx, booking); err != nil {
+		return nil, err
+	}
 
 	return booking, nil
 }
 
-func (u *BookingUsecase) ListUserBookings(ctx context.Context, userID string) ([]*domain.Booking, error) {
+const defaultBookingPageSize = 50
+
+// bookingOrders are the orders ListUserBookings allows.
+var bookingOrders = map[string]domain.SortOrder{
+	"":                {Column: "created_at", Desc: true},
+	"created_at desc": {Column: "created_at", Desc: true},
+	"created_at":      {Column: "created_at"},
+}
+
+func (u *BookingUsecase) ListUserBookings(ctx context.Context, userID string, filter domain.BookingFilter, page domain.PageRequest) ([]*domain.Booking, string, error) {
+	userID, err := callerUserID(ctx, userID)
+	if err != nil {
+		return nil, "", err
+	}
 	if userID == "" {
-		return nil, domain.ErrInvalidInput
+		return nil, "", domain.ErrInvalidInput
+	}
+	order, err := sortOrder(bookingOrders, page.OrderBy)
+	if err != nil {
+		return nil, "", err
+	}
+	size, err := pageSize(page.Size, defaultBookingPageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedBefore.IsZero() && !filter.CreatedBefore.After(filter.CreatedFrom) {
+		return nil, "", domain.ErrInvalidInput
+	}
+
+	query := fmt.Sprintf("%s|%s|%t|%d|%d|%d|%q", userID, order.Column, order.Desc,
+		filter.CreatedFrom.UnixNano(), filter.CreatedBefore.UnixNano(), filter.Status, filter.EventID)
+	after, err := decodePageToken(page.Token, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// One more than the page tells whether there is a next one.
+	bookings, err := u.repo.ListByUserID(ctx, userID, filter, order, after, size+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(bookings) <= int(size) {
+		return bookings, "", nil
 	}
 
-	return u.repo.ListByUserID(ctx, userID)
+	bookings = bookings[:size]
+	last := bookings[size-1]
+	return bookings, encodePageToken(domain.PageCursor{Key: last.CreatedAt, ID: last.ID}, query), nil
 }
 
 func (u *BookingUsecase) CancelBooking(ctx context.Context, bookingID string) error {
@@ -98,20 +255,254 @@ func (u *BookingUsecase) CancelBooking(ctx context.Context, bookingID string) er
 	if booking == nil {
 		return domain.ErrBookingNotFound
 	}
+	if err := authorizeBooking(ctx, booking); err != nil {
+		return err
+	}
 
 	if booking.Status == domain.BookingStatusCancelled {
 		return domain.ErrAlreadyCancelled
 	}
+	if booking.Status == domain.BookingStatusExpired {
+		return domain.ErrHoldExpired
+	}
+
+	// Claiming the booking first means only one of a concurrent cancel, the
+	// reaper or another cancel goes on to release its seats.
+	cancelled, err := u.repo.CancelLive(ctx, bookingID, u.now())
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		current, err := u.repo.GetByID(ctx, bookingID)
+		if err != nil {
+			return err
+		}
+		if current != nil && current.Status == domain.BookingStatusExpired {
+			return domain.ErrHoldExpired
+		}
+		return domain.ErrAlreadyCancelled
+	}
+	metrics.BookingsCancelled.WithLabelValues("user").Inc()
 
-	logger.Info("CancelBooking: releasing tickets",
+	logger.FromContext(ctx).Info("CancelBooking: releasing tickets",
 		zap.Int32("ticketCount", booking.TicketCount),
 		zap.String("eventID", booking.EventID),
+		zap.String("reservationID", booking.ReservationID),
+	)
+	attempts, err := u.releaseBookingWithRetry(ctx, booking)
+	if err != nil {
+		metrics.CompensationFailures.WithLabelValues("cancel").Inc()
+		logger.FromContext(ctx).Error("CancelBooking: ReleaseTickets failed, seats leaked",
+			zap.String("bookingID", booking.ID),
+			zap.Int32("attempts", attempts),
+			zap.Error(err),
+		)
+		compensation := &domain.Compensation{
+			UserID:        booking.UserID,
+			EventID:       booking.EventID,
+			ReservationID: booking.ReservationID,
+			TicketCount:   booking.TicketCount,
+			Reason:        "booking cancelled: " + err.Error(),
+			Attempts:      attempts,
+			Succeeded:     false,
+		}
+		if recordErr := u.repo.RecordCompensation(ctx, compensation); recordErr != nil {
+			logger.FromContext(ctx).Error("CancelBooking: recording compensation failed",
+				zap.String("bookingID", booking.ID),
+				zap.Error(recordErr),
+			)
+		}
+		return err
+	}
+	logger.FromContext(ctx).Info("CancelBooking: tickets released successfully")
+
+	u.promoteWaitlist(ctx, booking.EventID)
+	return nil
+}
+
+func (u *BookingUsecase) ConfirmBooking(ctx context.Context, bookingID string) (*domain.Booking, error) {
+	if bookingID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	booking, err := u.repo.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking == nil {
+		return nil, domain.ErrBookingNotFound
+	}
+	if err := authorizeBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	switch booking.Status {
+	case domain.BookingStatusConfirmed:
+		return booking, nil
+	case domain.BookingStatusCancelled:
+		return nil, domain.ErrAlreadyCancelled
+	case domain.BookingStatusExpired:
+		return nil, domain.ErrHoldExpired
+	}
+
+	now := u.now()
+	confirmed, err := u.repo.ConfirmPending(ctx, bookingID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		// The hold lapsed or the booking changed state under us; the reaper
+		// owns releasing the seats from here on.
+		return nil, domain.ErrHoldExpired
+	}
+
+	booking.Status = domain.BookingStatusConfirmed
+	return booking, nil
+}
+
+// CancelEventBookings cancels all live bookings of a cancelled event. Their
+// seats are not released: the event can no longer be sold. Bookings that are
+// already cancelled or expired are left alone, so the call is safe to repeat.
+func (u *BookingUsecase) CancelEventBookings(ctx context.Context, eventID string) (int32, error) {
+	if eventID == "" {
+		return 0, domain.ErrInvalidInput
+	}
+
+	bookings, err := u.repo.CancelByEventID(ctx, eventID, u.now())
+	if err != nil {
+		return 0, err
+	}
+
+	metrics.BookingsCancelled.WithLabelValues("event_cancelled").Add(float64(len(bookings)))
+	logger.FromContext(ctx).Info("CancelEventBookings: bookings cancelled",
+		zap.String("eventID", eventID),
+		zap.Int("count", len(bookings)),
 	)
-	if err := u.eventClient.ReleaseTickets(ctx, booking.EventID, booking.TicketCount); err != nil {
-		logger.Error("CancelBooking: ReleaseTickets failed", zap.Error(err))
+
+	// Nobody can book the event any more, so its waiting room can go. Left
+	// behind, the tickets are pruned once their passes lapse.
+	if err := u.queue.DeleteEvent(ctx, eventID); err != nil {
+		logger.FromContext(ctx).Error("CancelEventBookings: deleting waiting room failed",
+			zap.String("eventID", eventID),
+			zap.Error(err),
+		)
+	}
+	return int32(len(bookings)), nil
+}
+
+// ExpireHolds expires up to limit pending bookings whose hold has lapsed and
+// releases their seats. It returns the number of bookings expired.
+func (u *BookingUsecase) ExpireHolds(ctx context.Context, limit int) (int, error) {
+	bookings, err := u.repo.ExpirePending(ctx, u.now(), limit)
+	if err != nil {
+		return 0, err
+	}
+	metrics.HoldsExpired.Add(float64(len(bookings)))
+
+	released := make(map[string]bool)
+	for _, booking := range bookings {
+		attempts, err := u.releaseBookingWithRetry(ctx, booking)
+		if err == nil {
+			released[booking.EventID] = true
+			continue
+		}
+
+		metrics.CompensationFailures.WithLabelValues("expire").Inc()
+		logger.FromContext(ctx).Error("ExpireHolds: releasing seats failed",
+			zap.String("bookingID", booking.ID),
+			zap.String("eventID", booking.EventID),
+			zap.Int32("ticketCount", booking.TicketCount),
+			zap.Error(err),
+		)
+		compensation := &domain.Compensation{
+			UserID:        booking.UserID,
+			EventID:       booking.EventID,
+			ReservationID: booking.ReservationID,
+			TicketCount:   booking.TicketCount,
+			Reason:        "hold expired: " + err.Error(),
+			Attempts:      attempts,
+			Succeeded:     false,
+		}
+		if err := u.repo.RecordCompensation(ctx, compensation); err != nil {
+			logger.FromContext(ctx).Error("ExpireHolds: recording compensation failed",
+				zap.String("bookingID", booking.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	for eventID := range released {
+		u.promoteWaitlist(ctx, eventID)
+	}
+
+	return len(bookings), nil
+}
+
+// checkTicketLimit fails with ErrTicketLimitExceeded if the user cannot get
+// another requested tickets of an event limited to limit per user. A limit of
+// 0 means no limit.
+func (u *BookingUsecase) checkTicketLimit(ctx context.Context, userID, eventID string, limit, requested int32) error {
+	if limit <= 0 {
+		return nil
+	}
+	if requested > limit {
+		return domain.ErrTicketLimitExceeded
+	}
+
+	held, err := u.repo.CountLiveTickets(ctx, userID, eventID)
+	if err != nil {
 		return err
 	}
-	logger.Info("CancelBooking: tickets released successfully")
+	if held+requested > limit {
+		return domain.ErrTicketLimitExceeded
+	}
+	return nil
+}
+
+// itemQuantity sums the item quantities, reporting false for an item without
+// a ticket type or with a non-positive quantity.
+func itemQuantity(items []*domain.BookingItem) (int32, bool) {
+	var total int32
+	for _, item := range items {
+		if item == nil || item.TicketTypeID == "" || item.Quantity <= 0 {
+			return 0, false
+		}
+		total += item.Quantity
+	}
+	return total, true
+}
+
+func reservationItems(items []*domain.BookingItem) []*eventpb.ReservationItem {
+	if len(items) == 0 {
+		return nil
+	}
+	reserved := make([]*eventpb.ReservationItem, 0, len(items))
+	for _, item := range items {
+		reserved = append(reserved, &eventpb.ReservationItem{
+			TicketTypeId: item.TicketTypeID,
+			Quantity:     item.Quantity,
+		})
+	}
+	return reserved
+}
 
-	return u.repo.UpdateStatus(ctx, bookingID, domain.BookingStatusCancelled)
+// priceItems copies the prices event-service reserved at onto the booking, so
+// the booking keeps them even if the ticket types are repriced later.
+func priceItems(booking *domain.Booking, reserved []*eventpb.ReservationItem) {
+	if len(reserved) == 0 {
+		return
+	}
+	booking.Items = make([]*domain.BookingItem, 0, len(reserved))
+	for _, item := range reserved {
+		total := item.UnitPriceMinor * int64(item.Quantity)
+		booking.Items = append(booking.Items, &domain.BookingItem{
+			TicketTypeID:    item.TicketTypeId,
+			Quantity:        item.Quantity,
+			UnitPriceMinor:  item.UnitPriceMinor,
+			TotalPriceMinor: total,
+			Currency:        item.Currency,
+		})
+		booking.TotalPriceMinor += total
+		booking.Currency = item.Currency
+	}
 }
@@ -3,22 +3,43 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain/mocks"
-	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/metrics"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/queuepass"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+const testHoldTTL = 15 * time.Minute
+
+var testPasses = queuepass.NewSigner([]byte(strings.Repeat("k", 32)), 10*time.Minute)
+
+// newTestUsecase returns a usecase whose waitlists are always empty.
 func newTestUsecase() (*BookingUsecase, *mocks.MockBookingRepository, *mocks.MockEventClient) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	waitlist.On("Next", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	return uc, repo, eventClient
+}
+
+func newTestUsecaseWithWaitlist() (*BookingUsecase, *mocks.MockBookingRepository, *mocks.MockEventClient, *mocks.MockWaitlistRepository) {
 	repo := new(mocks.MockBookingRepository)
+	waitlist := new(mocks.MockWaitlistRepository)
 	eventClient := new(mocks.MockEventClient)
-	uc := NewBookingUsecase(repo, eventClient)
-	return uc, repo, eventClient
+	uc := NewBookingUsecase(repo, waitlist, new(mocks.MockQueueRepository), testPasses, eventClient, testHoldTTL)
+	uc.now = func() time.Time { return testNow }
+	uc.releaseBackoff = 0
+	return uc, repo, eventClient, waitlist
 }
 
 func TestCreateBooking_Success(t *testing.T) {
@@ -28,17 +49,22 @@ func TestCreateBooking_Success(t *testing.T) {
 	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
 		Id:             "event-1",
 		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
 	}, nil)
-	eventClient.On("ReserveTickets", ctx, "event-1", int32(2)).Return(nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
 	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(nil)
+	created := testutil.ToFloat64(metrics.BookingsCreated.WithLabelValues("direct"))
 
-	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2)
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
 
 	assert.NoError(t, err)
+	assert.Equal(t, created+1, testutil.ToFloat64(metrics.BookingsCreated.WithLabelValues("direct")))
 	assert.NotNil(t, booking)
 	assert.Equal(t, "user-1", booking.UserID)
 	assert.Equal(t, "event-1", booking.EventID)
 	assert.Equal(t, int32(2), booking.TicketCount)
+	assert.NotEmpty(t, booking.ReservationID)
+	assert.Equal(t, testNow.Add(testHoldTTL), booking.ExpiresAt)
 	repo.AssertExpectations(t)
 	eventClient.AssertExpectations(t)
 }
@@ -46,7 +72,7 @@ func TestCreateBooking_Success(t *testing.T) {
 func TestCreateBooking_EmptyUserID(t *testing.T) {
 	uc, _, _ := newTestUsecase()
 
-	booking, err := uc.CreateBooking(context.Background(), "", "event-1", 2)
+	booking, err := uc.CreateBooking(context.Background(), "", "event-1", 2, nil, nil, "")
 
 	assert.Nil(t, booking)
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
@@ -55,7 +81,7 @@ func TestCreateBooking_EmptyUserID(t *testing.T) {
 func TestCreateBooking_EmptyEventID(t *testing.T) {
 	uc, _, _ := newTestUsecase()
 
-	booking, err := uc.CreateBooking(context.Background(), "user-1", "", 2)
+	booking, err := uc.CreateBooking(context.Background(), "user-1", "", 2, nil, nil, "")
 
 	assert.Nil(t, booking)
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
@@ -64,7 +90,7 @@ func TestCreateBooking_EmptyEventID(t *testing.T) {
 func TestCreateBooking_ZeroTickets(t *testing.T) {
 	uc, _, _ := newTestUsecase()
 
-	booking, err := uc.CreateBooking(context.Background(), "user-1", "event-1", 0)
+	booking, err := uc.CreateBooking(context.Background(), "user-1", "event-1", 0, nil, nil, "")
 
 	assert.Nil(t, booking)
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
@@ -76,7 +102,7 @@ func TestCreateBooking_EventNotFound(t *testing.T) {
 
 	eventClient.On("GetEvent", ctx, "event-1").Return(nil, client.ErrEventNotFound)
 
-	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2)
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
 
 	assert.Nil(t, booking)
 	assert.ErrorIs(t, err, domain.ErrEventNotFound)
@@ -89,12 +115,15 @@ func TestCreateBooking_InsufficientSeats(t *testing.T) {
 	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
 		Id:             "event-1",
 		AvailableSeats: 1,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
 	}, nil)
+	rejected := testutil.ToFloat64(metrics.InsufficientSeats.WithLabelValues("create"))
 
-	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 5)
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 5, nil, nil, "")
 
 	assert.Nil(t, booking)
 	assert.ErrorIs(t, err, domain.ErrInsufficientSeats)
+	assert.Equal(t, rejected+1, testutil.ToFloat64(metrics.InsufficientSeats.WithLabelValues("create")))
 }
 
 func TestCreateBooking_ReserveTicketsFails(t *testing.T) {
@@ -104,15 +133,389 @@ func TestCreateBooking_ReserveTicketsFails(t *testing.T) {
 	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
 		Id:             "event-1",
 		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
 	}, nil)
-	eventClient.On("ReserveTickets", ctx, "event-1", int32(2)).Return(client.ErrInsufficientSeats)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(nil, client.ErrInsufficientSeats)
 
-	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2)
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
 
 	assert.Nil(t, booking)
 	assert.ErrorIs(t, err, domain.ErrInsufficientSeats)
 }
 
+func TestCreateBooking_WithSeats(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	seatIDs := []string{"seat-a1", "seat-a2"}
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+		LayoutId:       "layout-1",
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), seatIDs, []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("Create", ctx, mock.MatchedBy(func(b *domain.Booking) bool {
+		return b.TicketCount == 2 && len(b.SeatIDs) == 2
+	})).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 0, seatIDs, nil, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), booking.TicketCount)
+	assert.Equal(t, seatIDs, booking.SeatIDs)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_SeatCountMismatch(t *testing.T) {
+	uc, _, eventClient := newTestUsecase()
+
+	booking, err := uc.CreateBooking(context.Background(), "user-1", "event-1", 3, []string{"seat-a1"}, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	eventClient.AssertNotCalled(t, "GetEvent", mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_SeatTaken(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(1), []string{"seat-a1"}, []*eventpb.ReservationItem(nil)).Return(nil, client.ErrSeatUnavailable)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 0, []string{"seat-a1"}, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrSeatUnavailable)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_WithItems_CapturesPrices(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(3), []string(nil), []*eventpb.ReservationItem{
+		{TicketTypeId: "ga", Quantity: 2},
+		{TicketTypeId: "vip", Quantity: 1},
+	}).Return(&eventpb.Reservation{
+		Quantity: 3,
+		Items: []*eventpb.ReservationItem{
+			{TicketTypeId: "ga", Quantity: 2, UnitPriceMinor: 5000, Currency: "USD"},
+			{TicketTypeId: "vip", Quantity: 1, UnitPriceMinor: 15000, Currency: "USD"},
+		},
+	}, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 0, nil, []*domain.BookingItem{
+		{TicketTypeID: "ga", Quantity: 2},
+		{TicketTypeID: "vip", Quantity: 1},
+	}, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), booking.TicketCount)
+	assert.Equal(t, int64(25000), booking.TotalPriceMinor)
+	assert.Equal(t, "USD", booking.Currency)
+	if assert.Len(t, booking.Items, 2) {
+		assert.Equal(t, int64(5000), booking.Items[0].UnitPriceMinor)
+		assert.Equal(t, int64(10000), booking.Items[0].TotalPriceMinor)
+		assert.Equal(t, int64(15000), booking.Items[1].TotalPriceMinor)
+	}
+	repo.AssertExpectations(t)
+}
+
+func TestCreateBooking_ItemCountMismatch(t *testing.T) {
+	uc, _, eventClient := newTestUsecase()
+
+	items := []*domain.BookingItem{{TicketTypeID: "ga", Quantity: 2}}
+	booking, err := uc.CreateBooking(context.Background(), "user-1", "event-1", 3, nil, items, "")
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	items = []*domain.BookingItem{{TicketTypeID: "ga", Quantity: 0}}
+	booking, err = uc.CreateBooking(context.Background(), "user-1", "event-1", 0, nil, items, "")
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	eventClient.AssertNotCalled(t, "GetEvent", mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_TicketTypeNotOnSale(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(1), []string(nil), mock.Anything).Return(nil, client.ErrTicketTypeNotOnSale)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 0, nil, []*domain.BookingItem{
+		{TicketTypeID: "early-bird", Quantity: 1},
+	}, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrTicketTypeNotOnSale)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_ReserveTimeout_ReleasesReservation(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	var reservationID string
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	reserveErr := fmt.Errorf("%w: %w", client.ErrEventService, context.DeadlineExceeded)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(nil, reserveErr).Run(func(args mock.Arguments) {
+		reservationID = args.String(1)
+	})
+	eventClient.On("ReleaseTickets", mock.Anything, mock.MatchedBy(func(id string) bool {
+		return id == reservationID
+	})).Return(nil).Once()
+	repo.On("RecordCompensation", mock.Anything, mock.MatchedBy(func(c *domain.Compensation) bool {
+		return c.Succeeded && c.ReservationID == reservationID && c.UserID == "user-1" && c.TicketCount == 2
+	})).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotEmpty(t, reservationID)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_PersistFails_ReservationReleased(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(errors.New("db down"))
+	eventClient.On("ReleaseTickets", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	repo.On("RecordCompensation", mock.Anything, mock.MatchedBy(func(c *domain.Compensation) bool {
+		return c.Succeeded && c.Attempts == 1 && c.EventID == "event-1" && c.ReservationID != "" && c.TicketCount == 2
+	})).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrBookingRolledBack)
+	assert.Contains(t, err.Error(), "db down")
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_PersistFails_ReleasesSameReservation(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	var reservationID string
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil).Run(func(args mock.Arguments) {
+		reservationID = args.String(1)
+	})
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(errors.New("db down"))
+	eventClient.On("ReleaseTickets", mock.Anything, mock.MatchedBy(func(id string) bool {
+		return id == reservationID
+	})).Return(nil).Once()
+	repo.On("RecordCompensation", mock.Anything, mock.AnythingOfType("*domain.Compensation")).Return(nil)
+
+	_, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.ErrorIs(t, err, domain.ErrBookingRolledBack)
+	assert.NotEmpty(t, reservationID)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_PersistFails_ReleaseRetried(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(errors.New("db down"))
+	eventClient.On("ReleaseTickets", mock.Anything, mock.AnythingOfType("string")).Return(client.ErrEventService).Twice()
+	eventClient.On("ReleaseTickets", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	repo.On("RecordCompensation", mock.Anything, mock.MatchedBy(func(c *domain.Compensation) bool {
+		return c.Succeeded && c.Attempts == 3
+	})).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrBookingRolledBack)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_PersistFails_ReleaseExhausted(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(errors.New("db down"))
+	eventClient.On("ReleaseTickets", mock.Anything, mock.AnythingOfType("string")).Return(client.ErrEventService).Times(defaultReleaseAttempts)
+	repo.On("RecordCompensation", mock.Anything, mock.MatchedBy(func(c *domain.Compensation) bool {
+		return !c.Succeeded && c.Attempts == defaultReleaseAttempts && c.Reason == "db down"
+	})).Return(nil)
+	failures := testutil.ToFloat64(metrics.CompensationFailures.WithLabelValues("create"))
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrCompensationFailed)
+	assert.NotErrorIs(t, err, domain.ErrBookingRolledBack)
+	assert.Equal(t, failures+1, testutil.ToFloat64(metrics.CompensationFailures.WithLabelValues("create")))
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_PersistFails_RecordCompensationFails(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(errors.New("db down"))
+	eventClient.On("ReleaseTickets", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	repo.On("RecordCompensation", mock.Anything, mock.AnythingOfType("*domain.Compensation")).Return(errors.New("db down"))
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrBookingRolledBack)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_PersistFails_CancelledContext(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil).Run(func(mock.Arguments) {
+		cancel()
+	})
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(context.Canceled)
+	eventClient.On("ReleaseTickets", mock.MatchedBy(func(c context.Context) bool {
+		return c.Err() == nil
+	}), mock.AnythingOfType("string")).Return(nil).Once()
+	repo.On("RecordCompensation", mock.Anything, mock.AnythingOfType("*domain.Compensation")).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrBookingRolledBack)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCreateBooking_TicketLimitExceeded(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:                "event-1",
+		AvailableSeats:    100,
+		Status:            eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+		MaxTicketsPerUser: 4,
+	}, nil)
+	repo.On("CountLiveTickets", ctx, "user-1", "event-1").Return(int32(3), nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrTicketLimitExceeded)
+	eventClient.AssertNotCalled(t, "ReserveTickets", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_WithinLimit(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:                "event-1",
+		AvailableSeats:    100,
+		Status:            eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+		MaxTicketsPerUser: 4,
+	}, nil)
+	repo.On("CountLiveTickets", ctx, "user-1", "event-1").Return(int32(2), nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("CreateWithinLimit", ctx, mock.AnythingOfType("*domain.Booking"), int32(4)).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), booking.TicketCount)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateBooking_TicketLimitRaced_ReservationReleased(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:                "event-1",
+		AvailableSeats:    100,
+		Status:            eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+		MaxTicketsPerUser: 4,
+	}, nil)
+	repo.On("CountLiveTickets", ctx, "user-1", "event-1").Return(int32(0), nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("CreateWithinLimit", ctx, mock.AnythingOfType("*domain.Booking"), int32(4)).Return(domain.ErrTicketLimitExceeded)
+	eventClient.On("ReleaseTickets", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	repo.On("RecordCompensation", mock.Anything, mock.AnythingOfType("*domain.Compensation")).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrTicketLimitExceeded)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
 func TestGetBooking_Success(t *testing.T) {
 	uc, repo, _ := newTestUsecase()
 	ctx := context.Background()
@@ -162,18 +565,60 @@ func TestListUserBookings_Success(t *testing.T) {
 		{ID: "b-1", UserID: "user-1", EventID: "event-1", TicketCount: 2},
 		{ID: "b-2", UserID: "user-1", EventID: "event-2", TicketCount: 1},
 	}
-	repo.On("ListByUserID", ctx, "user-1").Return(expected, nil)
+	repo.On("ListByUserID", ctx, "user-1", domain.BookingFilter{}, domain.SortOrder{Column: "created_at", Desc: true}, (*domain.PageCursor)(nil), int32(51)).Return(expected, nil)
 
-	bookings, err := uc.ListUserBookings(ctx, "user-1")
+	bookings, next, err := uc.ListUserBookings(ctx, "user-1", domain.BookingFilter{}, domain.PageRequest{})
 
 	assert.NoError(t, err)
 	assert.Len(t, bookings, 2)
+	assert.Empty(t, next)
+}
+
+func TestListUserBookings_Pages(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	filter := domain.BookingFilter{Status: domain.BookingStatusConfirmed}
+	order := domain.SortOrder{Column: "created_at"}
+	repo.On("ListByUserID", ctx, "user-1", filter, order, (*domain.PageCursor)(nil), int32(2)).Return([]*domain.Booking{
+		{ID: "b-1", CreatedAt: testNow},
+		{ID: "b-2", CreatedAt: testNow.Add(time.Minute)},
+	}, nil)
+	repo.On("ListByUserID", ctx, "user-1", filter, order, &domain.PageCursor{Key: testNow, ID: "b-1"}, int32(2)).Return([]*domain.Booking{
+		{ID: "b-2", CreatedAt: testNow.Add(time.Minute)},
+	}, nil)
+
+	first, next, err := uc.ListUserBookings(ctx, "user-1", filter, domain.PageRequest{Size: 1, OrderBy: "created_at asc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "b-1", first[0].ID)
+	assert.NotEmpty(t, next)
+
+	second, last, err := uc.ListUserBookings(ctx, "user-1", filter, domain.PageRequest{Size: 1, Token: next, OrderBy: "created_at"})
+	assert.NoError(t, err)
+	assert.Equal(t, "b-2", second[0].ID)
+	assert.Empty(t, last)
+
+	// Another user's or another filter's query does not take the token.
+	_, _, err = uc.ListUserBookings(ctx, "user-2", filter, domain.PageRequest{Size: 1, Token: next, OrderBy: "created_at"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+	_, _, err = uc.ListUserBookings(ctx, "user-1", domain.BookingFilter{}, domain.PageRequest{Size: 1, Token: next, OrderBy: "created_at"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+	repo.AssertExpectations(t)
+}
+
+func TestListUserBookings_UnsupportedOrder(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+
+	_, _, err := uc.ListUserBookings(context.Background(), "user-1", domain.BookingFilter{}, domain.PageRequest{OrderBy: "total_price_minor"})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidOrderBy)
+	repo.AssertNotCalled(t, "ListByUserID", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
 }
 
 func TestListUserBookings_EmptyUserID(t *testing.T) {
 	uc, _, _ := newTestUsecase()
 
-	bookings, err := uc.ListUserBookings(context.Background(), "")
+	bookings, _, err := uc.ListUserBookings(context.Background(), "", domain.BookingFilter{}, domain.PageRequest{})
 
 	assert.Nil(t, bookings)
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
@@ -184,15 +629,16 @@ func TestCancelBooking_Success(t *testing.T) {
 	ctx := context.Background()
 
 	booking := &domain.Booking{
-		ID:          "booking-1",
-		UserID:      "user-1",
-		EventID:     "event-1",
-		TicketCount: 3,
-		Status:      domain.BookingStatusPending,
+		ID:            "booking-1",
+		UserID:        "user-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   3,
+		Status:        domain.BookingStatusPending,
 	}
 	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
-	eventClient.On("ReleaseTickets", ctx, "event-1", int32(3)).Return(nil)
-	repo.On("UpdateStatus", ctx, "booking-1", domain.BookingStatusCancelled).Return(nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
 
 	err := uc.CancelBooking(ctx, "booking-1")
 
@@ -201,6 +647,31 @@ func TestCancelBooking_Success(t *testing.T) {
 	eventClient.AssertExpectations(t)
 }
 
+func TestCancelBooking_LegacyHoldReleasedByCount(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:            "booking-1",
+		UserID:        "user-1",
+		EventID:       "event-1",
+		ReservationID: "booking-1",
+		LegacyHold:    true,
+		TicketCount:   3,
+		Status:        domain.BookingStatusConfirmed,
+	}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+	eventClient.On("ReleaseLegacyTickets", ctx, "booking-1", "event-1", int32(3)).Return(nil)
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	eventClient.AssertNotCalled(t, "ReleaseTickets", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
 func TestCancelBooking_EmptyID(t *testing.T) {
 	uc, _, _ := newTestUsecase()
 
@@ -240,16 +711,281 @@ func TestCancelBooking_ReleaseTicketsFails(t *testing.T) {
 	ctx := context.Background()
 
 	booking := &domain.Booking{
-		ID:          "booking-1",
-		EventID:     "event-1",
-		TicketCount: 3,
-		Status:      domain.BookingStatusPending,
+		ID:            "booking-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   3,
+		Status:        domain.BookingStatusPending,
 	}
 	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
-	eventClient.On("ReleaseTickets", ctx, "event-1", int32(3)).Return(errors.New("event service unavailable"))
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(errors.New("event service unavailable"))
+	repo.On("RecordCompensation", ctx, mock.MatchedBy(func(c *domain.Compensation) bool {
+		return c.ReservationID == "res-1" && !c.Succeeded
+	})).Return(nil)
 
 	err := uc.CancelBooking(ctx, "booking-1")
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "event service unavailable")
+	repo.AssertExpectations(t)
+}
+
+func TestCancelBooking_LostRace(t *testing.T) {
+	for _, lost := range []struct {
+		status domain.BookingStatus
+		err    error
+	}{
+		{domain.BookingStatusCancelled, domain.ErrAlreadyCancelled},
+		{domain.BookingStatusExpired, domain.ErrHoldExpired},
+	} {
+		uc, repo, eventClient := newTestUsecase()
+		ctx := context.Background()
+
+		// A concurrent cancel, or the reaper, changes the booking after it
+		// was read.
+		repo.On("GetByID", ctx, "booking-1").Return(&domain.Booking{
+			ID:            "booking-1",
+			EventID:       "event-1",
+			ReservationID: "res-1",
+			TicketCount:   3,
+			Status:        domain.BookingStatusPending,
+		}, nil).Once()
+		repo.On("CancelLive", ctx, "booking-1", testNow).Return(false, nil)
+		repo.On("GetByID", ctx, "booking-1").Return(&domain.Booking{ID: "booking-1", Status: lost.status}, nil).Once()
+
+		err := uc.CancelBooking(ctx, "booking-1")
+
+		assert.ErrorIs(t, err, lost.err)
+		eventClient.AssertNotCalled(t, "ReleaseTickets", mock.Anything, mock.Anything)
+	}
+}
+
+func TestCancelBooking_Expired(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:     "booking-1",
+		Status: domain.BookingStatusExpired,
+	}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.ErrorIs(t, err, domain.ErrHoldExpired)
+	eventClient.AssertNotCalled(t, "ReleaseTickets", mock.Anything, mock.Anything)
+}
+
+func TestConfirmBooking_Success(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:        "booking-1",
+		Status:    domain.BookingStatusPending,
+		ExpiresAt: testNow.Add(time.Minute),
+	}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+	repo.On("ConfirmPending", ctx, "booking-1", testNow).Return(true, nil)
+
+	confirmed, err := uc.ConfirmBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.BookingStatusConfirmed, confirmed.Status)
+	repo.AssertExpectations(t)
+}
+
+func TestConfirmBooking_AlreadyConfirmed(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:     "booking-1",
+		Status: domain.BookingStatusConfirmed,
+	}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+
+	confirmed, err := uc.ConfirmBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, booking, confirmed)
+	repo.AssertNotCalled(t, "ConfirmPending", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestConfirmBooking_HoldLapsed(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:        "booking-1",
+		Status:    domain.BookingStatusPending,
+		ExpiresAt: testNow.Add(-time.Minute),
+	}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+	repo.On("ConfirmPending", ctx, "booking-1", testNow).Return(false, nil)
+
+	confirmed, err := uc.ConfirmBooking(ctx, "booking-1")
+
+	assert.Nil(t, confirmed)
+	assert.ErrorIs(t, err, domain.ErrHoldExpired)
+}
+
+func TestConfirmBooking_Expired(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:     "booking-1",
+		Status: domain.BookingStatusExpired,
+	}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+
+	confirmed, err := uc.ConfirmBooking(ctx, "booking-1")
+
+	assert.Nil(t, confirmed)
+	assert.ErrorIs(t, err, domain.ErrHoldExpired)
+}
+
+func TestConfirmBooking_Cancelled(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	booking := &domain.Booking{
+		ID:     "booking-1",
+		Status: domain.BookingStatusCancelled,
+	}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+
+	confirmed, err := uc.ConfirmBooking(ctx, "booking-1")
+
+	assert.Nil(t, confirmed)
+	assert.ErrorIs(t, err, domain.ErrAlreadyCancelled)
+}
+
+func TestConfirmBooking_NotFound(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(nil, nil)
+
+	confirmed, err := uc.ConfirmBooking(ctx, "booking-1")
+
+	assert.Nil(t, confirmed)
+	assert.ErrorIs(t, err, domain.ErrBookingNotFound)
+}
+
+func TestCreateBooking_EventNotPublished(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_SALES_CLOSED,
+	}, nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrEventNotOnSale)
+	eventClient.AssertNotCalled(t, "ReserveTickets", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_EventClosedDuringReserve(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:             "event-1",
+		AvailableSeats: 100,
+		Status:         eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(nil, client.ErrEventNotOnSale)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrEventNotOnSale)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCancelEventBookings(t *testing.T) {
+	uc, repo, eventClient, queue := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	repo.On("CancelByEventID", ctx, "event-1", testNow).Return([]*domain.Booking{
+		{ID: "booking-1", EventID: "event-1", Status: domain.BookingStatusCancelled},
+		{ID: "booking-2", EventID: "event-1", Status: domain.BookingStatusCancelled},
+	}, nil)
+	queue.On("DeleteEvent", ctx, "event-1").Return(nil)
+
+	cancelled, err := uc.CancelEventBookings(ctx, "event-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), cancelled)
+	eventClient.AssertNotCalled(t, "ReleaseTickets", mock.Anything, mock.Anything)
+	queue.AssertExpectations(t)
+}
+
+func TestCancelEventBookings_InvalidInput(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+
+	_, err := uc.CancelEventBookings(context.Background(), "")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	repo.AssertNotCalled(t, "CancelByEventID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExpireHolds_ReleasesSeats(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	expired := []*domain.Booking{
+		{ID: "b-1", EventID: "event-1", ReservationID: "res-1", TicketCount: 2},
+		{ID: "b-2", EventID: "event-2", ReservationID: "res-2", TicketCount: 1},
+	}
+	repo.On("ExpirePending", ctx, testNow, 50).Return(expired, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	eventClient.On("ReleaseTickets", ctx, "res-2").Return(nil)
+
+	count, err := uc.ExpireHolds(ctx, 50)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestExpireHolds_ReleaseFailsRecordsCompensation(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	expired := []*domain.Booking{
+		{ID: "b-1", UserID: "user-1", EventID: "event-1", ReservationID: "res-1", TicketCount: 2},
+	}
+	repo.On("ExpirePending", ctx, testNow, 50).Return(expired, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(client.ErrEventService)
+	repo.On("RecordCompensation", ctx, mock.MatchedBy(func(c *domain.Compensation) bool {
+		return !c.Succeeded && c.EventID == "event-1" && c.ReservationID == "res-1" && c.Attempts == defaultReleaseAttempts
+	})).Return(nil)
+
+	count, err := uc.ExpireHolds(ctx, 50)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	repo.AssertExpectations(t)
+}
+
+func TestExpireHolds_RepoError(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	repo.On("ExpirePending", ctx, testNow, 50).Return(nil, errors.New("db error"))
+
+	count, err := uc.ExpireHolds(ctx, 50)
+
+	assert.Error(t, err)
+	assert.Zero(t, count)
 }
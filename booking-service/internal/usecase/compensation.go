@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/metrics"
+	"go.uber.org/zap"
+)
+
+const compensationTimeout = 10 * time.Second
+
+// compensateReservation undoes a seat reservation whose booking row could not
+// be persisted. The outcome is recorded so failed releases can be reconciled.
+func (u *BookingUsecase) compensateReservation(ctx context.Context, booking *domain.Booking, cause error) error {
+	// The caller's context may already be cancelled, which is often the reason
+	// the insert failed in the first place.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensationTimeout)
+	defer cancel()
+
+	logger.FromContext(ctx).Warn("CreateBooking: persisting booking failed, releasing reservation",
+		zap.String("eventID", booking.EventID),
+		zap.String("reservationID", booking.ReservationID),
+		zap.Int32("ticketCount", booking.TicketCount),
+		zap.Error(cause),
+	)
+
+	if err := u.releaseAndRecord(ctx, booking, cause, "create"); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrCompensationFailed, cause)
+	}
+
+	return fmt.Errorf("%w: %v", domain.ErrBookingRolledBack, cause)
+}
+
+// abandonReservation releases a reservation whose ReserveTickets call failed
+// without a definite answer. Event-service may have committed it before the
+// deadline ran out; if it did not, the release leaves a tombstone that turns a
+// late reserve into a no-op.
+func (u *BookingUsecase) abandonReservation(ctx context.Context, booking *domain.Booking, cause error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), compensationTimeout)
+	defer cancel()
+
+	logger.FromContext(ctx).Warn("CreateBooking: reserve outcome unknown, releasing reservation",
+		zap.String("eventID", booking.EventID),
+		zap.String("reservationID", booking.ReservationID),
+		zap.Int32("ticketCount", booking.TicketCount),
+		zap.Error(cause),
+	)
+
+	_ = u.releaseAndRecord(ctx, booking, cause, "reserve")
+}
+
+// releaseAndRecord releases the booking's reservation and records the outcome
+// as a compensation. op labels the failure metric.
+func (u *BookingUsecase) releaseAndRecord(ctx context.Context, booking *domain.Booking, cause error, op string) error {
+	attempts, releaseErr := u.releaseWithRetry(ctx, booking.ReservationID)
+
+	compensation := &domain.Compensation{
+		UserID:        booking.UserID,
+		EventID:       booking.EventID,
+		ReservationID: booking.ReservationID,
+		TicketCount:   booking.TicketCount,
+		Reason:        cause.Error(),
+		Attempts:      attempts,
+		Succeeded:     releaseErr == nil,
+	}
+	if err := u.repo.RecordCompensation(ctx, compensation); err != nil {
+		logger.FromContext(ctx).Error("CreateBooking: recording compensation failed",
+			zap.String("eventID", booking.EventID),
+			zap.Bool("succeeded", compensation.Succeeded),
+			zap.Error(err),
+		)
+	}
+
+	if releaseErr != nil {
+		metrics.CompensationFailures.WithLabelValues(op).Inc()
+		logger.FromContext(ctx).Error("CreateBooking: releasing reservation failed, seats leaked",
+			zap.String("eventID", booking.EventID),
+			zap.Int32("ticketCount", booking.TicketCount),
+			zap.Int32("attempts", attempts),
+			zap.Error(releaseErr),
+		)
+	}
+	return releaseErr
+}
+
+// releaseWithRetry releases a reservation, retrying with a linear backoff.
+// Releases are idempotent in event-service, so a retry after a lost response
+// can never return the seats twice. It returns the number of attempts made and
+// the last error, if every attempt failed.
+func (u *BookingUsecase) releaseWithRetry(ctx context.Context, reservationID string) (int32, error) {
+	return u.retryRelease(ctx, reservationID, func() error {
+		return u.eventClient.ReleaseTickets(ctx, reservationID)
+	})
+}
+
+// releaseBookingWithRetry is releaseWithRetry for a booking's seats. A legacy
+// hold has no reservation in event-service, so its seats are given back by
+// count instead; event-service still does that only once.
+func (u *BookingUsecase) releaseBookingWithRetry(ctx context.Context, booking *domain.Booking) (int32, error) {
+	if !booking.LegacyHold {
+		return u.releaseWithRetry(ctx, booking.ReservationID)
+	}
+	return u.retryRelease(ctx, booking.ReservationID, func() error {
+		return u.eventClient.ReleaseLegacyTickets(ctx, booking.ReservationID, booking.EventID, booking.TicketCount)
+	})
+}
+
+func (u *BookingUsecase) retryRelease(ctx context.Context, reservationID string, release func() error) (int32, error) {
+	var (
+		attempts int32
+		err      error
+	)
+
+	for i := 0; i < u.releaseAttempts; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return attempts, ctx.Err()
+			case <-time.After(time.Duration(i) * u.releaseBackoff):
+			}
+		}
+
+		attempts++
+		if err = release(); err == nil {
+			return attempts, nil
+		}
+		logger.FromContext(ctx).Warn("ReleaseTickets attempt failed",
+			zap.String("reservationID", reservationID),
+			zap.Int32("attempt", attempts),
+			zap.Error(err),
+		)
+	}
+
+	return attempts, err
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+const maxIdempotencyKeyLength = 255
+
+type IdempotencyUsecase struct {
+	repo domain.IdempotencyRepository
+	ttl  time.Duration
+	now  func() time.Time
+}
+
+func NewIdempotencyUsecase(repo domain.IdempotencyRepository, ttl time.Duration) *IdempotencyUsecase {
+	return &IdempotencyUsecase{
+		repo: repo,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// Execute runs fn at most once per subject, key and operation within the TTL
+// window.
+// A repeat with the same fingerprint gets the stored response back; a repeat
+// with a different fingerprint is rejected. If fn fails, the key is freed so
+// the client can retry.
+func (u *IdempotencyUsecase) Execute(ctx context.Context, subject, key, operation, fingerprint string, fn func() ([]byte, error)) ([]byte, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, domain.ErrInvalidInput
+	}
+
+	now := u.now()
+	existing, err := u.repo.Reserve(ctx, &domain.IdempotencyRecord{
+		Subject:     subject,
+		Key:         key,
+		Operation:   operation,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(u.ttl),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		if existing.Fingerprint != fingerprint {
+			return nil, domain.ErrIdempotencyKeyReused
+		}
+		if existing.Response == nil {
+			return nil, domain.ErrIdempotencyInProgress
+		}
+		logger.FromContext(ctx).Info("Idempotent replay",
+			zap.String("operation", operation),
+			zap.String("idempotencyKey", key),
+		)
+		return existing.Response, nil
+	}
+
+	response, err := fn()
+	if err != nil {
+		if delErr := u.repo.Delete(context.WithoutCancel(ctx), subject, key, operation); delErr != nil {
+			logger.FromContext(ctx).Error("Idempotency: releasing key failed",
+				zap.String("operation", operation),
+				zap.String("idempotencyKey", key),
+				zap.Error(delErr),
+			)
+		}
+		return nil, err
+	}
+
+	if err := u.repo.Complete(context.WithoutCancel(ctx), subject, key, operation, response); err != nil {
+		// The operation itself succeeded, so report success; retries with this
+		// key will see it as in progress until it expires.
+		logger.FromContext(ctx).Error("Idempotency: storing response failed",
+			zap.String("operation", operation),
+			zap.String("idempotencyKey", key),
+			zap.Error(err),
+		)
+	}
+
+	return response, nil
+}
+
+// DeleteExpired removes idempotency records whose window has passed.
+func (u *IdempotencyUsecase) DeleteExpired(ctx context.Context) (int64, error) {
+	return u.repo.DeleteExpired(ctx, u.now())
+}
@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testIdempotencyTTL = 24 * time.Hour
+
+func newTestIdempotencyUsecase() (*IdempotencyUsecase, *mocks.MockIdempotencyRepository) {
+	repo := new(mocks.MockIdempotencyRepository)
+	uc := NewIdempotencyUsecase(repo, testIdempotencyTTL)
+	uc.now = func() time.Time { return testNow }
+	return uc, repo
+}
+
+func TestIdempotencyExecute_FirstCall(t *testing.T) {
+	uc, repo := newTestIdempotencyUsecase()
+	ctx := context.Background()
+
+	repo.On("Reserve", ctx, mock.MatchedBy(func(r *domain.IdempotencyRecord) bool {
+		return r.Subject == "user-1" && r.Key == "key-1" && r.Fingerprint == "fp" && r.ExpiresAt.Equal(testNow.Add(testIdempotencyTTL))
+	})).Return(nil, nil)
+	repo.On("Complete", mock.Anything, "user-1", "key-1", "CreateBooking", []byte("response")).Return(nil)
+
+	calls := 0
+	resp, err := uc.Execute(ctx, "user-1", "key-1", "CreateBooking", "fp", func() ([]byte, error) {
+		calls++
+		return []byte("response"), nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("response"), resp)
+	assert.Equal(t, 1, calls)
+	repo.AssertExpectations(t)
+}
+
+func TestIdempotencyExecute_Replay(t *testing.T) {
+	uc, repo := newTestIdempotencyUsecase()
+	ctx := context.Background()
+
+	repo.On("Reserve", ctx, mock.AnythingOfType("*domain.IdempotencyRecord")).Return(&domain.IdempotencyRecord{
+		Key:         "key-1",
+		Operation:   "CreateBooking",
+		Fingerprint: "fp",
+		Response:    []byte("original"),
+	}, nil)
+
+	resp, err := uc.Execute(ctx, "user-1", "key-1", "CreateBooking", "fp", func() ([]byte, error) {
+		t.Fatal("fn must not run on replay")
+		return nil, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("original"), resp)
+}
+
+func TestIdempotencyExecute_DifferentPayload(t *testing.T) {
+	uc, repo := newTestIdempotencyUsecase()
+	ctx := context.Background()
+
+	repo.On("Reserve", ctx, mock.AnythingOfType("*domain.IdempotencyRecord")).Return(&domain.IdempotencyRecord{
+		Key:         "key-1",
+		Fingerprint: "other",
+		Response:    []byte("original"),
+	}, nil)
+
+	resp, err := uc.Execute(ctx, "user-1", "key-1", "CreateBooking", "fp", func() ([]byte, error) {
+		t.Fatal("fn must not run when the key is reused")
+		return nil, nil
+	})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, domain.ErrIdempotencyKeyReused)
+}
+
+func TestIdempotencyExecute_InProgress(t *testing.T) {
+	uc, repo := newTestIdempotencyUsecase()
+	ctx := context.Background()
+
+	repo.On("Reserve", ctx, mock.AnythingOfType("*domain.IdempotencyRecord")).Return(&domain.IdempotencyRecord{
+		Key:         "key-1",
+		Fingerprint: "fp",
+	}, nil)
+
+	resp, err := uc.Execute(ctx, "user-1", "key-1", "CreateBooking", "fp", func() ([]byte, error) {
+		t.Fatal("fn must not run while the key is in progress")
+		return nil, nil
+	})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, domain.ErrIdempotencyInProgress)
+}
+
+func TestIdempotencyExecute_FailureReleasesKey(t *testing.T) {
+	uc, repo := newTestIdempotencyUsecase()
+	ctx := context.Background()
+
+	repo.On("Reserve", ctx, mock.AnythingOfType("*domain.IdempotencyRecord")).Return(nil, nil)
+	repo.On("Delete", mock.Anything, "user-1", "key-1", "CreateBooking").Return(nil)
+
+	resp, err := uc.Execute(ctx, "user-1", "key-1", "CreateBooking", "fp", func() ([]byte, error) {
+		return nil, domain.ErrInsufficientSeats
+	})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, domain.ErrInsufficientSeats)
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestIdempotencyExecute_CompleteFailsStillSucceeds(t *testing.T) {
+	uc, repo := newTestIdempotencyUsecase()
+	ctx := context.Background()
+
+	repo.On("Reserve", ctx, mock.AnythingOfType("*domain.IdempotencyRecord")).Return(nil, nil)
+	repo.On("Complete", mock.Anything, "user-1", "key-1", "CreateBooking", []byte("response")).Return(errors.New("db error"))
+
+	resp, err := uc.Execute(ctx, "user-1", "key-1", "CreateBooking", "fp", func() ([]byte, error) {
+		return []byte("response"), nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("response"), resp)
+}
+
+func TestIdempotencyExecute_InvalidKey(t *testing.T) {
+	uc, _ := newTestIdempotencyUsecase()
+
+	resp, err := uc.Execute(context.Background(), "user-1", strings.Repeat("k", maxIdempotencyKeyLength+1), "CreateBooking", "fp", func() ([]byte, error) {
+		return nil, nil
+	})
+
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestIdempotencyDeleteExpired(t *testing.T) {
+	uc, repo := newTestIdempotencyUsecase()
+	ctx := context.Background()
+
+	repo.On("DeleteExpired", ctx, testNow).Return(int64(3), nil)
+
+	deleted, err := uc.DeleteExpired(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+}
@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// ModifyBooking changes a live booking's ticket count, reserving or releasing
+// only the difference. The hold of a pending booking is not extended. Added
+// tickets keep the unit price the booking was made at, and need a waiting room
+// pass like a new booking while the event is in queue mode.
+func (u *BookingUsecase) ModifyBooking(ctx context.Context, bookingID string, ticketCount int32, queuePass string) (*domain.Booking, error) {
+	if bookingID == "" || ticketCount <= 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	booking, err := u.repo.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking == nil {
+		return nil, domain.ErrBookingNotFound
+	}
+	if err := authorizeBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	now := u.now()
+	switch booking.Status {
+	case domain.BookingStatusCancelled:
+		return nil, domain.ErrAlreadyCancelled
+	case domain.BookingStatusExpired:
+		return nil, domain.ErrHoldExpired
+	case domain.BookingStatusPending:
+		if !booking.ExpiresAt.IsZero() && !now.Before(booking.ExpiresAt) {
+			return nil, domain.ErrHoldExpired
+		}
+	}
+	if len(booking.SeatIDs) > 0 || len(booking.Items) > 1 || booking.LegacyHold {
+		return nil, domain.ErrBookingNotModifiable
+	}
+	if booking.TicketCount == ticketCount {
+		return booking, nil
+	}
+	var limit int32
+	if ticketCount > booking.TicketCount {
+		limit, err = u.checkIncrease(ctx, booking, ticketCount, queuePass)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Event-service applies the whole difference or none of it, so a failed
+	// increase leaves the booking as it was.
+	reservation, err := u.eventClient.ResizeTickets(ctx, booking.ReservationID, ticketCount)
+	if err != nil {
+		switch {
+		case errors.Is(err, client.ErrInsufficientSeats):
+			metrics.InsufficientSeats.WithLabelValues("modify").Inc()
+			return nil, domain.ErrInsufficientSeats
+		case errors.Is(err, client.ErrTicketTypeNotOnSale):
+			return nil, domain.ErrTicketTypeNotOnSale
+		case errors.Is(err, client.ErrEventNotOnSale):
+			return nil, domain.ErrEventNotOnSale
+		case errors.Is(err, client.ErrReservationReleased):
+			return nil, domain.ErrBookingChanged
+		case errors.Is(err, client.ErrInvalidReservation):
+			return nil, domain.ErrBookingNotModifiable
+		}
+		return nil, err
+	}
+
+	adjustment := &domain.BookingAdjustment{
+		PreviousCount:      booking.TicketCount,
+		NewCount:           ticketCount,
+		PreviousTotalMinor: booking.TotalPriceMinor,
+		CreatedAt:          now,
+	}
+	booking.TicketCount = ticketCount
+	if len(booking.Items) > 0 {
+		booking.Items = nil
+		booking.TotalPriceMinor = 0
+		priceItems(booking, reservation.GetItems())
+	}
+	adjustment.NewTotalMinor = booking.TotalPriceMinor
+
+	// The limit is checked again under a lock, as a concurrent booking of the
+	// same user may have taken the room left for this increase.
+	modified, err := u.repo.Modify(ctx, booking, adjustment, limit)
+	if err == nil && !modified {
+		err = domain.ErrBookingChanged
+	}
+	if err != nil {
+		u.restoreReservation(ctx, bookingID)
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("ModifyBooking: ticket count changed",
+		zap.String("bookingID", bookingID),
+		zap.Int32("from", adjustment.PreviousCount),
+		zap.Int32("to", adjustment.NewCount),
+	)
+	if ticketCount < adjustment.PreviousCount {
+		u.promoteWaitlist(ctx, booking.EventID)
+	}
+
+	return booking, nil
+}
+
+func (u *BookingUsecase) ListBookingAdjustments(ctx context.Context, bookingID string) ([]*domain.BookingAdjustment, error) {
+	if bookingID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	booking, err := u.repo.GetByID(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking == nil {
+		return nil, domain.ErrBookingNotFound
+	}
+	if err := authorizeBooking(ctx, booking); err != nil {
+		return nil, err
+	}
+
+	return u.repo.ListAdjustments(ctx, bookingID)
+}
+
+// checkIncrease applies the event's waiting room and per-user ticket limit to
+// an increase of booking to ticketCount tickets and returns the limit for
+// Modify to check again.
+func (u *BookingUsecase) checkIncrease(ctx context.Context, booking *domain.Booking, ticketCount int32, queuePass string) (int32, error) {
+	event, err := u.eventClient.GetEvent(ctx, booking.EventID)
+	if err != nil {
+		if errors.Is(err, client.ErrEventNotFound) {
+			return 0, domain.ErrEventNotFound
+		}
+		return 0, err
+	}
+	// Otherwise a small booking made with one pass could be grown after the
+	// pass lapsed, skipping the queue.
+	if event.QueueAdmissionsPerMinute > 0 {
+		if err := u.checkQueuePass(queuePass, booking.EventID, booking.UserID); err != nil {
+			return 0, err
+		}
+	}
+	if err := u.checkTicketLimit(ctx, booking.UserID, booking.EventID, event.MaxTicketsPerUser, ticketCount-booking.TicketCount); err != nil {
+		return 0, err
+	}
+	return event.MaxTicketsPerUser, nil
+}
+
+// restoreReservation resizes a booking's reservation back to the ticket count
+// that is stored, after a resize whose booking update did not go through. A
+// booking that is no longer live has its whole reservation released anyway.
+func (u *BookingUsecase) restoreReservation(ctx context.Context, bookingID string) {
+	ctx = context.WithoutCancel(ctx)
+
+	booking, err := u.repo.GetByID(ctx, bookingID)
+	if err == nil && booking != nil &&
+		(booking.Status == domain.BookingStatusPending || booking.Status == domain.BookingStatusConfirmed) {
+		_, err = u.eventClient.ResizeTickets(ctx, booking.ReservationID, booking.TicketCount)
+	}
+	if err != nil {
+		logger.FromContext(ctx).Error("ModifyBooking: restoring reservation failed",
+			zap.String("bookingID", bookingID),
+			zap.Error(err),
+		)
+	}
+}
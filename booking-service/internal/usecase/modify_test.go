@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func liveBooking() *domain.Booking {
+	return &domain.Booking{
+		ID:            "booking-1",
+		UserID:        "user-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   5,
+		Status:        domain.BookingStatusConfirmed,
+	}
+}
+
+func TestModifyBooking_Decrease(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+	eventClient.On("ResizeTickets", ctx, "res-1", int32(3)).Return(&eventpb.Reservation{Id: "res-1", Quantity: 3}, nil)
+	repo.On("Modify", ctx, mock.MatchedBy(func(b *domain.Booking) bool {
+		return b.TicketCount == 3
+	}), mock.MatchedBy(func(a *domain.BookingAdjustment) bool {
+		return a.PreviousCount == 5 && a.NewCount == 3 && a.CreatedAt.Equal(testNow)
+	}), int32(0)).Return(true, nil)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 3, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), booking.TicketCount)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestModifyBooking_IncreaseRepricesItem(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	existing := liveBooking()
+	existing.TicketCount = 2
+	existing.Items = []*domain.BookingItem{
+		{TicketTypeID: "tt-1", Quantity: 2, UnitPriceMinor: 1500, TotalPriceMinor: 3000, Currency: "EUR"},
+	}
+	existing.TotalPriceMinor = 3000
+	existing.Currency = "EUR"
+	repo.On("GetByID", ctx, "booking-1").Return(existing, nil)
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{Id: "event-1"}, nil)
+	eventClient.On("ResizeTickets", ctx, "res-1", int32(4)).Return(&eventpb.Reservation{
+		Id:       "res-1",
+		Quantity: 4,
+		Items:    []*eventpb.ReservationItem{{TicketTypeId: "tt-1", Quantity: 4, UnitPriceMinor: 1500, Currency: "EUR"}},
+	}, nil)
+	repo.On("Modify", ctx, mock.AnythingOfType("*domain.Booking"), mock.MatchedBy(func(a *domain.BookingAdjustment) bool {
+		return a.PreviousTotalMinor == 3000 && a.NewTotalMinor == 6000
+	}), int32(0)).Return(true, nil)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 4, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6000), booking.TotalPriceMinor)
+	assert.Equal(t, int32(4), booking.Items[0].Quantity)
+	repo.AssertExpectations(t)
+}
+
+func TestModifyBooking_IncreaseInsufficientSeats(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{Id: "event-1"}, nil)
+	eventClient.On("ResizeTickets", ctx, "res-1", int32(8)).Return(nil, client.ErrInsufficientSeats)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 8, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrInsufficientSeats)
+	repo.AssertNotCalled(t, "Modify", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModifyBooking_IncreaseOverTicketLimit(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{Id: "event-1", MaxTicketsPerUser: 6}, nil)
+	repo.On("CountLiveTickets", ctx, "user-1", "event-1").Return(int32(5), nil)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 7, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrTicketLimitExceeded)
+	eventClient.AssertNotCalled(t, "ResizeTickets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModifyBooking_IncreaseLosesRaceForTicketLimit(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	// A concurrent booking of the same user took the last ticket under the
+	// limit after the first check.
+	repo.On("GetByID", mock.Anything, "booking-1").Return(liveBooking(), nil).Once()
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{Id: "event-1", MaxTicketsPerUser: 6}, nil)
+	repo.On("CountLiveTickets", ctx, "user-1", "event-1").Return(int32(5), nil)
+	eventClient.On("ResizeTickets", ctx, "res-1", int32(6)).Return(&eventpb.Reservation{Id: "res-1", Quantity: 6}, nil)
+	repo.On("Modify", ctx, mock.Anything, mock.Anything, int32(6)).Return(false, domain.ErrTicketLimitExceeded)
+	repo.On("GetByID", mock.Anything, "booking-1").Return(liveBooking(), nil).Once()
+	eventClient.On("ResizeTickets", mock.Anything, "res-1", int32(5)).Return(&eventpb.Reservation{Id: "res-1", Quantity: 5}, nil)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 6, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrTicketLimitExceeded)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestModifyBooking_SameCount(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 5, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), booking.TicketCount)
+	eventClient.AssertNotCalled(t, "ResizeTickets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModifyBooking_AssignedSeating(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	booking := liveBooking()
+	booking.SeatIDs = []string{"seat-1", "seat-2"}
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+
+	_, err := uc.ModifyBooking(ctx, "booking-1", 1, "")
+
+	assert.ErrorIs(t, err, domain.ErrBookingNotModifiable)
+	eventClient.AssertNotCalled(t, "ResizeTickets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModifyBooking_HoldLapsed(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	booking := liveBooking()
+	booking.Status = domain.BookingStatusPending
+	booking.ExpiresAt = testNow.Add(-time.Second)
+	repo.On("GetByID", ctx, "booking-1").Return(booking, nil)
+
+	_, err := uc.ModifyBooking(ctx, "booking-1", 3, "")
+
+	assert.ErrorIs(t, err, domain.ErrHoldExpired)
+}
+
+func TestModifyBooking_ConcurrentChangeRestoresReservation(t *testing.T) {
+	uc, repo, eventClient := newTestUsecase()
+	ctx := context.Background()
+
+	stored := liveBooking()
+	stored.TicketCount = 4
+	repo.On("GetByID", mock.Anything, "booking-1").Return(liveBooking(), nil).Once()
+	eventClient.On("ResizeTickets", ctx, "res-1", int32(3)).Return(&eventpb.Reservation{Id: "res-1", Quantity: 3}, nil)
+	repo.On("Modify", ctx, mock.Anything, mock.Anything, int32(0)).Return(false, nil)
+	repo.On("GetByID", mock.Anything, "booking-1").Return(stored, nil).Once()
+	eventClient.On("ResizeTickets", mock.Anything, "res-1", int32(4)).Return(&eventpb.Reservation{Id: "res-1", Quantity: 4}, nil)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 3, "")
+
+	assert.Nil(t, booking)
+	assert.ErrorIs(t, err, domain.ErrBookingChanged)
+	repo.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestModifyBooking_DecreaseOffersSeatsToWaitlist(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+	eventClient.On("ResizeTickets", ctx, "res-1", int32(3)).Return(&eventpb.Reservation{Id: "res-1", Quantity: 3}, nil)
+	repo.On("Modify", ctx, mock.Anything, mock.Anything, int32(0)).Return(true, nil)
+	waitlist.On("Next", ctx, "event-1").Return(nil, nil).Once()
+
+	_, err := uc.ModifyBooking(ctx, "booking-1", 3, "")
+
+	assert.NoError(t, err)
+	waitlist.AssertExpectations(t)
+}
+
+func TestListBookingAdjustments(t *testing.T) {
+	uc, repo, _ := newTestUsecase()
+	ctx := context.Background()
+
+	adjustments := []*domain.BookingAdjustment{{ID: "adj-1", BookingID: "booking-1", PreviousCount: 5, NewCount: 3}}
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+	repo.On("ListAdjustments", ctx, "booking-1").Return(adjustments, nil)
+
+	got, err := uc.ListBookingAdjustments(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, adjustments, got)
+}
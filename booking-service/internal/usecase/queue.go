@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+// JoinQueue puts the user in the waiting room of a published event that has
+// one. A user holds one ticket per event: joining again returns it, keeping
+// its place, until its pass has lapsed, after which the user goes to the back.
+func (u *BookingUsecase) JoinQueue(ctx context.Context, eventID, userID string) (*domain.QueueStatus, error) {
+	userID, err := callerUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if eventID == "" || userID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	event, err := u.eventClient.GetEvent(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, client.ErrEventNotFound) {
+			return nil, domain.ErrEventNotFound
+		}
+		return nil, err
+	}
+	if event.QueueAdmissionsPerMinute <= 0 {
+		return nil, domain.ErrQueueNotActive
+	}
+	if event.Status != eventpb.EventStatus_EVENT_STATUS_PUBLISHED {
+		return nil, domain.ErrEventNotOnSale
+	}
+
+	now := u.now()
+	interval := time.Minute / time.Duration(event.QueueAdmissionsPerMinute)
+	ticket, err := u.queue.Join(ctx, eventID, userID, now, now.Add(-u.passes.TTL()), interval)
+	if err != nil {
+		return nil, err
+	}
+
+	_, ahead, err := u.queue.Get(ctx, ticket.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).Debug("JoinQueue: ticket issued",
+		zap.String("eventID", eventID),
+		zap.String("ticketID", ticket.ID),
+		zap.Time("admitAt", ticket.AdmitAt),
+	)
+
+	return u.queueStatus(ticket, ahead, now), nil
+}
+
+// GetQueuePosition returns a ticket's place in the queue, and its pass once
+// admitted.
+func (u *BookingUsecase) GetQueuePosition(ctx context.Context, ticketID string) (*domain.QueueStatus, error) {
+	if ticketID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	now := u.now()
+	ticket, ahead, err := u.queue.Get(ctx, ticketID, now)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil {
+		return nil, domain.ErrQueueTicketNotFound
+	}
+	if _, err := callerUserID(ctx, ticket.UserID); err != nil {
+		return nil, err
+	}
+
+	return u.queueStatus(ticket, ahead, now), nil
+}
+
+func (u *BookingUsecase) queueStatus(ticket *domain.QueueTicket, ahead int32, now time.Time) *domain.QueueStatus {
+	status := &domain.QueueStatus{Ticket: ticket}
+	if !ticket.Admitted(now) {
+		status.Position = ahead + 1
+		return status
+	}
+	status.Pass, status.PassExpiresAt = u.passes.Issue(ticket.EventID, ticket.UserID, ticket.AdmitAt)
+	return status
+}
+
+// PruneQueues deletes queue tickets whose passes have lapsed. Joining again
+// would replace them anyway. It returns the number deleted.
+func (u *BookingUsecase) PruneQueues(ctx context.Context) (int64, error) {
+	return u.queue.DeleteLapsed(ctx, u.now().Add(-u.passes.TTL()))
+}
+
+func (u *BookingUsecase) checkQueuePass(pass, eventID, userID string) error {
+	if pass == "" {
+		return domain.ErrQueuePassRequired
+	}
+	if err := u.passes.Verify(pass, eventID, userID, u.now()); err != nil {
+		return domain.ErrInvalidQueuePass
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestUsecaseWithQueue() (*BookingUsecase, *mocks.MockBookingRepository, *mocks.MockEventClient, *mocks.MockQueueRepository) {
+	repo := new(mocks.MockBookingRepository)
+	queue := new(mocks.MockQueueRepository)
+	eventClient := new(mocks.MockEventClient)
+	uc := NewBookingUsecase(repo, new(mocks.MockWaitlistRepository), queue, testPasses, eventClient, testHoldTTL)
+	uc.now = func() time.Time { return testNow }
+	return uc, repo, eventClient, queue
+}
+
+func queuedEvent() *eventpb.Event {
+	return &eventpb.Event{
+		Id:                       "event-1",
+		AvailableSeats:           100,
+		Status:                   eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+		QueueAdmissionsPerMinute: 60,
+	}
+}
+
+func TestJoinQueue_Waiting(t *testing.T) {
+	uc, _, eventClient, queue := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	ticket := &domain.QueueTicket{ID: "ticket-1", EventID: "event-1", UserID: "user-1", AdmitAt: testNow.Add(30 * time.Second)}
+	eventClient.On("GetEvent", ctx, "event-1").Return(queuedEvent(), nil)
+	queue.On("Join", ctx, "event-1", "user-1", testNow, testNow.Add(-testPasses.TTL()), time.Second).Return(ticket, nil)
+	queue.On("Get", ctx, "ticket-1", testNow).Return(ticket, int32(29), nil)
+
+	st, err := uc.JoinQueue(ctx, "event-1", "user-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, ticket, st.Ticket)
+	assert.Equal(t, int32(30), st.Position)
+	assert.Empty(t, st.Pass)
+	queue.AssertExpectations(t)
+}
+
+func TestJoinQueue_AdmittedRightAway(t *testing.T) {
+	uc, _, eventClient, queue := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	ticket := &domain.QueueTicket{ID: "ticket-1", EventID: "event-1", UserID: "user-1", AdmitAt: testNow}
+	eventClient.On("GetEvent", ctx, "event-1").Return(queuedEvent(), nil)
+	queue.On("Join", ctx, "event-1", "user-1", testNow, mock.Anything, time.Second).Return(ticket, nil)
+	queue.On("Get", ctx, "ticket-1", testNow).Return(ticket, int32(0), nil)
+
+	st, err := uc.JoinQueue(ctx, "event-1", "user-1")
+
+	assert.NoError(t, err)
+	assert.Zero(t, st.Position)
+	assert.NoError(t, testPasses.Verify(st.Pass, "event-1", "user-1", testNow))
+	assert.Equal(t, testNow.Add(testPasses.TTL()), st.PassExpiresAt)
+}
+
+func TestJoinQueue_NotActive(t *testing.T) {
+	uc, _, eventClient, queue := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:     "event-1",
+		Status: eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}, nil)
+
+	_, err := uc.JoinQueue(ctx, "event-1", "user-1")
+
+	assert.ErrorIs(t, err, domain.ErrQueueNotActive)
+	queue.AssertNotCalled(t, "Join", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetQueuePosition_OtherUsersTicket(t *testing.T) {
+	uc, _, _, queue := newTestUsecaseWithQueue()
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{Subject: "user-2", Roles: []auth.Role{auth.RoleCustomer}})
+
+	ticket := &domain.QueueTicket{ID: "ticket-1", EventID: "event-1", UserID: "user-1", AdmitAt: testNow}
+	queue.On("Get", ctx, "ticket-1", testNow).Return(ticket, int32(0), nil)
+
+	_, err := uc.GetQueuePosition(ctx, "ticket-1")
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+}
+
+func TestGetQueuePosition_NotFound(t *testing.T) {
+	uc, _, _, queue := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	queue.On("Get", ctx, "ticket-1", testNow).Return(nil, int32(0), nil)
+
+	_, err := uc.GetQueuePosition(ctx, "ticket-1")
+
+	assert.ErrorIs(t, err, domain.ErrQueueTicketNotFound)
+}
+
+func TestCreateBooking_QueuePassRequired(t *testing.T) {
+	uc, _, eventClient, _ := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(queuedEvent(), nil)
+	otherEvent, _ := testPasses.Issue("event-2", "user-1", testNow)
+	expired, _ := testPasses.Issue("event-1", "user-1", testNow.Add(-testPasses.TTL()))
+
+	_, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, "")
+	assert.ErrorIs(t, err, domain.ErrQueuePassRequired)
+	_, err = uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, otherEvent)
+	assert.ErrorIs(t, err, domain.ErrInvalidQueuePass)
+	_, err = uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, expired)
+	assert.ErrorIs(t, err, domain.ErrInvalidQueuePass)
+	eventClient.AssertNotCalled(t, "ReserveTickets", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateBooking_WithQueuePass(t *testing.T) {
+	uc, repo, eventClient, _ := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	pass, _ := testPasses.Issue("event-1", "user-1", testNow.Add(-time.Minute))
+	eventClient.On("GetEvent", ctx, "event-1").Return(queuedEvent(), nil)
+	eventClient.On("ReserveTickets", ctx, mock.AnythingOfType("string"), "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	repo.On("Create", ctx, mock.AnythingOfType("*domain.Booking")).Return(nil)
+
+	booking, err := uc.CreateBooking(ctx, "user-1", "event-1", 2, nil, nil, pass)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, booking)
+}
+
+func TestModifyBooking_IncreaseQueuePassRequired(t *testing.T) {
+	uc, repo, eventClient, _ := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+	eventClient.On("GetEvent", ctx, "event-1").Return(queuedEvent(), nil)
+	otherUser, _ := testPasses.Issue("event-1", "user-2", testNow)
+	expired, _ := testPasses.Issue("event-1", "user-1", testNow.Add(-testPasses.TTL()))
+
+	_, err := uc.ModifyBooking(ctx, "booking-1", 7, "")
+	assert.ErrorIs(t, err, domain.ErrQueuePassRequired)
+	_, err = uc.ModifyBooking(ctx, "booking-1", 7, otherUser)
+	assert.ErrorIs(t, err, domain.ErrInvalidQueuePass)
+	_, err = uc.ModifyBooking(ctx, "booking-1", 7, expired)
+	assert.ErrorIs(t, err, domain.ErrInvalidQueuePass)
+	eventClient.AssertNotCalled(t, "ResizeTickets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestModifyBooking_IncreaseWithQueuePass(t *testing.T) {
+	uc, repo, eventClient, _ := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	pass, _ := testPasses.Issue("event-1", "user-1", testNow.Add(-time.Minute))
+	repo.On("GetByID", ctx, "booking-1").Return(liveBooking(), nil)
+	eventClient.On("GetEvent", ctx, "event-1").Return(queuedEvent(), nil)
+	eventClient.On("ResizeTickets", ctx, "res-1", int32(7)).Return(&eventpb.Reservation{Id: "res-1", Quantity: 7}, nil)
+	repo.On("Modify", ctx, mock.Anything, mock.Anything, int32(0)).Return(true, nil)
+
+	booking, err := uc.ModifyBooking(ctx, "booking-1", 7, pass)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), booking.TicketCount)
+}
+
+func TestPruneQueues(t *testing.T) {
+	uc, _, _, queue := newTestUsecaseWithQueue()
+	ctx := context.Background()
+
+	queue.On("DeleteLapsed", ctx, testNow.Add(-testPasses.TTL())).Return(int64(3), nil)
+
+	deleted, err := uc.PruneQueues(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+}
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// JoinWaitlist queues the user for ticketCount tickets of a general admission
+// event, of one of its ticket types if it has any. Joining again with the same request
+// returns the existing entry. If seats are already free the entry is offered
+// right away and returned with position 0.
+func (u *BookingUsecase) JoinWaitlist(ctx context.Context, eventID, userID string, ticketCount int32, ticketTypeID string) (*domain.WaitlistEntry, int32, error) {
+	userID, err := callerUserID(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if eventID == "" || userID == "" || ticketCount <= 0 {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	event, err := u.eventClient.GetEvent(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, client.ErrEventNotFound) {
+			return nil, 0, domain.ErrEventNotFound
+		}
+		return nil, 0, err
+	}
+	// Offers are made without the user present, so there is no one to pick
+	// seats for an assigned-seating event.
+	if event.LayoutId != "" {
+		return nil, 0, domain.ErrWaitlistUnavailable
+	}
+	if event.Status != eventpb.EventStatus_EVENT_STATUS_PUBLISHED {
+		return nil, 0, domain.ErrEventNotOnSale
+	}
+	if err := u.checkWaitlistTicketType(ctx, eventID, ticketTypeID); err != nil {
+		return nil, 0, err
+	}
+	// Offers are not checked again: the user asked for these tickets while
+	// within the limit.
+	if err := u.checkTicketLimit(ctx, userID, eventID, event.MaxTicketsPerUser, ticketCount); err != nil {
+		return nil, 0, err
+	}
+
+	entry, err := u.waitlist.Join(ctx, &domain.WaitlistEntry{
+		EventID:      eventID,
+		UserID:       userID,
+		TicketCount:  ticketCount,
+		TicketTypeID: ticketTypeID,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if entry.TicketCount != ticketCount || entry.TicketTypeID != ticketTypeID {
+		return nil, 0, domain.ErrAlreadyWaitlisted
+	}
+
+	for _, offered := range u.promoteWaitlist(ctx, eventID) {
+		if offered.ID == entry.ID {
+			return offered, 0, nil
+		}
+	}
+
+	return u.GetWaitlistPosition(ctx, eventID, userID)
+}
+
+func (u *BookingUsecase) LeaveWaitlist(ctx context.Context, eventID, userID string) error {
+	userID, err := callerUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if eventID == "" || userID == "" {
+		return domain.ErrInvalidInput
+	}
+
+	left, err := u.waitlist.Leave(ctx, eventID, userID)
+	if err != nil {
+		return err
+	}
+	if !left {
+		return domain.ErrNotWaitlisted
+	}
+
+	return nil
+}
+
+// GetWaitlistPosition returns the user's waiting entry and its 1-based place
+// in the queue.
+func (u *BookingUsecase) GetWaitlistPosition(ctx context.Context, eventID, userID string) (*domain.WaitlistEntry, int32, error) {
+	userID, err := callerUserID(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if eventID == "" || userID == "" {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	entry, position, err := u.waitlist.GetWaiting(ctx, eventID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if entry == nil {
+		return nil, 0, domain.ErrNotWaitlisted
+	}
+
+	return entry, position, nil
+}
+
+// checkWaitlistTicketType makes sure the entry can be offered later: an event
+// with ticket types needs one of them that is on sale, an event without takes
+// none.
+func (u *BookingUsecase) checkWaitlistTicketType(ctx context.Context, eventID, ticketTypeID string) error {
+	ticketTypes, err := u.eventClient.ListTicketTypes(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, client.ErrEventNotFound) {
+			return domain.ErrEventNotFound
+		}
+		return err
+	}
+	if len(ticketTypes) == 0 {
+		if ticketTypeID != "" {
+			return domain.ErrInvalidInput
+		}
+		return nil
+	}
+
+	for _, tt := range ticketTypes {
+		if tt.Id != ticketTypeID {
+			continue
+		}
+		now := u.now()
+		if tt.SalesStart != nil && now.Before(tt.SalesStart.AsTime()) {
+			return domain.ErrTicketTypeNotOnSale
+		}
+		if tt.SalesEnd != nil && !now.Before(tt.SalesEnd.AsTime()) {
+			return domain.ErrTicketTypeNotOnSale
+		}
+		return nil
+	}
+
+	return domain.ErrInvalidInput
+}
+
+// promoteWaitlist offers released seats to the event's waitlist in join order
+// until the queue is empty or the first entry no longer fits. Entries that can
+// never be offered are expired so they do not block the ones behind them.
+// Other failures are logged: the seats stay free and the next release tries
+// again.
+func (u *BookingUsecase) promoteWaitlist(ctx context.Context, eventID string) []*domain.WaitlistEntry {
+	var offered []*domain.WaitlistEntry
+	for {
+		entry, err := u.waitlist.Next(ctx, eventID)
+		if err != nil {
+			logger.FromContext(ctx).Error("promoteWaitlist: loading next entry failed",
+				zap.String("eventID", eventID),
+				zap.Error(err),
+			)
+			return offered
+		}
+		if entry == nil {
+			return offered
+		}
+
+		booking, err := u.offerEntry(ctx, entry)
+		if isPermanentOfferError(err) {
+			logger.FromContext(ctx).Warn("promoteWaitlist: expiring entry that cannot be offered",
+				zap.String("eventID", eventID),
+				zap.String("entryID", entry.ID),
+				zap.Error(err),
+			)
+			if _, err := u.waitlist.Expire(ctx, entry.ID); err != nil {
+				logger.FromContext(ctx).Error("promoteWaitlist: expiring entry failed",
+					zap.String("eventID", eventID),
+					zap.String("entryID", entry.ID),
+					zap.Error(err),
+				)
+				return offered
+			}
+			continue
+		}
+		if err != nil {
+			// Skipping ahead would break FIFO order, so a head entry that does
+			// not fit blocks the queue until more seats are released.
+			if !errors.Is(err, client.ErrInsufficientSeats) {
+				logger.FromContext(ctx).Error("promoteWaitlist: offering seats failed",
+					zap.String("eventID", eventID),
+					zap.String("entryID", entry.ID),
+					zap.Error(err),
+				)
+			}
+			return offered
+		}
+		if booking == nil {
+			continue
+		}
+
+		logger.FromContext(ctx).Info("promoteWaitlist: seats offered",
+			zap.String("eventID", eventID),
+			zap.String("entryID", entry.ID),
+			zap.String("bookingID", booking.ID),
+		)
+		entry.Status = domain.WaitlistStatusOffered
+		entry.BookingID = booking.ID
+		offered = append(offered, entry)
+	}
+}
+
+// isPermanentOfferError reports whether event-service turned an entry down for
+// good, e.g. because its ticket type was deleted or its sales ended, so that
+// retrying on the next release cannot help.
+func isPermanentOfferError(err error) bool {
+	return errors.Is(err, client.ErrInvalidReservation) ||
+		errors.Is(err, client.ErrTicketTypeNotOnSale) ||
+		errors.Is(err, client.ErrEventNotFound)
+}
+
+// offerEntry reserves seats for a waiting entry and turns it into a pending
+// booking. It returns a nil booking if the entry stopped waiting meanwhile.
+func (u *BookingUsecase) offerEntry(ctx context.Context, entry *domain.WaitlistEntry) (*domain.Booking, error) {
+	var items []*domain.BookingItem
+	if entry.TicketTypeID != "" {
+		items = []*domain.BookingItem{{TicketTypeID: entry.TicketTypeID, Quantity: entry.TicketCount}}
+	}
+
+	// The entry ID doubles as the reservation ID, so concurrent promoters
+	// offering the same entry share one reservation, and a retry after a
+	// failed Fulfill picks up the seats it already holds.
+	reservation, err := u.eventClient.ReserveTickets(ctx, entry.ID, entry.EventID, entry.TicketCount, nil, reservationItems(items))
+	if err != nil {
+		return nil, err
+	}
+
+	booking := &domain.Booking{
+		UserID:        entry.UserID,
+		EventID:       entry.EventID,
+		ReservationID: entry.ID,
+		TicketCount:   entry.TicketCount,
+		ExpiresAt:     u.now().Add(u.holdTTL),
+	}
+	priceItems(booking, reservation.GetItems())
+
+	status, err := u.waitlist.Fulfill(ctx, entry.ID, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == domain.WaitlistStatusOffered {
+		if booking.ID == "" {
+			// Another promoter offered it first; the seats are its booking's.
+			return nil, nil
+		}
+		metrics.BookingsCreated.WithLabelValues("waitlist").Inc()
+		return booking, nil
+	}
+
+	// The entry left or expired meanwhile, so nobody will book the seats.
+	if _, err := u.releaseWithRetry(ctx, entry.ID); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,416 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	eventpb "github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/event"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func publishedEvent() *eventpb.Event {
+	return &eventpb.Event{
+		Id:     "event-1",
+		Status: eventpb.EventStatus_EVENT_STATUS_PUBLISHED,
+	}
+}
+
+func TestJoinWaitlist_Queued(t *testing.T) {
+	uc, _, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	entry := &domain.WaitlistEntry{
+		ID:          "entry-1",
+		EventID:     "event-1",
+		UserID:      "user-1",
+		TicketCount: 2,
+		Status:      domain.WaitlistStatusWaiting,
+	}
+	eventClient.On("GetEvent", ctx, "event-1").Return(publishedEvent(), nil)
+	eventClient.On("ListTicketTypes", ctx, "event-1").Return([]*eventpb.TicketType(nil), nil)
+	waitlist.On("Join", ctx, mock.MatchedBy(func(e *domain.WaitlistEntry) bool {
+		return e.EventID == "event-1" && e.UserID == "user-1" && e.TicketCount == 2
+	})).Return(entry, nil)
+	// The head entry still waits for more seats than are free.
+	waitlist.On("Next", ctx, "event-1").Return(&domain.WaitlistEntry{ID: "entry-0", EventID: "event-1", TicketCount: 5}, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-0", "event-1", int32(5), []string(nil), []*eventpb.ReservationItem(nil)).Return(nil, client.ErrInsufficientSeats)
+	waitlist.On("GetWaiting", ctx, "event-1", "user-1").Return(entry, int32(2), nil)
+
+	got, position, err := uc.JoinWaitlist(ctx, "event-1", "user-1", 2, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entry, got)
+	assert.Equal(t, int32(2), position)
+	waitlist.AssertNotCalled(t, "Fulfill", mock.Anything, mock.Anything, mock.Anything)
+	waitlist.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestJoinWaitlist_OfferedImmediately(t *testing.T) {
+	uc, _, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	entry := &domain.WaitlistEntry{
+		ID:           "entry-1",
+		EventID:      "event-1",
+		UserID:       "user-1",
+		TicketCount:  2,
+		TicketTypeID: "tt-1",
+		Status:       domain.WaitlistStatusWaiting,
+	}
+	eventClient.On("GetEvent", ctx, "event-1").Return(publishedEvent(), nil)
+	eventClient.On("ListTicketTypes", ctx, "event-1").Return([]*eventpb.TicketType{
+		{Id: "tt-0", SalesEnd: timestamppb.New(testNow)},
+		{Id: "tt-1", SalesStart: timestamppb.New(testNow.Add(-time.Hour))},
+	}, nil)
+	waitlist.On("Join", ctx, mock.AnythingOfType("*domain.WaitlistEntry")).Return(entry, nil)
+	waitlist.On("Next", ctx, "event-1").Return(entry, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(nil, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-1", "event-1", int32(2), []string(nil), []*eventpb.ReservationItem{
+		{TicketTypeId: "tt-1", Quantity: 2},
+	}).Return(&eventpb.Reservation{
+		Items: []*eventpb.ReservationItem{{TicketTypeId: "tt-1", Quantity: 2, UnitPriceMinor: 1500, Currency: "EUR"}},
+	}, nil)
+	waitlist.On("Fulfill", ctx, "entry-1", mock.MatchedBy(func(b *domain.Booking) bool {
+		return b.ReservationID == "entry-1" && b.UserID == "user-1" && b.TicketCount == 2 &&
+			b.TotalPriceMinor == 3000 && b.ExpiresAt.Equal(testNow.Add(testHoldTTL))
+	})).Run(func(args mock.Arguments) {
+		args.Get(2).(*domain.Booking).ID = "booking-1"
+	}).Return(domain.WaitlistStatusOffered, nil)
+
+	got, position, err := uc.JoinWaitlist(ctx, "event-1", "user-1", 2, "tt-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), position)
+	assert.Equal(t, domain.WaitlistStatusOffered, got.Status)
+	assert.Equal(t, "booking-1", got.BookingID)
+	waitlist.AssertNotCalled(t, "GetWaiting", mock.Anything, mock.Anything, mock.Anything)
+	waitlist.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestJoinWaitlist_InvalidInput(t *testing.T) {
+	uc, _, _, _ := newTestUsecaseWithWaitlist()
+
+	_, _, err := uc.JoinWaitlist(context.Background(), "event-1", "user-1", 0, "")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestJoinWaitlist_AssignedSeating(t *testing.T) {
+	uc, _, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	event := publishedEvent()
+	event.LayoutId = "layout-1"
+	eventClient.On("GetEvent", ctx, "event-1").Return(event, nil)
+
+	_, _, err := uc.JoinWaitlist(ctx, "event-1", "user-1", 2, "")
+
+	assert.ErrorIs(t, err, domain.ErrWaitlistUnavailable)
+	waitlist.AssertNotCalled(t, "Join", mock.Anything, mock.Anything)
+}
+
+func TestJoinWaitlist_EventNotPublished(t *testing.T) {
+	uc, _, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(&eventpb.Event{
+		Id:     "event-1",
+		Status: eventpb.EventStatus_EVENT_STATUS_DRAFT,
+	}, nil)
+
+	_, _, err := uc.JoinWaitlist(ctx, "event-1", "user-1", 2, "")
+
+	assert.ErrorIs(t, err, domain.ErrEventNotOnSale)
+	waitlist.AssertNotCalled(t, "Join", mock.Anything, mock.Anything)
+}
+
+func TestJoinWaitlist_TicketLimitExceeded(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	event := publishedEvent()
+	event.MaxTicketsPerUser = 2
+	eventClient.On("GetEvent", ctx, "event-1").Return(event, nil)
+	eventClient.On("ListTicketTypes", ctx, "event-1").Return([]*eventpb.TicketType(nil), nil)
+	repo.On("CountLiveTickets", ctx, "user-1", "event-1").Return(int32(1), nil)
+
+	_, _, err := uc.JoinWaitlist(ctx, "event-1", "user-1", 2, "")
+
+	assert.ErrorIs(t, err, domain.ErrTicketLimitExceeded)
+	waitlist.AssertNotCalled(t, "Join", mock.Anything, mock.Anything)
+}
+
+func TestJoinWaitlist_DifferentRequest(t *testing.T) {
+	uc, _, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	eventClient.On("GetEvent", ctx, "event-1").Return(publishedEvent(), nil)
+	eventClient.On("ListTicketTypes", ctx, "event-1").Return([]*eventpb.TicketType(nil), nil)
+	waitlist.On("Join", ctx, mock.AnythingOfType("*domain.WaitlistEntry")).Return(&domain.WaitlistEntry{
+		ID:          "entry-1",
+		EventID:     "event-1",
+		UserID:      "user-1",
+		TicketCount: 4,
+		Status:      domain.WaitlistStatusWaiting,
+	}, nil)
+
+	_, _, err := uc.JoinWaitlist(ctx, "event-1", "user-1", 2, "")
+
+	assert.ErrorIs(t, err, domain.ErrAlreadyWaitlisted)
+	waitlist.AssertNotCalled(t, "Next", mock.Anything, mock.Anything)
+}
+
+func TestJoinWaitlist_TicketTypeChecked(t *testing.T) {
+	ticketTypes := []*eventpb.TicketType{
+		{Id: "tt-1"},
+		{Id: "tt-late", SalesStart: timestamppb.New(testNow.Add(time.Hour))},
+		{Id: "tt-over", SalesEnd: timestamppb.New(testNow)},
+	}
+	tests := []struct {
+		name         string
+		ticketTypes  []*eventpb.TicketType
+		ticketTypeID string
+		want         error
+	}{
+		{"missing", ticketTypes, "", domain.ErrInvalidInput},
+		{"unknown", ticketTypes, "tt-9", domain.ErrInvalidInput},
+		{"not yet on sale", ticketTypes, "tt-late", domain.ErrTicketTypeNotOnSale},
+		{"sales ended", ticketTypes, "tt-over", domain.ErrTicketTypeNotOnSale},
+		{"event without ticket types", nil, "tt-1", domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, eventClient, waitlist := newTestUsecaseWithWaitlist()
+			ctx := context.Background()
+
+			eventClient.On("GetEvent", ctx, "event-1").Return(publishedEvent(), nil)
+			eventClient.On("ListTicketTypes", ctx, "event-1").Return(tt.ticketTypes, nil)
+
+			_, _, err := uc.JoinWaitlist(ctx, "event-1", "user-1", 2, tt.ticketTypeID)
+
+			assert.ErrorIs(t, err, tt.want)
+			waitlist.AssertNotCalled(t, "Join", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestLeaveWaitlist_Success(t *testing.T) {
+	uc, _, _, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	waitlist.On("Leave", ctx, "event-1", "user-1").Return(true, nil)
+
+	err := uc.LeaveWaitlist(ctx, "event-1", "user-1")
+
+	assert.NoError(t, err)
+	waitlist.AssertExpectations(t)
+}
+
+func TestLeaveWaitlist_NotWaiting(t *testing.T) {
+	uc, _, _, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	waitlist.On("Leave", ctx, "event-1", "user-1").Return(false, nil)
+
+	err := uc.LeaveWaitlist(ctx, "event-1", "user-1")
+
+	assert.ErrorIs(t, err, domain.ErrNotWaitlisted)
+}
+
+func TestGetWaitlistPosition_NotWaiting(t *testing.T) {
+	uc, _, _, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	waitlist.On("GetWaiting", ctx, "event-1", "user-1").Return(nil, int32(0), nil)
+
+	_, _, err := uc.GetWaitlistPosition(ctx, "event-1", "user-1")
+
+	assert.ErrorIs(t, err, domain.ErrNotWaitlisted)
+}
+
+func TestCancelBooking_OffersSeatsToWaitlist(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(&domain.Booking{
+		ID:            "booking-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   3,
+		Status:        domain.BookingStatusConfirmed,
+	}, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
+
+	first := &domain.WaitlistEntry{ID: "entry-1", EventID: "event-1", UserID: "user-2", TicketCount: 2}
+	second := &domain.WaitlistEntry{ID: "entry-2", EventID: "event-1", UserID: "user-3", TicketCount: 2}
+	waitlist.On("Next", ctx, "event-1").Return(first, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(second, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-1", "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	eventClient.On("ReserveTickets", ctx, "entry-2", "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(nil, client.ErrInsufficientSeats)
+	waitlist.On("Fulfill", ctx, "entry-1", mock.AnythingOfType("*domain.Booking")).Run(func(args mock.Arguments) {
+		args.Get(2).(*domain.Booking).ID = "booking-2"
+	}).Return(domain.WaitlistStatusOffered, nil)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	waitlist.AssertNumberOfCalls(t, "Fulfill", 1)
+	waitlist.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCancelBooking_WaitlistSkipsEntriesThatCannotBeOffered(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(&domain.Booking{
+		ID:            "booking-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   2,
+		Status:        domain.BookingStatusConfirmed,
+	}, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
+
+	deleted := &domain.WaitlistEntry{ID: "entry-1", EventID: "event-1", UserID: "user-2", TicketCount: 2, TicketTypeID: "tt-gone"}
+	ended := &domain.WaitlistEntry{ID: "entry-2", EventID: "event-1", UserID: "user-3", TicketCount: 2, TicketTypeID: "tt-ended"}
+	next := &domain.WaitlistEntry{ID: "entry-3", EventID: "event-1", UserID: "user-4", TicketCount: 2, TicketTypeID: "tt-1"}
+	waitlist.On("Next", ctx, "event-1").Return(deleted, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(ended, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(next, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(nil, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-1", "event-1", int32(2), []string(nil), mock.Anything).Return(nil, client.ErrInvalidReservation)
+	eventClient.On("ReserveTickets", ctx, "entry-2", "event-1", int32(2), []string(nil), mock.Anything).Return(nil, client.ErrTicketTypeNotOnSale)
+	eventClient.On("ReserveTickets", ctx, "entry-3", "event-1", int32(2), []string(nil), mock.Anything).Return(&eventpb.Reservation{}, nil)
+	waitlist.On("Expire", ctx, "entry-1").Return(true, nil)
+	waitlist.On("Expire", ctx, "entry-2").Return(true, nil)
+	waitlist.On("Fulfill", ctx, "entry-3", mock.AnythingOfType("*domain.Booking")).Run(func(args mock.Arguments) {
+		args.Get(2).(*domain.Booking).ID = "booking-2"
+	}).Return(domain.WaitlistStatusOffered, nil)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	waitlist.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCancelBooking_WaitlistStopsOnTransientError(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(&domain.Booking{
+		ID:            "booking-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   2,
+		Status:        domain.BookingStatusConfirmed,
+	}, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
+
+	entry := &domain.WaitlistEntry{ID: "entry-1", EventID: "event-1", UserID: "user-2", TicketCount: 2}
+	waitlist.On("Next", ctx, "event-1").Return(entry, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-1", "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(nil, client.ErrEventService)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	waitlist.AssertNotCalled(t, "Expire", mock.Anything, mock.Anything)
+	waitlist.AssertNumberOfCalls(t, "Next", 1)
+}
+
+func TestCancelBooking_WaitlistEntryLeftReleasesSeats(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(&domain.Booking{
+		ID:            "booking-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   2,
+		Status:        domain.BookingStatusPending,
+	}, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
+
+	entry := &domain.WaitlistEntry{ID: "entry-1", EventID: "event-1", UserID: "user-2", TicketCount: 2}
+	waitlist.On("Next", ctx, "event-1").Return(entry, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(nil, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-1", "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	waitlist.On("Fulfill", ctx, "entry-1", mock.AnythingOfType("*domain.Booking")).Return(domain.WaitlistStatusLeft, nil)
+	eventClient.On("ReleaseTickets", ctx, "entry-1").Return(nil)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	waitlist.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestCancelBooking_WaitlistEntryExpiredReleasesSeats(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	repo.On("GetByID", ctx, "booking-1").Return(&domain.Booking{
+		ID:            "booking-1",
+		EventID:       "event-1",
+		ReservationID: "res-1",
+		TicketCount:   2,
+		Status:        domain.BookingStatusPending,
+	}, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	repo.On("CancelLive", ctx, "booking-1", testNow).Return(true, nil)
+
+	// The entry was expired by a concurrent promoter after Next returned it.
+	entry := &domain.WaitlistEntry{ID: "entry-1", EventID: "event-1", UserID: "user-2", TicketCount: 2}
+	waitlist.On("Next", ctx, "event-1").Return(entry, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(nil, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-1", "event-1", int32(2), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	waitlist.On("Fulfill", ctx, "entry-1", mock.AnythingOfType("*domain.Booking")).Return(domain.WaitlistStatusExpired, nil)
+	eventClient.On("ReleaseTickets", ctx, "entry-1").Return(nil)
+
+	err := uc.CancelBooking(ctx, "booking-1")
+
+	assert.NoError(t, err)
+	waitlist.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
+
+func TestExpireHolds_OffersSeatsToWaitlist(t *testing.T) {
+	uc, repo, eventClient, waitlist := newTestUsecaseWithWaitlist()
+	ctx := context.Background()
+
+	expired := []*domain.Booking{
+		{ID: "b-1", EventID: "event-1", ReservationID: "res-1", TicketCount: 2},
+		{ID: "b-2", EventID: "event-1", ReservationID: "res-2", TicketCount: 1},
+	}
+	repo.On("ExpirePending", ctx, testNow, 50).Return(expired, nil)
+	eventClient.On("ReleaseTickets", ctx, "res-1").Return(nil)
+	eventClient.On("ReleaseTickets", ctx, "res-2").Return(nil)
+
+	entry := &domain.WaitlistEntry{ID: "entry-1", EventID: "event-1", UserID: "user-2", TicketCount: 3}
+	waitlist.On("Next", ctx, "event-1").Return(entry, nil).Once()
+	waitlist.On("Next", ctx, "event-1").Return(nil, nil).Once()
+	eventClient.On("ReserveTickets", ctx, "entry-1", "event-1", int32(3), []string(nil), []*eventpb.ReservationItem(nil)).Return(&eventpb.Reservation{}, nil)
+	waitlist.On("Fulfill", ctx, "entry-1", mock.AnythingOfType("*domain.Booking")).Run(func(args mock.Arguments) {
+		args.Get(2).(*domain.Booking).ID = "booking-3"
+	}).Return(domain.WaitlistStatusOffered, nil)
+
+	count, err := uc.ExpireHolds(ctx, 50)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	waitlist.AssertExpectations(t)
+	eventClient.AssertExpectations(t)
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+type HoldExpirer interface {
+	ExpireHolds(ctx context.Context, limit int) (int, error)
+}
+
+// HoldReaper periodically expires pending bookings whose hold has lapsed.
+// Claiming is done with SKIP LOCKED, so every replica can run one.
+type HoldReaper struct {
+	svc       HoldExpirer
+	interval  time.Duration
+	batchSize int
+}
+
+func NewHoldReaper(svc HoldExpirer, interval time.Duration, batchSize int) *HoldReaper {
+	return &HoldReaper{
+		svc:       svc,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (r *HoldReaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	logger.Info("Hold reaper started", zap.Duration("interval", r.interval))
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Hold reaper stopped")
+			return
+		case <-ticker.C:
+			r.reap(ctx)
+		}
+	}
+}
+
+func (r *HoldReaper) reap(ctx context.Context) {
+	for {
+		expired, err := r.svc.ExpireHolds(ctx, r.batchSize)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Error("Hold reaper: expiring holds failed", zap.Error(err))
+			}
+			return
+		}
+		if expired > 0 {
+			logger.Info("Hold reaper: expired holds", zap.Int("count", expired))
+		}
+		// A full batch means there is probably a backlog; keep draining.
+		if expired < r.batchSize {
+			return
+		}
+	}
+}
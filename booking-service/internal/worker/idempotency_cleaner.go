@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+type ExpiredKeyDeleter interface {
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+// IdempotencyCleaner periodically deletes idempotency keys past their window.
+type IdempotencyCleaner struct {
+	svc      ExpiredKeyDeleter
+	interval time.Duration
+}
+
+func NewIdempotencyCleaner(svc ExpiredKeyDeleter, interval time.Duration) *IdempotencyCleaner {
+	return &IdempotencyCleaner{
+		svc:      svc,
+		interval: interval,
+	}
+}
+
+func (c *IdempotencyCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := c.svc.DeleteExpired(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error("Idempotency cleaner: deleting expired keys failed", zap.Error(err))
+				}
+				continue
+			}
+			if deleted > 0 {
+				logger.Debug("Idempotency cleaner: deleted expired keys", zap.Int64("count", deleted))
+			}
+		}
+	}
+}
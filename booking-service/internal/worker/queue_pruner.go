@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/booking-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+type QueueTicketPruner interface {
+	PruneQueues(ctx context.Context) (int64, error)
+}
+
+// QueuePruner periodically deletes waiting room tickets whose passes have
+// lapsed.
+type QueuePruner struct {
+	svc      QueueTicketPruner
+	interval time.Duration
+}
+
+func NewQueuePruner(svc QueueTicketPruner, interval time.Duration) *QueuePruner {
+	return &QueuePruner{
+		svc:      svc,
+		interval: interval,
+	}
+}
+
+func (p *QueuePruner) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := p.svc.PruneQueues(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error("Queue pruner: deleting lapsed tickets failed", zap.Error(err))
+				}
+				continue
+			}
+			if deleted > 0 {
+				logger.Debug("Queue pruner: deleted lapsed tickets", zap.Int64("count", deleted))
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS reservation_id VARCHAR(64);
+-- Bookings made before reservation-scoped seats have no reservation in
+-- event-service; they are released as legacy holds (see legacy_hold).
+UPDATE bookings SET reservation_id = id WHERE reservation_id IS NULL;
+ALTER TABLE bookings ALTER COLUMN reservation_id SET NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookings_reservation_id ON bookings(reservation_id);
+
+ALTER TABLE booking_compensations ADD COLUMN IF NOT EXISTS reservation_id VARCHAR(64);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE booking_compensations DROP COLUMN IF EXISTS reservation_id;
+DROP INDEX IF EXISTS idx_bookings_reservation_id;
+ALTER TABLE bookings DROP COLUMN IF EXISTS reservation_id;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Prices are copied from the reservation when the booking is made and never
+-- recomputed, so repricing a ticket type does not change existing bookings.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS items JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS total_price_minor BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP COLUMN IF EXISTS currency;
+ALTER TABLE bookings DROP COLUMN IF EXISTS total_price_minor;
+ALTER TABLE bookings DROP COLUMN IF EXISTS items;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id VARCHAR(36) PRIMARY KEY,
+    -- seq orders the queue; created_at alone can tie.
+    seq BIGSERIAL NOT NULL,
+    event_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    ticket_count INTEGER NOT NULL,
+    ticket_type_id VARCHAR(36) NOT NULL DEFAULT '',
+    status INTEGER NOT NULL,
+    booking_id VARCHAR(36),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- A user waits at most once per event.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_waitlist_entries_waiting ON waitlist_entries(event_id, user_id) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_queue ON waitlist_entries(event_id, seq) WHERE status = 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS waitlist_entries;
+-- +goose StatementEnd
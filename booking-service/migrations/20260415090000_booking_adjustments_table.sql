@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS booking_adjustments (
+    id VARCHAR(36) PRIMARY KEY,
+    booking_id VARCHAR(36) NOT NULL REFERENCES bookings(id),
+    previous_count INTEGER NOT NULL,
+    new_count INTEGER NOT NULL,
+    previous_total_minor BIGINT NOT NULL,
+    new_total_minor BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_booking_adjustments_booking_id ON booking_adjustments(booking_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS booking_adjustments;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- next_admit_at is the admission time the event's next queue ticket gets.
+CREATE TABLE IF NOT EXISTS event_queues (
+    event_id VARCHAR(36) PRIMARY KEY,
+    next_admit_at TIMESTAMP NOT NULL
+);
+CREATE TABLE IF NOT EXISTS queue_tickets (
+    id VARCHAR(36) PRIMARY KEY,
+    event_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    admit_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- A user holds at most one ticket per event.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_queue_tickets_user ON queue_tickets(event_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_queue_tickets_admit_at ON queue_tickets(event_id, admit_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS queue_tickets;
+DROP TABLE IF EXISTS event_queues;
+-- +goose StatementEnd
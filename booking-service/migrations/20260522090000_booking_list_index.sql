@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyset pagination of a user's bookings by (created_at, id).
+CREATE INDEX IF NOT EXISTS idx_bookings_user_created_at_id ON bookings (user_id, created_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_bookings_user_created_at_id;
+-- +goose StatementEnd
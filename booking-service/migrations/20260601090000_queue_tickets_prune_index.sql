@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Lets the pruner find lapsed tickets across all events.
+CREATE INDEX IF NOT EXISTS idx_queue_tickets_lapsed ON queue_tickets(admit_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_queue_tickets_lapsed;
+-- +goose StatementEnd
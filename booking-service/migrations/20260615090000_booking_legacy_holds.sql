@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Bookings backfilled with their own ID as reservation ID took their seats by
+-- count, before reservations existed. Releasing them gives that count back.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS legacy_hold BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE bookings SET legacy_hold = TRUE WHERE reservation_id = id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bookings DROP COLUMN IF EXISTS legacy_hold;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keys are scoped to the caller. Records from before have no subject, so a
+-- retry no longer finds them; they lapse with their idempotency window.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS subject VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (subject, key, operation);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Different callers may share a key by now; the records are short-lived, so
+-- they are dropped rather than merged.
+DELETE FROM idempotency_keys;
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (key, operation);
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS subject;
+-- +goose StatementEnd
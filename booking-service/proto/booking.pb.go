@@ -30,6 +30,7 @@ const (
 	BookingStatus_BOOKING_STATUS_PENDING     BookingStatus = 1
 	BookingStatus_BOOKING_STATUS_CONFIRMED   BookingStatus = 2
 	BookingStatus_BOOKING_STATUS_CANCELLED   BookingStatus = 3
+	BookingStatus_BOOKING_STATUS_EXPIRED     BookingStatus = 4
 )
 
 // Enum value maps for BookingStatus.
@@ -39,12 +40,14 @@ var (
 		1: "BOOKING_STATUS_PENDING",
 		2: "BOOKING_STATUS_CONFIRMED",
 		3: "BOOKING_STATUS_CANCELLED",
+		4: "BOOKING_STATUS_EXPIRED",
 	}
 	BookingStatus_value = map[string]int32{
 		"BOOKING_STATUS_UNSPECIFIED": 0,
 		"BOOKING_STATUS_PENDING":     1,
 		"BOOKING_STATUS_CONFIRMED":   2,
 		"BOOKING_STATUS_CANCELLED":   3,
+		"BOOKING_STATUS_EXPIRED":     4,
 	}
 )
 
@@ -75,17 +78,80 @@ func (BookingStatus) EnumDescriptor() ([]byte, []int) {
 	return file_booking_proto_rawDescGZIP(), []int{0}
 }
 
-// Ana booking modeli
+type WaitlistStatus int32
+
+const (
+	WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED WaitlistStatus = 0
+	WaitlistStatus_WAITLIST_STATUS_WAITING     WaitlistStatus = 1
+	// A pending booking was created for the entry; see booking_id.
+	WaitlistStatus_WAITLIST_STATUS_OFFERED WaitlistStatus = 2
+	WaitlistStatus_WAITLIST_STATUS_LEFT    WaitlistStatus = 3
+	// The entry could never be offered, e.g. because its ticket type is no
+	// longer sold, and was taken off the waitlist.
+	WaitlistStatus_WAITLIST_STATUS_EXPIRED WaitlistStatus = 4
+)
+
+// Enum value maps for WaitlistStatus.
+var (
+	WaitlistStatus_name = map[int32]string{
+		0: "WAITLIST_STATUS_UNSPECIFIED",
+		1: "WAITLIST_STATUS_WAITING",
+		2: "WAITLIST_STATUS_OFFERED",
+		3: "WAITLIST_STATUS_LEFT",
+		4: "WAITLIST_STATUS_EXPIRED",
+	}
+	WaitlistStatus_value = map[string]int32{
+		"WAITLIST_STATUS_UNSPECIFIED": 0,
+		"WAITLIST_STATUS_WAITING":     1,
+		"WAITLIST_STATUS_OFFERED":     2,
+		"WAITLIST_STATUS_LEFT":        3,
+		"WAITLIST_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x WaitlistStatus) Enum() *WaitlistStatus {
+	p := new(WaitlistStatus)
+	*p = x
+	return p
+}
+
+func (x WaitlistStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitlistStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_booking_proto_enumTypes[1].Descriptor()
+}
+
+func (WaitlistStatus) Type() protoreflect.EnumType {
+	return &file_booking_proto_enumTypes[1]
+}
+
+func (x WaitlistStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitlistStatus.Descriptor instead.
+func (WaitlistStatus) EnumDescriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{1}
+}
+
 type Booking struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	TicketCount   int32                  `protobuf:"varint,4,opt,name=ticket_count,json=ticketCount,proto3" json:"ticket_count,omitempty"`
-	Status        BookingStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=booking.BookingStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId     string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TicketCount int32                  `protobuf:"varint,4,opt,name=ticket_count,json=ticketCount,proto3" json:"ticket_count,omitempty"`
+	Status      BookingStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=booking.BookingStatus" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SeatIds     []string               `protobuf:"bytes,8,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Items       []*BookingItem         `protobuf:"bytes,9,rep,name=items,proto3" json:"items,omitempty"`
+	// Sum of the item totals, in minor units of currency.
+	TotalPriceMinor int64  `protobuf:"varint,10,opt,name=total_price_minor,json=totalPriceMinor,proto3" json:"total_price_minor,omitempty"`
+	Currency        string `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Booking) Reset() {
@@ -160,19 +226,140 @@ func (x *Booking) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-// Request/Response mesajları
+func (x *Booking) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Booking) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *Booking) GetItems() []*BookingItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Booking) GetTotalPriceMinor() int64 {
+	if x != nil {
+		return x.TotalPriceMinor
+	}
+	return 0
+}
+
+func (x *Booking) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// BookingItem records the price of a ticket type as it was when the booking
+// was made.
+type BookingItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypeId    string                 `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPriceMinor  int64                  `protobuf:"varint,3,opt,name=unit_price_minor,json=unitPriceMinor,proto3" json:"unit_price_minor,omitempty"`
+	TotalPriceMinor int64                  `protobuf:"varint,4,opt,name=total_price_minor,json=totalPriceMinor,proto3" json:"total_price_minor,omitempty"`
+	Currency        string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BookingItem) Reset() {
+	*x = BookingItem{}
+	mi := &file_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingItem) ProtoMessage() {}
+
+func (x *BookingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingItem.ProtoReflect.Descriptor instead.
+func (*BookingItem) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BookingItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *BookingItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BookingItem) GetUnitPriceMinor() int64 {
+	if x != nil {
+		return x.UnitPriceMinor
+	}
+	return 0
+}
+
+func (x *BookingItem) GetTotalPriceMinor() int64 {
+	if x != nil {
+		return x.TotalPriceMinor
+	}
+	return 0
+}
+
+func (x *BookingItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	TicketCount   int32                  `protobuf:"varint,3,opt,name=ticket_count,json=ticketCount,proto3" json:"ticket_count,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserId  string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventId string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// May be left zero when seat_ids or items is set.
+	TicketCount int32 `protobuf:"varint,3,opt,name=ticket_count,json=ticketCount,proto3" json:"ticket_count,omitempty"`
+	// Optional; may also be sent as the Idempotency-Key header.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Specific seats to book; required for assigned-seating events.
+	SeatIds []string `protobuf:"bytes,5,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Tickets per ticket type; required for events with ticket types.
+	Items []*LineItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	// Pass from the event's waiting room; required while it is on.
+	QueuePass     string `protobuf:"bytes,7,opt,name=queue_pass,json=queuePass,proto3" json:"queue_pass,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateBookingRequest) Reset() {
 	*x = CreateBookingRequest{}
-	mi := &file_booking_proto_msgTypes[1]
+	mi := &file_booking_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +371,7 @@ func (x *CreateBookingRequest) String() string {
 func (*CreateBookingRequest) ProtoMessage() {}
 
 func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[1]
+	mi := &file_booking_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +384,7 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
 func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{1}
+	return file_booking_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateBookingRequest) GetUserId() string {
@@ -221,6 +408,86 @@ func (x *CreateBookingRequest) GetTicketCount() int32 {
 	return 0
 }
 
+func (x *CreateBookingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetItems() []*LineItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetQueuePass() string {
+	if x != nil {
+		return x.QueuePass
+	}
+	return ""
+}
+
+type LineItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypeId  string                 `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LineItem) Reset() {
+	*x = LineItem{}
+	mi := &file_booking_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LineItem) ProtoMessage() {}
+
+func (x *LineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LineItem.ProtoReflect.Descriptor instead.
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LineItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *LineItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type CreateBookingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
@@ -230,7 +497,7 @@ type CreateBookingResponse struct {
 
 func (x *CreateBookingResponse) Reset() {
 	*x = CreateBookingResponse{}
-	mi := &file_booking_proto_msgTypes[2]
+	mi := &file_booking_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -242,7 +509,7 @@ func (x *CreateBookingResponse) String() string {
 func (*CreateBookingResponse) ProtoMessage() {}
 
 func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[2]
+	mi := &file_booking_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,7 +522,7 @@ func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
 func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{2}
+	return file_booking_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateBookingResponse) GetBooking() *Booking {
@@ -274,7 +541,7 @@ type GetBookingRequest struct {
 
 func (x *GetBookingRequest) Reset() {
 	*x = GetBookingRequest{}
-	mi := &file_booking_proto_msgTypes[3]
+	mi := &file_booking_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +553,7 @@ func (x *GetBookingRequest) String() string {
 func (*GetBookingRequest) ProtoMessage() {}
 
 func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[3]
+	mi := &file_booking_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +566,7 @@ func (x *GetBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBookingRequest.ProtoReflect.Descriptor instead.
 func (*GetBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{3}
+	return file_booking_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetBookingRequest) GetBookingId() string {
@@ -318,7 +585,7 @@ type GetBookingResponse struct {
 
 func (x *GetBookingResponse) Reset() {
 	*x = GetBookingResponse{}
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -330,7 +597,7 @@ func (x *GetBookingResponse) String() string {
 func (*GetBookingResponse) ProtoMessage() {}
 
 func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +610,7 @@ func (x *GetBookingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBookingResponse.ProtoReflect.Descriptor instead.
 func (*GetBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{4}
+	return file_booking_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetBookingResponse) GetBooking() *Booking {
@@ -353,16 +620,30 @@ func (x *GetBookingResponse) GetBooking() *Booking {
 	return nil
 }
 
+// Lists a user's bookings a page at a time. Pages follow each other by page
+// token, so bookings made meanwhile do not shift them.
 type ListUserBookingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Page size: 50 when unset, at most 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page. The other fields must be the same
+	// as for that page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only bookings made at or after created_from and before created_before.
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Status        BookingStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=booking.BookingStatus" json:"status,omitempty"`
+	EventId       string                 `protobuf:"bytes,7,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// "created_at desc" (the default, newest first) or "created_at".
+	OrderBy       string `protobuf:"bytes,8,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserBookingsRequest) Reset() {
 	*x = ListUserBookingsRequest{}
-	mi := &file_booking_proto_msgTypes[5]
+	mi := &file_booking_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -374,7 +655,7 @@ func (x *ListUserBookingsRequest) String() string {
 func (*ListUserBookingsRequest) ProtoMessage() {}
 
 func (x *ListUserBookingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[5]
+	mi := &file_booking_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +668,7 @@ func (x *ListUserBookingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserBookingsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserBookingsRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{5}
+	return file_booking_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListUserBookingsRequest) GetUserId() string {
@@ -397,16 +678,67 @@ func (x *ListUserBookingsRequest) GetUserId() string {
 	return ""
 }
 
+func (x *ListUserBookingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserBookingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListUserBookingsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListUserBookingsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *ListUserBookingsRequest) GetStatus() BookingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BookingStatus_BOOKING_STATUS_UNSPECIFIED
+}
+
+func (x *ListUserBookingsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ListUserBookingsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
 type ListUserBookingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bookings      []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Bookings []*Booking             `protobuf:"bytes,1,rep,name=bookings,proto3" json:"bookings,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListUserBookingsResponse) Reset() {
 	*x = ListUserBookingsResponse{}
-	mi := &file_booking_proto_msgTypes[6]
+	mi := &file_booking_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -418,7 +750,7 @@ func (x *ListUserBookingsResponse) String() string {
 func (*ListUserBookingsResponse) ProtoMessage() {}
 
 func (x *ListUserBookingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[6]
+	mi := &file_booking_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -431,7 +763,7 @@ func (x *ListUserBookingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserBookingsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserBookingsResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{6}
+	return file_booking_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListUserBookingsResponse) GetBookings() []*Booking {
@@ -441,16 +773,25 @@ func (x *ListUserBookingsResponse) GetBookings() []*Booking {
 	return nil
 }
 
+func (x *ListUserBookingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type CancelBookingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	BookingId string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	// Optional; may also be sent as the Idempotency-Key header.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CancelBookingRequest) Reset() {
 	*x = CancelBookingRequest{}
-	mi := &file_booking_proto_msgTypes[7]
+	mi := &file_booking_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -462,7 +803,7 @@ func (x *CancelBookingRequest) String() string {
 func (*CancelBookingRequest) ProtoMessage() {}
 
 func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[7]
+	mi := &file_booking_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -475,7 +816,7 @@ func (x *CancelBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBookingRequest.ProtoReflect.Descriptor instead.
 func (*CancelBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{7}
+	return file_booking_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CancelBookingRequest) GetBookingId() string {
@@ -485,29 +826,922 @@ func (x *CancelBookingRequest) GetBookingId() string {
 	return ""
 }
 
-type CancelBookingResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *CancelBookingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type CancelBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBookingResponse) Reset() {
-	*x = CancelBookingResponse{}
-	mi := &file_booking_proto_msgTypes[8]
+func (x *CancelBookingResponse) Reset() {
+	*x = CancelBookingResponse{}
+	mi := &file_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBookingResponse) ProtoMessage() {}
+
+func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
+func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelBookingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelBookingResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ConfirmBookingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingRequest) Reset() {
+	*x = ConfirmBookingRequest{}
+	mi := &file_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingRequest) ProtoMessage() {}
+
+func (x *ConfirmBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfirmBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type ConfirmBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmBookingResponse) Reset() {
+	*x = ConfirmBookingResponse{}
+	mi := &file_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmBookingResponse) ProtoMessage() {}
+
+func (x *ConfirmBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmBookingResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+// Changes the ticket count of a general admission booking. Increases fail
+// without changes if seats are short.
+type ModifyBookingRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	BookingId   string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	TicketCount int32                  `protobuf:"varint,2,opt,name=ticket_count,json=ticketCount,proto3" json:"ticket_count,omitempty"`
+	// Pass from the event's waiting room; required to add tickets while it is
+	// on.
+	QueuePass     string `protobuf:"bytes,3,opt,name=queue_pass,json=queuePass,proto3" json:"queue_pass,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyBookingRequest) Reset() {
+	*x = ModifyBookingRequest{}
+	mi := &file_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBookingRequest) ProtoMessage() {}
+
+func (x *ModifyBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBookingRequest.ProtoReflect.Descriptor instead.
+func (*ModifyBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ModifyBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ModifyBookingRequest) GetTicketCount() int32 {
+	if x != nil {
+		return x.TicketCount
+	}
+	return 0
+}
+
+func (x *ModifyBookingRequest) GetQueuePass() string {
+	if x != nil {
+		return x.QueuePass
+	}
+	return ""
+}
+
+type ModifyBookingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Booking       *Booking               `protobuf:"bytes,1,opt,name=booking,proto3" json:"booking,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyBookingResponse) Reset() {
+	*x = ModifyBookingResponse{}
+	mi := &file_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyBookingResponse) ProtoMessage() {}
+
+func (x *ModifyBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyBookingResponse.ProtoReflect.Descriptor instead.
+func (*ModifyBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ModifyBookingResponse) GetBooking() *Booking {
+	if x != nil {
+		return x.Booking
+	}
+	return nil
+}
+
+type BookingAdjustment struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookingId          string                 `protobuf:"bytes,2,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PreviousCount      int32                  `protobuf:"varint,3,opt,name=previous_count,json=previousCount,proto3" json:"previous_count,omitempty"`
+	NewCount           int32                  `protobuf:"varint,4,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
+	PreviousTotalMinor int64                  `protobuf:"varint,5,opt,name=previous_total_minor,json=previousTotalMinor,proto3" json:"previous_total_minor,omitempty"`
+	NewTotalMinor      int64                  `protobuf:"varint,6,opt,name=new_total_minor,json=newTotalMinor,proto3" json:"new_total_minor,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *BookingAdjustment) Reset() {
+	*x = BookingAdjustment{}
+	mi := &file_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingAdjustment) ProtoMessage() {}
+
+func (x *BookingAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingAdjustment.ProtoReflect.Descriptor instead.
+func (*BookingAdjustment) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BookingAdjustment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BookingAdjustment) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *BookingAdjustment) GetPreviousCount() int32 {
+	if x != nil {
+		return x.PreviousCount
+	}
+	return 0
+}
+
+func (x *BookingAdjustment) GetNewCount() int32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *BookingAdjustment) GetPreviousTotalMinor() int64 {
+	if x != nil {
+		return x.PreviousTotalMinor
+	}
+	return 0
+}
+
+func (x *BookingAdjustment) GetNewTotalMinor() int64 {
+	if x != nil {
+		return x.NewTotalMinor
+	}
+	return 0
+}
+
+func (x *BookingAdjustment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListBookingAdjustmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingAdjustmentsRequest) Reset() {
+	*x = ListBookingAdjustmentsRequest{}
+	mi := &file_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingAdjustmentsRequest) ProtoMessage() {}
+
+func (x *ListBookingAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListBookingAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListBookingAdjustmentsRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type ListBookingAdjustmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adjustments   []*BookingAdjustment   `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBookingAdjustmentsResponse) Reset() {
+	*x = ListBookingAdjustmentsResponse{}
+	mi := &file_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBookingAdjustmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBookingAdjustmentsResponse) ProtoMessage() {}
+
+func (x *ListBookingAdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBookingAdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListBookingAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListBookingAdjustmentsResponse) GetAdjustments() []*BookingAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type CancelEventBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventBookingsRequest) Reset() {
+	*x = CancelEventBookingsRequest{}
+	mi := &file_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventBookingsRequest) ProtoMessage() {}
+
+func (x *CancelEventBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventBookingsRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CancelEventBookingsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type CancelEventBookingsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CancelledCount int32                  `protobuf:"varint,1,opt,name=cancelled_count,json=cancelledCount,proto3" json:"cancelled_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelEventBookingsResponse) Reset() {
+	*x = CancelEventBookingsResponse{}
+	mi := &file_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventBookingsResponse) ProtoMessage() {}
+
+func (x *CancelEventBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventBookingsResponse.ProtoReflect.Descriptor instead.
+func (*CancelEventBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelEventBookingsResponse) GetCancelledCount() int32 {
+	if x != nil {
+		return x.CancelledCount
+	}
+	return 0
+}
+
+type WaitlistEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketCount   int32                  `protobuf:"varint,4,opt,name=ticket_count,json=ticketCount,proto3" json:"ticket_count,omitempty"`
+	TicketTypeId  string                 `protobuf:"bytes,5,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Status        WaitlistStatus         `protobuf:"varint,6,opt,name=status,proto3,enum=booking.WaitlistStatus" json:"status,omitempty"`
+	BookingId     string                 `protobuf:"bytes,7,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetTicketCount() int32 {
+	if x != nil {
+		return x.TicketCount
+	}
+	return 0
+}
+
+func (x *WaitlistEntry) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStatus() WaitlistStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WaitlistStatus_WAITLIST_STATUS_UNSPECIFIED
+}
+
+func (x *WaitlistEntry) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type JoinWaitlistRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EventId     string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TicketCount int32                  `protobuf:"varint,3,opt,name=ticket_count,json=ticketCount,proto3" json:"ticket_count,omitempty"`
+	// Optional; required for events with ticket types.
+	TicketTypeId  string `protobuf:"bytes,4,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistRequest) Reset() {
+	*x = JoinWaitlistRequest{}
+	mi := &file_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistRequest) ProtoMessage() {}
+
+func (x *JoinWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *JoinWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JoinWaitlistRequest) GetTicketCount() int32 {
+	if x != nil {
+		return x.TicketCount
+	}
+	return 0
+}
+
+func (x *JoinWaitlistRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+type JoinWaitlistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Entry *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// 1-based place in the queue; 0 once the entry has been offered.
+	Position      int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinWaitlistResponse) Reset() {
+	*x = JoinWaitlistResponse{}
+	mi := &file_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWaitlistResponse) ProtoMessage() {}
+
+func (x *JoinWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*JoinWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *JoinWaitlistResponse) GetEntry() *WaitlistEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *JoinWaitlistResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type LeaveWaitlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistRequest) Reset() {
+	*x = LeaveWaitlistRequest{}
+	mi := &file_booking_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistRequest) ProtoMessage() {}
+
+func (x *LeaveWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LeaveWaitlistRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *LeaveWaitlistRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveWaitlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveWaitlistResponse) Reset() {
+	*x = LeaveWaitlistResponse{}
+	mi := &file_booking_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveWaitlistResponse) ProtoMessage() {}
+
+func (x *LeaveWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*LeaveWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LeaveWaitlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetWaitlistPositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistPositionRequest) Reset() {
+	*x = GetWaitlistPositionRequest{}
+	mi := &file_booking_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWaitlistPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitlistPositionRequest) ProtoMessage() {}
+
+func (x *GetWaitlistPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitlistPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetWaitlistPositionRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetWaitlistPositionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetWaitlistPositionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *WaitlistEntry         `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWaitlistPositionResponse) Reset() {
+	*x = GetWaitlistPositionResponse{}
+	mi := &file_booking_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBookingResponse) String() string {
+func (x *GetWaitlistPositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBookingResponse) ProtoMessage() {}
+func (*GetWaitlistPositionResponse) ProtoMessage() {}
 
-func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[8]
+func (x *GetWaitlistPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,30 +1752,344 @@ func (x *CancelBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBookingResponse.ProtoReflect.Descriptor instead.
-func (*CancelBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetWaitlistPositionResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CancelBookingResponse) GetSuccess() bool {
+func (x *GetWaitlistPositionResponse) GetEntry() *WaitlistEntry {
 	if x != nil {
-		return x.Success
+		return x.Entry
 	}
-	return false
+	return nil
 }
 
-func (x *CancelBookingResponse) GetMessage() string {
+func (x *GetWaitlistPositionResponse) GetPosition() int32 {
 	if x != nil {
-		return x.Message
+		return x.Position
+	}
+	return 0
+}
+
+// QueueTicket is a user's place in an event's waiting room.
+type QueueTicket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the ticket when polling or watching.
+	Token   string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId  string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// When the ticket is admitted; the queue admits at the event's rate in
+	// join order.
+	AdmitAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=admit_at,json=admitAt,proto3" json:"admit_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueTicket) Reset() {
+	*x = QueueTicket{}
+	mi := &file_booking_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueTicket) ProtoMessage() {}
+
+func (x *QueueTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueTicket.ProtoReflect.Descriptor instead.
+func (*QueueTicket) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueueTicket) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *QueueTicket) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *QueueTicket) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QueueTicket) GetAdmitAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AdmitAt
+	}
+	return nil
+}
+
+func (x *QueueTicket) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type JoinQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinQueueRequest) Reset() {
+	*x = JoinQueueRequest{}
+	mi := &file_booking_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueRequest) ProtoMessage() {}
+
+func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
+func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JoinQueueRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *JoinQueueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type JoinQueueResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Ticket *QueueTicket           `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	// 1-based place among the tickets still waiting; 0 once admitted.
+	Position int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// Set once admitted: the pass CreateBooking takes, and when it expires.
+	Pass          string                 `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"`
+	PassExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=pass_expires_at,json=passExpiresAt,proto3" json:"pass_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinQueueResponse) Reset() {
+	*x = JoinQueueResponse{}
+	mi := &file_booking_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueResponse) ProtoMessage() {}
+
+func (x *JoinQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueResponse.ProtoReflect.Descriptor instead.
+func (*JoinQueueResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *JoinQueueResponse) GetTicket() *QueueTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *JoinQueueResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *JoinQueueResponse) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+func (x *JoinQueueResponse) GetPassExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PassExpiresAt
+	}
+	return nil
+}
+
+type GetQueuePositionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueuePositionRequest) Reset() {
+	*x = GetQueuePositionRequest{}
+	mi := &file_booking_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuePositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuePositionRequest) ProtoMessage() {}
+
+func (x *GetQueuePositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuePositionRequest.ProtoReflect.Descriptor instead.
+func (*GetQueuePositionRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetQueuePositionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetQueuePositionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *QueueTicket           `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Position      int32                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Pass          string                 `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"`
+	PassExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=pass_expires_at,json=passExpiresAt,proto3" json:"pass_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQueuePositionResponse) Reset() {
+	*x = GetQueuePositionResponse{}
+	mi := &file_booking_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueuePositionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueuePositionResponse) ProtoMessage() {}
+
+func (x *GetQueuePositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQueuePositionResponse.ProtoReflect.Descriptor instead.
+func (*GetQueuePositionResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetQueuePositionResponse) GetTicket() *QueueTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *GetQueuePositionResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GetQueuePositionResponse) GetPass() string {
+	if x != nil {
+		return x.Pass
 	}
 	return ""
 }
 
+func (x *GetQueuePositionResponse) GetPassExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PassExpiresAt
+	}
+	return nil
+}
+
 var File_booking_proto protoreflect.FileDescriptor
 
 const file_booking_proto_rawDesc = "" +
 	"\n" +
-	"\rbooking.proto\x12\abooking\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x01\n" +
+	"\rbooking.proto\x12\abooking\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x03\n" +
 	"\aBooking\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
@@ -549,39 +2097,171 @@ const file_booking_proto_rawDesc = "" +
 	"\fticket_count\x18\x04 \x01(\x05R\vticketCount\x12.\n" +
 	"\x06status\x18\x05 \x01(\x0e2\x16.booking.BookingStatusR\x06status\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"m\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x19\n" +
+	"\bseat_ids\x18\b \x03(\tR\aseatIds\x12*\n" +
+	"\x05items\x18\t \x03(\v2\x14.booking.BookingItemR\x05items\x12*\n" +
+	"\x11total_price_minor\x18\n" +
+	" \x01(\x03R\x0ftotalPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\"\xc1\x01\n" +
+	"\vBookingItem\x12$\n" +
+	"\x0eticket_type_id\x18\x01 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12(\n" +
+	"\x10unit_price_minor\x18\x03 \x01(\x03R\x0eunitPriceMinor\x12*\n" +
+	"\x11total_price_minor\x18\x04 \x01(\x03R\x0ftotalPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xf9\x01\n" +
 	"\x14CreateBookingRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12!\n" +
-	"\fticket_count\x18\x03 \x01(\x05R\vticketCount\"C\n" +
+	"\fticket_count\x18\x03 \x01(\x05R\vticketCount\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x12\x19\n" +
+	"\bseat_ids\x18\x05 \x03(\tR\aseatIds\x12'\n" +
+	"\x05items\x18\x06 \x03(\v2\x11.booking.LineItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"queue_pass\x18\a \x01(\tR\tqueuePass\"L\n" +
+	"\bLineItem\x12$\n" +
+	"\x0eticket_type_id\x18\x01 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"C\n" +
 	"\x15CreateBookingResponse\x12*\n" +
 	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\"2\n" +
 	"\x11GetBookingRequest\x12\x1d\n" +
 	"\n" +
 	"booking_id\x18\x01 \x01(\tR\tbookingId\"@\n" +
 	"\x12GetBookingResponse\x12*\n" +
-	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\"2\n" +
+	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\"\xd6\x02\n" +
 	"\x17ListUserBookingsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"H\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12=\n" +
+	"\fcreated_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x12A\n" +
+	"\x0ecreated_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12.\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.booking.BookingStatusR\x06status\x12\x19\n" +
+	"\bevent_id\x18\a \x01(\tR\aeventId\x12\x19\n" +
+	"\border_by\x18\b \x01(\tR\aorderBy\"p\n" +
 	"\x18ListUserBookingsResponse\x12,\n" +
-	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\"5\n" +
+	"\bbookings\x18\x01 \x03(\v2\x10.booking.BookingR\bbookings\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"^\n" +
 	"\x14CancelBookingRequest\x12\x1d\n" +
 	"\n" +
-	"booking_id\x18\x01 \x01(\tR\tbookingId\"K\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12'\n" +
+	"\x0fidempotency_key\x18\x02 \x01(\tR\x0eidempotencyKey\"K\n" +
 	"\x15CancelBookingResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*\x87\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"6\n" +
+	"\x15ConfirmBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"D\n" +
+	"\x16ConfirmBookingResponse\x12*\n" +
+	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\"w\n" +
+	"\x14ModifyBookingRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12!\n" +
+	"\fticket_count\x18\x02 \x01(\x05R\vticketCount\x12\x1d\n" +
+	"\n" +
+	"queue_pass\x18\x03 \x01(\tR\tqueuePass\"C\n" +
+	"\x15ModifyBookingResponse\x12*\n" +
+	"\abooking\x18\x01 \x01(\v2\x10.booking.BookingR\abooking\"\x9b\x02\n" +
+	"\x11BookingAdjustment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x02 \x01(\tR\tbookingId\x12%\n" +
+	"\x0eprevious_count\x18\x03 \x01(\x05R\rpreviousCount\x12\x1b\n" +
+	"\tnew_count\x18\x04 \x01(\x05R\bnewCount\x120\n" +
+	"\x14previous_total_minor\x18\x05 \x01(\x03R\x12previousTotalMinor\x12&\n" +
+	"\x0fnew_total_minor\x18\x06 \x01(\x03R\rnewTotalMinor\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\x1dListBookingAdjustmentsRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"^\n" +
+	"\x1eListBookingAdjustmentsResponse\x12<\n" +
+	"\vadjustments\x18\x01 \x03(\v2\x1a.booking.BookingAdjustmentR\vadjustments\"7\n" +
+	"\x1aCancelEventBookingsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"F\n" +
+	"\x1bCancelEventBookingsResponse\x12'\n" +
+	"\x0fcancelled_count\x18\x01 \x01(\x05R\x0ecancelledCount\"\xa7\x02\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12!\n" +
+	"\fticket_count\x18\x04 \x01(\x05R\vticketCount\x12$\n" +
+	"\x0eticket_type_id\x18\x05 \x01(\tR\fticketTypeId\x12/\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x17.booking.WaitlistStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\a \x01(\tR\tbookingId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x92\x01\n" +
+	"\x13JoinWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fticket_count\x18\x03 \x01(\x05R\vticketCount\x12$\n" +
+	"\x0eticket_type_id\x18\x04 \x01(\tR\fticketTypeId\"`\n" +
+	"\x14JoinWaitlistResponse\x12,\n" +
+	"\x05entry\x18\x01 \x01(\v2\x16.booking.WaitlistEntryR\x05entry\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"J\n" +
+	"\x14LeaveWaitlistRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"1\n" +
+	"\x15LeaveWaitlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
+	"\x1aGetWaitlistPositionRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"g\n" +
+	"\x1bGetWaitlistPositionResponse\x12,\n" +
+	"\x05entry\x18\x01 \x01(\v2\x16.booking.WaitlistEntryR\x05entry\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"\xc9\x01\n" +
+	"\vQueueTicket\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x125\n" +
+	"\badmit_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aadmitAt\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"F\n" +
+	"\x10JoinQueueRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xb5\x01\n" +
+	"\x11JoinQueueResponse\x12,\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x14.booking.QueueTicketR\x06ticket\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04pass\x18\x03 \x01(\tR\x04pass\x12B\n" +
+	"\x0fpass_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rpassExpiresAt\"/\n" +
+	"\x17GetQueuePositionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xbc\x01\n" +
+	"\x18GetQueuePositionResponse\x12,\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x14.booking.QueueTicketR\x06ticket\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x12\n" +
+	"\x04pass\x18\x03 \x01(\tR\x04pass\x12B\n" +
+	"\x0fpass_expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rpassExpiresAt*\xa3\x01\n" +
 	"\rBookingStatus\x12\x1e\n" +
 	"\x1aBOOKING_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16BOOKING_STATUS_PENDING\x10\x01\x12\x1c\n" +
 	"\x18BOOKING_STATUS_CONFIRMED\x10\x02\x12\x1c\n" +
-	"\x18BOOKING_STATUS_CANCELLED\x10\x032\xd5\x03\n" +
+	"\x18BOOKING_STATUS_CANCELLED\x10\x03\x12\x1a\n" +
+	"\x16BOOKING_STATUS_EXPIRED\x10\x04*\xa2\x01\n" +
+	"\x0eWaitlistStatus\x12\x1f\n" +
+	"\x1bWAITLIST_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17WAITLIST_STATUS_WAITING\x10\x01\x12\x1b\n" +
+	"\x17WAITLIST_STATUS_OFFERED\x10\x02\x12\x18\n" +
+	"\x14WAITLIST_STATUS_LEFT\x10\x03\x12\x1b\n" +
+	"\x17WAITLIST_STATUS_EXPIRED\x10\x042\x96\r\n" +
 	"\x0eBookingService\x12g\n" +
 	"\rCreateBooking\x12\x1d.booking.CreateBookingRequest\x1a\x1e.booking.CreateBookingResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/bookings\x12h\n" +
 	"\n" +
 	"GetBooking\x12\x1a.booking.GetBookingRequest\x1a\x1b.booking.GetBookingResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/bookings/{booking_id}\x12}\n" +
 	"\x10ListUserBookings\x12 .booking.ListUserBookingsRequest\x1a!.booking.ListUserBookingsResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/users/{user_id}/bookings\x12q\n" +
-	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/bookings/{booking_id}B\tZ\a./protob\x06proto3"
+	"\rCancelBooking\x12\x1d.booking.CancelBookingRequest\x1a\x1e.booking.CancelBookingResponse\"!\x82\xd3\xe4\x93\x02\x1b*\x19/v1/bookings/{booking_id}\x12\x7f\n" +
+	"\x0eConfirmBooking\x12\x1e.booking.ConfirmBookingRequest\x1a\x1f.booking.ConfirmBookingResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/bookings/{booking_id}/confirm\x12t\n" +
+	"\rModifyBooking\x12\x1d.booking.ModifyBookingRequest\x1a\x1e.booking.ModifyBookingResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*2\x19/v1/bookings/{booking_id}\x12\x98\x01\n" +
+	"\x16ListBookingAdjustments\x12&.booking.ListBookingAdjustmentsRequest\x1a'.booking.ListBookingAdjustmentsResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/bookings/{booking_id}/adjustments\x12v\n" +
+	"\fJoinWaitlist\x12\x1c.booking.JoinWaitlistRequest\x1a\x1d.booking.JoinWaitlistResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/events/{event_id}/waitlist\x12\x80\x01\n" +
+	"\rLeaveWaitlist\x12\x1d.booking.LeaveWaitlistRequest\x1a\x1e.booking.LeaveWaitlistResponse\"0\x82\xd3\xe4\x93\x02**(/v1/events/{event_id}/waitlist/{user_id}\x12\x92\x01\n" +
+	"\x13GetWaitlistPosition\x12#.booking.GetWaitlistPositionRequest\x1a$.booking.GetWaitlistPositionResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/events/{event_id}/waitlist/{user_id}\x12j\n" +
+	"\tJoinQueue\x12\x19.booking.JoinQueueRequest\x1a\x1a.booking.JoinQueueResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/events/{event_id}/queue\x12r\n" +
+	"\x10GetQueuePosition\x12 .booking.GetQueuePositionRequest\x1a!.booking.GetQueuePositionResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/queue/{token}\x12[\n" +
+	"\x12WatchQueuePosition\x12 .booking.GetQueuePositionRequest\x1a!.booking.GetQueuePositionResponse0\x01\x12`\n" +
+	"\x13CancelEventBookings\x12#.booking.CancelEventBookingsRequest\x1a$.booking.CancelEventBookingsResponseB\tZ\a./protob\x06proto3"
 
 var (
 	file_booking_proto_rawDescOnce sync.Once
@@ -595,40 +2275,104 @@ func file_booking_proto_rawDescGZIP() []byte {
 	return file_booking_proto_rawDescData
 }
 
-var file_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_booking_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_booking_proto_goTypes = []any{
-	(BookingStatus)(0),               // 0: booking.BookingStatus
-	(*Booking)(nil),                  // 1: booking.Booking
-	(*CreateBookingRequest)(nil),     // 2: booking.CreateBookingRequest
-	(*CreateBookingResponse)(nil),    // 3: booking.CreateBookingResponse
-	(*GetBookingRequest)(nil),        // 4: booking.GetBookingRequest
-	(*GetBookingResponse)(nil),       // 5: booking.GetBookingResponse
-	(*ListUserBookingsRequest)(nil),  // 6: booking.ListUserBookingsRequest
-	(*ListUserBookingsResponse)(nil), // 7: booking.ListUserBookingsResponse
-	(*CancelBookingRequest)(nil),     // 8: booking.CancelBookingRequest
-	(*CancelBookingResponse)(nil),    // 9: booking.CancelBookingResponse
-	(*timestamppb.Timestamp)(nil),    // 10: google.protobuf.Timestamp
+	(BookingStatus)(0),                     // 0: booking.BookingStatus
+	(WaitlistStatus)(0),                    // 1: booking.WaitlistStatus
+	(*Booking)(nil),                        // 2: booking.Booking
+	(*BookingItem)(nil),                    // 3: booking.BookingItem
+	(*CreateBookingRequest)(nil),           // 4: booking.CreateBookingRequest
+	(*LineItem)(nil),                       // 5: booking.LineItem
+	(*CreateBookingResponse)(nil),          // 6: booking.CreateBookingResponse
+	(*GetBookingRequest)(nil),              // 7: booking.GetBookingRequest
+	(*GetBookingResponse)(nil),             // 8: booking.GetBookingResponse
+	(*ListUserBookingsRequest)(nil),        // 9: booking.ListUserBookingsRequest
+	(*ListUserBookingsResponse)(nil),       // 10: booking.ListUserBookingsResponse
+	(*CancelBookingRequest)(nil),           // 11: booking.CancelBookingRequest
+	(*CancelBookingResponse)(nil),          // 12: booking.CancelBookingResponse
+	(*ConfirmBookingRequest)(nil),          // 13: booking.ConfirmBookingRequest
+	(*ConfirmBookingResponse)(nil),         // 14: booking.ConfirmBookingResponse
+	(*ModifyBookingRequest)(nil),           // 15: booking.ModifyBookingRequest
+	(*ModifyBookingResponse)(nil),          // 16: booking.ModifyBookingResponse
+	(*BookingAdjustment)(nil),              // 17: booking.BookingAdjustment
+	(*ListBookingAdjustmentsRequest)(nil),  // 18: booking.ListBookingAdjustmentsRequest
+	(*ListBookingAdjustmentsResponse)(nil), // 19: booking.ListBookingAdjustmentsResponse
+	(*CancelEventBookingsRequest)(nil),     // 20: booking.CancelEventBookingsRequest
+	(*CancelEventBookingsResponse)(nil),    // 21: booking.CancelEventBookingsResponse
+	(*WaitlistEntry)(nil),                  // 22: booking.WaitlistEntry
+	(*JoinWaitlistRequest)(nil),            // 23: booking.JoinWaitlistRequest
+	(*JoinWaitlistResponse)(nil),           // 24: booking.JoinWaitlistResponse
+	(*LeaveWaitlistRequest)(nil),           // 25: booking.LeaveWaitlistRequest
+	(*LeaveWaitlistResponse)(nil),          // 26: booking.LeaveWaitlistResponse
+	(*GetWaitlistPositionRequest)(nil),     // 27: booking.GetWaitlistPositionRequest
+	(*GetWaitlistPositionResponse)(nil),    // 28: booking.GetWaitlistPositionResponse
+	(*QueueTicket)(nil),                    // 29: booking.QueueTicket
+	(*JoinQueueRequest)(nil),               // 30: booking.JoinQueueRequest
+	(*JoinQueueResponse)(nil),              // 31: booking.JoinQueueResponse
+	(*GetQueuePositionRequest)(nil),        // 32: booking.GetQueuePositionRequest
+	(*GetQueuePositionResponse)(nil),       // 33: booking.GetQueuePositionResponse
+	(*timestamppb.Timestamp)(nil),          // 34: google.protobuf.Timestamp
 }
 var file_booking_proto_depIdxs = []int32{
 	0,  // 0: booking.Booking.status:type_name -> booking.BookingStatus
-	10, // 1: booking.Booking.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: booking.CreateBookingResponse.booking:type_name -> booking.Booking
-	1,  // 3: booking.GetBookingResponse.booking:type_name -> booking.Booking
-	1,  // 4: booking.ListUserBookingsResponse.bookings:type_name -> booking.Booking
-	2,  // 5: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
-	4,  // 6: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
-	6,  // 7: booking.BookingService.ListUserBookings:input_type -> booking.ListUserBookingsRequest
-	8,  // 8: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
-	3,  // 9: booking.BookingService.CreateBooking:output_type -> booking.CreateBookingResponse
-	5,  // 10: booking.BookingService.GetBooking:output_type -> booking.GetBookingResponse
-	7,  // 11: booking.BookingService.ListUserBookings:output_type -> booking.ListUserBookingsResponse
-	9,  // 12: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
-	9,  // [9:13] is the sub-list for method output_type
-	5,  // [5:9] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	34, // 1: booking.Booking.created_at:type_name -> google.protobuf.Timestamp
+	34, // 2: booking.Booking.expires_at:type_name -> google.protobuf.Timestamp
+	3,  // 3: booking.Booking.items:type_name -> booking.BookingItem
+	5,  // 4: booking.CreateBookingRequest.items:type_name -> booking.LineItem
+	2,  // 5: booking.CreateBookingResponse.booking:type_name -> booking.Booking
+	2,  // 6: booking.GetBookingResponse.booking:type_name -> booking.Booking
+	34, // 7: booking.ListUserBookingsRequest.created_from:type_name -> google.protobuf.Timestamp
+	34, // 8: booking.ListUserBookingsRequest.created_before:type_name -> google.protobuf.Timestamp
+	0,  // 9: booking.ListUserBookingsRequest.status:type_name -> booking.BookingStatus
+	2,  // 10: booking.ListUserBookingsResponse.bookings:type_name -> booking.Booking
+	2,  // 11: booking.ConfirmBookingResponse.booking:type_name -> booking.Booking
+	2,  // 12: booking.ModifyBookingResponse.booking:type_name -> booking.Booking
+	34, // 13: booking.BookingAdjustment.created_at:type_name -> google.protobuf.Timestamp
+	17, // 14: booking.ListBookingAdjustmentsResponse.adjustments:type_name -> booking.BookingAdjustment
+	1,  // 15: booking.WaitlistEntry.status:type_name -> booking.WaitlistStatus
+	34, // 16: booking.WaitlistEntry.created_at:type_name -> google.protobuf.Timestamp
+	22, // 17: booking.JoinWaitlistResponse.entry:type_name -> booking.WaitlistEntry
+	22, // 18: booking.GetWaitlistPositionResponse.entry:type_name -> booking.WaitlistEntry
+	34, // 19: booking.QueueTicket.admit_at:type_name -> google.protobuf.Timestamp
+	34, // 20: booking.QueueTicket.created_at:type_name -> google.protobuf.Timestamp
+	29, // 21: booking.JoinQueueResponse.ticket:type_name -> booking.QueueTicket
+	34, // 22: booking.JoinQueueResponse.pass_expires_at:type_name -> google.protobuf.Timestamp
+	29, // 23: booking.GetQueuePositionResponse.ticket:type_name -> booking.QueueTicket
+	34, // 24: booking.GetQueuePositionResponse.pass_expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 25: booking.BookingService.CreateBooking:input_type -> booking.CreateBookingRequest
+	7,  // 26: booking.BookingService.GetBooking:input_type -> booking.GetBookingRequest
+	9,  // 27: booking.BookingService.ListUserBookings:input_type -> booking.ListUserBookingsRequest
+	11, // 28: booking.BookingService.CancelBooking:input_type -> booking.CancelBookingRequest
+	13, // 29: booking.BookingService.ConfirmBooking:input_type -> booking.ConfirmBookingRequest
+	15, // 30: booking.BookingService.ModifyBooking:input_type -> booking.ModifyBookingRequest
+	18, // 31: booking.BookingService.ListBookingAdjustments:input_type -> booking.ListBookingAdjustmentsRequest
+	23, // 32: booking.BookingService.JoinWaitlist:input_type -> booking.JoinWaitlistRequest
+	25, // 33: booking.BookingService.LeaveWaitlist:input_type -> booking.LeaveWaitlistRequest
+	27, // 34: booking.BookingService.GetWaitlistPosition:input_type -> booking.GetWaitlistPositionRequest
+	30, // 35: booking.BookingService.JoinQueue:input_type -> booking.JoinQueueRequest
+	32, // 36: booking.BookingService.GetQueuePosition:input_type -> booking.GetQueuePositionRequest
+	32, // 37: booking.BookingService.WatchQueuePosition:input_type -> booking.GetQueuePositionRequest
+	20, // 38: booking.BookingService.CancelEventBookings:input_type -> booking.CancelEventBookingsRequest
+	6,  // 39: booking.BookingService.CreateBooking:output_type -> booking.CreateBookingResponse
+	8,  // 40: booking.BookingService.GetBooking:output_type -> booking.GetBookingResponse
+	10, // 41: booking.BookingService.ListUserBookings:output_type -> booking.ListUserBookingsResponse
+	12, // 42: booking.BookingService.CancelBooking:output_type -> booking.CancelBookingResponse
+	14, // 43: booking.BookingService.ConfirmBooking:output_type -> booking.ConfirmBookingResponse
+	16, // 44: booking.BookingService.ModifyBooking:output_type -> booking.ModifyBookingResponse
+	19, // 45: booking.BookingService.ListBookingAdjustments:output_type -> booking.ListBookingAdjustmentsResponse
+	24, // 46: booking.BookingService.JoinWaitlist:output_type -> booking.JoinWaitlistResponse
+	26, // 47: booking.BookingService.LeaveWaitlist:output_type -> booking.LeaveWaitlistResponse
+	28, // 48: booking.BookingService.GetWaitlistPosition:output_type -> booking.GetWaitlistPositionResponse
+	31, // 49: booking.BookingService.JoinQueue:output_type -> booking.JoinQueueResponse
+	33, // 50: booking.BookingService.GetQueuePosition:output_type -> booking.GetQueuePositionResponse
+	33, // 51: booking.BookingService.WatchQueuePosition:output_type -> booking.GetQueuePositionResponse
+	21, // 52: booking.BookingService.CancelEventBookings:output_type -> booking.CancelEventBookingsResponse
+	39, // [39:53] is the sub-list for method output_type
+	25, // [25:39] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_booking_proto_init() }
@@ -641,8 +2385,8 @@ func file_booking_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_proto_rawDesc), len(file_booking_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
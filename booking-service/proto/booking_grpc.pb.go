@@ -19,22 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_CreateBooking_FullMethodName    = "/booking.BookingService/CreateBooking"
-	BookingService_GetBooking_FullMethodName       = "/booking.BookingService/GetBooking"
-	BookingService_ListUserBookings_FullMethodName = "/booking.BookingService/ListUserBookings"
-	BookingService_CancelBooking_FullMethodName    = "/booking.BookingService/CancelBooking"
+	BookingService_CreateBooking_FullMethodName          = "/booking.BookingService/CreateBooking"
+	BookingService_GetBooking_FullMethodName             = "/booking.BookingService/GetBooking"
+	BookingService_ListUserBookings_FullMethodName       = "/booking.BookingService/ListUserBookings"
+	BookingService_CancelBooking_FullMethodName          = "/booking.BookingService/CancelBooking"
+	BookingService_ConfirmBooking_FullMethodName         = "/booking.BookingService/ConfirmBooking"
+	BookingService_ModifyBooking_FullMethodName          = "/booking.BookingService/ModifyBooking"
+	BookingService_ListBookingAdjustments_FullMethodName = "/booking.BookingService/ListBookingAdjustments"
+	BookingService_JoinWaitlist_FullMethodName           = "/booking.BookingService/JoinWaitlist"
+	BookingService_LeaveWaitlist_FullMethodName          = "/booking.BookingService/LeaveWaitlist"
+	BookingService_GetWaitlistPosition_FullMethodName    = "/booking.BookingService/GetWaitlistPosition"
+	BookingService_JoinQueue_FullMethodName              = "/booking.BookingService/JoinQueue"
+	BookingService_GetQueuePosition_FullMethodName       = "/booking.BookingService/GetQueuePosition"
+	BookingService_WatchQueuePosition_FullMethodName     = "/booking.BookingService/WatchQueuePosition"
+	BookingService_CancelEventBookings_FullMethodName    = "/booking.BookingService/CancelEventBookings"
 )
 
 // BookingServiceClient is the client API for BookingService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// Tek bir servis, içinde tüm RPC'ler
 type BookingServiceClient interface {
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	GetBooking(ctx context.Context, in *GetBookingRequest, opts ...grpc.CallOption) (*GetBookingResponse, error)
 	ListUserBookings(ctx context.Context, in *ListUserBookingsRequest, opts ...grpc.CallOption) (*ListUserBookingsResponse, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
+	ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*ConfirmBookingResponse, error)
+	ModifyBooking(ctx context.Context, in *ModifyBookingRequest, opts ...grpc.CallOption) (*ModifyBookingResponse, error)
+	ListBookingAdjustments(ctx context.Context, in *ListBookingAdjustmentsRequest, opts ...grpc.CallOption) (*ListBookingAdjustmentsResponse, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error)
+	JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (*JoinQueueResponse, error)
+	GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (*GetQueuePositionResponse, error)
+	// Streams the ticket's place in the queue until it is admitted; the last
+	// message carries the pass. Not exposed over HTTP, where clients poll
+	// GetQueuePosition instead.
+	WatchQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetQueuePositionResponse], error)
+	// Called by event-service when an event is cancelled; not exposed over HTTP.
+	CancelEventBookings(ctx context.Context, in *CancelEventBookingsRequest, opts ...grpc.CallOption) (*CancelEventBookingsResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -85,16 +107,137 @@ func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) ConfirmBooking(ctx context.Context, in *ConfirmBookingRequest, opts ...grpc.CallOption) (*ConfirmBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_ConfirmBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ModifyBooking(ctx context.Context, in *ModifyBookingRequest, opts ...grpc.CallOption) (*ModifyBookingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModifyBookingResponse)
+	err := c.cc.Invoke(ctx, BookingService_ModifyBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListBookingAdjustments(ctx context.Context, in *ListBookingAdjustmentsRequest, opts ...grpc.CallOption) (*ListBookingAdjustmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBookingAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ListBookingAdjustments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*JoinWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinWaitlistResponse)
+	err := c.cc.Invoke(ctx, BookingService_JoinWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, BookingService_LeaveWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitlistPositionResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetWaitlistPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) JoinQueue(ctx context.Context, in *JoinQueueRequest, opts ...grpc.CallOption) (*JoinQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinQueueResponse)
+	err := c.cc.Invoke(ctx, BookingService_JoinQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (*GetQueuePositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueuePositionResponse)
+	err := c.cc.Invoke(ctx, BookingService_GetQueuePosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) WatchQueuePosition(ctx context.Context, in *GetQueuePositionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetQueuePositionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookingService_ServiceDesc.Streams[0], BookingService_WatchQueuePosition_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetQueuePositionRequest, GetQueuePositionResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchQueuePositionClient = grpc.ServerStreamingClient[GetQueuePositionResponse]
+
+func (c *bookingServiceClient) CancelEventBookings(ctx context.Context, in *CancelEventBookingsRequest, opts ...grpc.CallOption) (*CancelEventBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelEventBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_CancelEventBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
-//
-// Tek bir servis, içinde tüm RPC'ler
 type BookingServiceServer interface {
 	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
 	GetBooking(context.Context, *GetBookingRequest) (*GetBookingResponse, error)
 	ListUserBookings(context.Context, *ListUserBookingsRequest) (*ListUserBookingsResponse, error)
 	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
+	ConfirmBooking(context.Context, *ConfirmBookingRequest) (*ConfirmBookingResponse, error)
+	ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error)
+	ListBookingAdjustments(context.Context, *ListBookingAdjustmentsRequest) (*ListBookingAdjustmentsResponse, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error)
+	JoinQueue(context.Context, *JoinQueueRequest) (*JoinQueueResponse, error)
+	GetQueuePosition(context.Context, *GetQueuePositionRequest) (*GetQueuePositionResponse, error)
+	// Streams the ticket's place in the queue until it is admitted; the last
+	// message carries the pass. Not exposed over HTTP, where clients poll
+	// GetQueuePosition instead.
+	WatchQueuePosition(*GetQueuePositionRequest, grpc.ServerStreamingServer[GetQueuePositionResponse]) error
+	// Called by event-service when an event is cancelled; not exposed over HTTP.
+	CancelEventBookings(context.Context, *CancelEventBookingsRequest) (*CancelEventBookingsResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -117,6 +260,36 @@ func (UnimplementedBookingServiceServer) ListUserBookings(context.Context, *List
 func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CancelBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) ConfirmBooking(context.Context, *ConfirmBookingRequest) (*ConfirmBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfirmBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ModifyBooking(context.Context, *ModifyBookingRequest) (*ModifyBookingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ModifyBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListBookingAdjustments(context.Context, *ListBookingAdjustmentsRequest) (*ListBookingAdjustmentsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBookingAdjustments not implemented")
+}
+func (UnimplementedBookingServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*JoinWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWaitlistPosition not implemented")
+}
+func (UnimplementedBookingServiceServer) JoinQueue(context.Context, *JoinQueueRequest) (*JoinQueueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinQueue not implemented")
+}
+func (UnimplementedBookingServiceServer) GetQueuePosition(context.Context, *GetQueuePositionRequest) (*GetQueuePositionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetQueuePosition not implemented")
+}
+func (UnimplementedBookingServiceServer) WatchQueuePosition(*GetQueuePositionRequest, grpc.ServerStreamingServer[GetQueuePositionResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchQueuePosition not implemented")
+}
+func (UnimplementedBookingServiceServer) CancelEventBookings(context.Context, *CancelEventBookingsRequest) (*CancelEventBookingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelEventBookings not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -210,6 +383,179 @@ func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_ConfirmBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ConfirmBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ConfirmBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ConfirmBooking(ctx, req.(*ConfirmBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ModifyBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ModifyBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ModifyBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ModifyBooking(ctx, req.(*ModifyBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListBookingAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBookingAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListBookingAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ListBookingAdjustments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListBookingAdjustments(ctx, req.(*ListBookingAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_JoinWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_LeaveWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetWaitlistPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetWaitlistPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetWaitlistPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetWaitlistPosition(ctx, req.(*GetWaitlistPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_JoinQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).JoinQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_JoinQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).JoinQueue(ctx, req.(*JoinQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetQueuePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueuePositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetQueuePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetQueuePosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetQueuePosition(ctx, req.(*GetQueuePositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_WatchQueuePosition_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetQueuePositionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookingServiceServer).WatchQueuePosition(m, &grpc.GenericServerStream[GetQueuePositionRequest, GetQueuePositionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_WatchQueuePositionServer = grpc.ServerStreamingServer[GetQueuePositionResponse]
+
+func _BookingService_CancelEventBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CancelEventBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_CancelEventBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CancelEventBookings(ctx, req.(*CancelEventBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,7 +579,49 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBooking",
 			Handler:    _BookingService_CancelBooking_Handler,
 		},
+		{
+			MethodName: "ConfirmBooking",
+			Handler:    _BookingService_ConfirmBooking_Handler,
+		},
+		{
+			MethodName: "ModifyBooking",
+			Handler:    _BookingService_ModifyBooking_Handler,
+		},
+		{
+			MethodName: "ListBookingAdjustments",
+			Handler:    _BookingService_ListBookingAdjustments_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _BookingService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _BookingService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "GetWaitlistPosition",
+			Handler:    _BookingService_GetWaitlistPosition_Handler,
+		},
+		{
+			MethodName: "JoinQueue",
+			Handler:    _BookingService_JoinQueue_Handler,
+		},
+		{
+			MethodName: "GetQueuePosition",
+			Handler:    _BookingService_GetQueuePosition_Handler,
+		},
+		{
+			MethodName: "CancelEventBookings",
+			Handler:    _BookingService_CancelEventBookings_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQueuePosition",
+			Handler:       _BookingService_WatchQueuePosition_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking.proto",
 }
@@ -0,0 +1,173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: booking/booking.proto
+
+package booking
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CancelEventBookingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventBookingsRequest) Reset() {
+	*x = CancelEventBookingsRequest{}
+	mi := &file_booking_booking_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventBookingsRequest) ProtoMessage() {}
+
+func (x *CancelEventBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventBookingsRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CancelEventBookingsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type CancelEventBookingsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CancelledCount int32                  `protobuf:"varint,1,opt,name=cancelled_count,json=cancelledCount,proto3" json:"cancelled_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CancelEventBookingsResponse) Reset() {
+	*x = CancelEventBookingsResponse{}
+	mi := &file_booking_booking_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventBookingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventBookingsResponse) ProtoMessage() {}
+
+func (x *CancelEventBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_booking_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventBookingsResponse.ProtoReflect.Descriptor instead.
+func (*CancelEventBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_booking_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CancelEventBookingsResponse) GetCancelledCount() int32 {
+	if x != nil {
+		return x.CancelledCount
+	}
+	return 0
+}
+
+var File_booking_booking_proto protoreflect.FileDescriptor
+
+const file_booking_booking_proto_rawDesc = "" +
+	"\n" +
+	"\x15booking/booking.proto\x12\abooking\"7\n" +
+	"\x1aCancelEventBookingsRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"F\n" +
+	"\x1bCancelEventBookingsResponse\x12'\n" +
+	"\x0fcancelled_count\x18\x01 \x01(\x05R\x0ecancelledCount2r\n" +
+	"\x0eBookingService\x12`\n" +
+	"\x13CancelEventBookings\x12#.booking.CancelEventBookingsRequest\x1a$.booking.CancelEventBookingsResponseB\vZ\t./bookingb\x06proto3"
+
+var (
+	file_booking_booking_proto_rawDescOnce sync.Once
+	file_booking_booking_proto_rawDescData []byte
+)
+
+func file_booking_booking_proto_rawDescGZIP() []byte {
+	file_booking_booking_proto_rawDescOnce.Do(func() {
+		file_booking_booking_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_booking_booking_proto_rawDesc), len(file_booking_booking_proto_rawDesc)))
+	})
+	return file_booking_booking_proto_rawDescData
+}
+
+var file_booking_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_booking_booking_proto_goTypes = []any{
+	(*CancelEventBookingsRequest)(nil),  // 0: booking.CancelEventBookingsRequest
+	(*CancelEventBookingsResponse)(nil), // 1: booking.CancelEventBookingsResponse
+}
+var file_booking_booking_proto_depIdxs = []int32{
+	0, // 0: booking.BookingService.CancelEventBookings:input_type -> booking.CancelEventBookingsRequest
+	1, // 1: booking.BookingService.CancelEventBookings:output_type -> booking.CancelEventBookingsResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_booking_booking_proto_init() }
+func file_booking_booking_proto_init() {
+	if File_booking_booking_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_booking_booking_proto_rawDesc), len(file_booking_booking_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_booking_booking_proto_goTypes,
+		DependencyIndexes: file_booking_booking_proto_depIdxs,
+		MessageInfos:      file_booking_booking_proto_msgTypes,
+	}.Build()
+	File_booking_booking_proto = out.File
+	file_booking_booking_proto_goTypes = nil
+	file_booking_booking_proto_depIdxs = nil
+}
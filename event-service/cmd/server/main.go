@@ -1,6 +1,10 @@
 package main
 
 import (
+	// Venue time zones must load in the alpine image, which has no tz
+	// database.
+	_ "time/tzdata"
+
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/app"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/config"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
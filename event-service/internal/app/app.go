@@ -1,19 +1,41 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net/http"
+	"sync"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/client"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/config"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/health"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/tlsconfig"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/tracing"
+	"go.uber.org/zap"
 	grpclib "google.golang.org/grpc"
 )
 
 type App struct {
-	cfg        *config.Config
-	db         *sql.DB
-	grpcServer *grpclib.Server
-	httpServer *http.Server
+	cfg           *config.Config
+	db            *sql.DB
+	grpcServer    *grpclib.Server
+	httpServer    *http.Server
+	bookingClient client.BookingClient
+	health        *health.Checker
+
+	availability         *availability.Hub
+	availabilityListener *availability.Listener
+
+	tlsReloaders    []*tlsconfig.Reloader
+	shutdownTracing func(context.Context) error
+
+	// Background workers are started in start() and stopped on shutdown.
+	workers     []func(ctx context.Context)
+	stopWorkers context.CancelFunc
+	workersDone sync.WaitGroup
 }
 
 func New(cfg *config.Config) *App {
@@ -21,6 +43,10 @@ func New(cfg *config.Config) *App {
 }
 
 func (a *App) Run() error {
+	if err := a.initTracing(); err != nil {
+		return fmt.Errorf("failed to init tracing: %w", err)
+	}
+
 	if err := a.initDB(); err != nil {
 		return fmt.Errorf("failed to init db: %w", err)
 	}
@@ -31,3 +57,20 @@ func (a *App) Run() error {
 
 	return a.start()
 }
+
+func (a *App) initTracing() error {
+	shutdown, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName:  "event-service",
+		Exporter:     a.cfg.Tracing.Exporter,
+		FilePath:     a.cfg.Tracing.FilePath,
+		OTLPEndpoint: a.cfg.Tracing.OTLPEndpoint,
+		OTLPInsecure: a.cfg.Tracing.OTLPInsecure,
+		SampleRatio:  a.cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return err
+	}
+	a.shutdownTracing = shutdown
+	logger.Info("Tracing ready", zap.String("exporter", a.cfg.Tracing.Exporter))
+	return nil
+}
@@ -1,14 +1,12 @@
 package app
 
 import (
-	"database/sql"
-
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
-	_ "github.com/lib/pq"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/repository/postgres"
 )
 
 func (a *App) initDB() error {
-	db, err := sql.Open("postgres", a.cfg.Database.DSN())
+	db, err := postgres.Open(a.cfg.Database.DSN())
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -11,37 +12,163 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/client"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/handler/grpc"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/health"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/metrics"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/repository/postgres"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/requestid"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/tlsconfig"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/tracing"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/usecase"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/worker"
 	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	grpclib "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 func (a *App) initServers() error {
+	// Booking client
+	var bookingCreds credentials.TransportCredentials
+	if tlsCfg := a.cfg.App.BookingServiceTLS; tlsCfg.Enabled {
+		reloader, err := a.newTLSReloader(tlsconfig.Config{
+			CertFile:   tlsCfg.CertFile,
+			KeyFile:    tlsCfg.KeyFile,
+			CAFile:     tlsCfg.CAFile,
+			ServerName: tlsCfg.ServerName,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to init booking client TLS: %w", err)
+		}
+		bookingCreds = reloader.ClientCredentials()
+	}
+	bookingClient, err := client.NewBookingClient(a.cfg.App.BookingServiceAddr, a.cfg.App.BookingServiceTokenFile, bookingCreds)
+	if err != nil {
+		return fmt.Errorf("failed to connect to booking service: %w", err)
+	}
+	a.bookingClient = bookingClient
+	logger.Info("Connected to Booking Service", zap.String("addr", a.cfg.App.BookingServiceAddr))
+
 	// Dependencies
 	repo := postgres.NewEventRepository(a.db)
-	svc := usecase.NewEventUsecase(repo)
-	handler := grpc.NewEventHandler(svc)
+	layouts := postgres.NewLayoutRepository(a.db)
+	ticketTypes := postgres.NewTicketTypeRepository(a.db)
+	venues := postgres.NewVenueRepository(a.db)
+	organizers := postgres.NewOrganizerRepository(a.db)
+	series := postgres.NewSeriesRepository(a.db)
+	svc := usecase.NewEventUsecase(repo, layouts, ticketTypes, venues, organizers, series, a.bookingClient, a.cfg.Series.Horizon)
+
+	// Seat availability: every replica hears the changes of all of them
+	// through Postgres LISTEN/NOTIFY.
+	a.availability = availability.NewHub()
+	listener, err := availability.NewListener(a.cfg.Database.DSN(), a.availability)
+	if err != nil {
+		return fmt.Errorf("failed to listen for availability changes: %w", err)
+	}
+	a.availabilityListener = listener
+	handler := grpc.NewEventHandler(svc, a.availability)
+
+	// Health
+	// Booking Service is left out: it only matters for cancellations, and
+	// it checks this service in turn, so neither could become ready first.
+	a.health = health.NewChecker(a.cfg.Health.CheckTimeout, a.cfg.Health.CheckInterval, pb.EventService_ServiceDesc.ServiceName)
+	a.health.Add("database", a.db.PingContext)
+
+	// Background workers
+	roller := worker.NewSeriesRoller(svc, a.cfg.Series.ExtendInterval, a.cfg.Series.ExtendBatchSize)
+	a.workers = append(a.workers, roller.Run, a.health.Run)
+
+	// Metrics
+	metrics.RegisterDB(a.db, "event")
+	metrics.RegisterAvailabilityWatchers(a.availability.Watchers)
+	serverOpts := []grpclib.ServerOption{
+		grpclib.ChainUnaryInterceptor(metrics.GRPCServer.UnaryServerInterceptor()),
+		grpclib.ChainStreamInterceptor(metrics.GRPCServer.StreamServerInterceptor()),
+	}
+
+	// Tracing
+	serverOpts = append(serverOpts, grpclib.StatsHandler(otelgrpc.NewServerHandler()))
+
+	// Request IDs
+	routes := auth.GatewayRoutes(pb.File_event_proto.Services().ByName("EventService"))
+	routes["GET /v1/events/{event_id=*}/availability"] = pb.EventService_WatchEventAvailability_FullMethodName
+	serverOpts = append(serverOpts,
+		grpclib.ChainUnaryInterceptor(requestid.UnaryServerInterceptor()),
+		grpclib.ChainStreamInterceptor(requestid.StreamServerInterceptor()),
+	)
+
+	// TLS
+	if tlsCfg := a.cfg.Server.TLS; tlsCfg.Enabled {
+		if tlsCfg.CertFile == "" {
+			return errors.New("gRPC TLS is enabled but no certificate is configured")
+		}
+		reloader, err := a.newTLSReloader(tlsconfig.Config{
+			CertFile:    tlsCfg.CertFile,
+			KeyFile:     tlsCfg.KeyFile,
+			CAFile:      tlsCfg.ClientCAFile,
+			AllowedSANs: tlsCfg.AllowedSANs,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to init gRPC TLS: %w", err)
+		}
+		serverOpts = append(serverOpts, grpclib.Creds(reloader.ServerCredentials()))
+		logger.Info("gRPC TLS enabled", zap.Bool("mutual", tlsCfg.ClientCAFile != ""))
+	}
+
+	// Authentication
+	gatewayOpts := []runtime.ServeMuxOption{
+		runtime.WithMiddlewares(tracing.GatewayMiddleware(), requestid.Middleware(routes)),
+	}
+	if a.cfg.Auth.Enabled {
+		verifier, err := auth.NewVerifier(auth.Config{
+			HMACSecretFile: a.cfg.Auth.HMACSecretFile,
+			JWKSFile:       a.cfg.Auth.JWKSFile,
+			Issuer:         a.cfg.Auth.Issuer,
+			Audience:       a.cfg.Auth.Audience,
+			Leeway:         a.cfg.Auth.Leeway,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to init authentication: %w", err)
+		}
+		serverOpts = append(serverOpts,
+			grpclib.ChainUnaryInterceptor(auth.UnaryServerInterceptor(verifier, grpc.AccessPolicy)),
+			grpclib.ChainStreamInterceptor(auth.StreamServerInterceptor(verifier, grpc.AccessPolicy)),
+		)
+		gatewayOpts = append(gatewayOpts, runtime.WithMiddlewares(auth.Middleware(verifier, grpc.AccessPolicy, routes)))
+	} else {
+		logger.Warn("Authentication is disabled; callers are not identified")
+	}
 
 	// gRPC Server
-	a.grpcServer = grpclib.NewServer()
+	a.grpcServer = grpclib.NewServer(serverOpts...)
 	pb.RegisterEventServiceServer(a.grpcServer, handler)
+	healthpb.RegisterHealthServer(a.grpcServer, a.health.Server())
 	reflection.Register(a.grpcServer)
+	metrics.GRPCServer.InitializeMetrics(a.grpcServer)
 
 	// HTTP/gRPC-Gateway
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(gatewayOpts...)
 	if err := pb.RegisterEventServiceHandlerServer(context.Background(), mux, handler); err != nil {
 		return err
 	}
+	if err := mux.HandlePath(http.MethodGet, grpc.AvailabilityEventsPath, handler.ServeAvailabilityEvents(mux)); err != nil {
+		return err
+	}
 
 	httpMux := http.NewServeMux()
-	httpMux.Handle("/", mux)
-	httpMux.HandleFunc("/healthz", a.healthCheck)
+	httpMux.Handle("/", otelhttp.NewHandler(mux, "gateway"))
+	httpMux.HandleFunc("/livez", a.livez)
+	httpMux.HandleFunc("/readyz", a.readyz)
+	httpMux.Handle("/metrics", metrics.Handler())
 
 	httpAddr := fmt.Sprintf("%s:%s", a.cfg.Server.Host, a.cfg.Server.HTTP_Port)
 	a.httpServer = &http.Server{
@@ -52,19 +179,43 @@ func (a *App) initServers() error {
 	return nil
 }
 
-func (a *App) healthCheck(w http.ResponseWriter, r *http.Request) {
+// newTLSReloader loads the TLS files of cfg and keeps watching them until
+// shutdown.
+func (a *App) newTLSReloader(cfg tlsconfig.Config) (*tlsconfig.Reloader, error) {
+	reloader, err := tlsconfig.NewReloader(cfg)
+	if err != nil {
+		return nil, err
+	}
+	a.tlsReloaders = append(a.tlsReloaders, reloader)
+	return reloader, nil
+}
+
+// livez answers as long as the process can serve HTTP at all.
+func (a *App) livez(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
+
+// readyz checks the database, and turns unavailable for good once shutdown
+// has begun.
+func (a *App) readyz(w http.ResponseWriter, r *http.Request) {
+	report := a.health.Check(r.Context())
 	status := "ok"
 	httpStatus := http.StatusOK
-
-	if err := a.db.Ping(); err != nil {
-		status = "unhealthy"
+	if !report.Ready {
+		status = "unavailable"
+		if a.health.Draining() {
+			status = "draining"
+		}
 		httpStatus = http.StatusServiceUnavailable
-		logger.Error("health check failed: db ping error", zap.Error(err))
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(httpStatus)
-	json.NewEncoder(w).Encode(map[string]string{"status": status})
+	json.NewEncoder(w).Encode(map[string]any{
+		"status": status,
+		"checks": report.Checks,
+	})
 }
 
 func (a *App) start() error {
@@ -94,6 +245,20 @@ func (a *App) start() error {
 		}
 	}()
 
+	// Start background workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	a.stopWorkers = stopWorkers
+	for _, run := range a.workers {
+		a.workersDone.Add(1)
+		go func(run func(ctx context.Context)) {
+			defer a.workersDone.Done()
+			run(workerCtx)
+		}(run)
+	}
+
+	// Relay seat availability changes until shutdown
+	go a.availabilityListener.Run()
+
 	<-quit
 	logger.Info("Shutting down servers...")
 
@@ -101,6 +266,21 @@ func (a *App) start() error {
 }
 
 func (a *App) shutdown() error {
+	// Stop taking traffic: readiness and the gRPC health service report not
+	// serving before the servers stop.
+	a.health.Drain()
+	if delay := a.cfg.Health.DrainDelay; delay > 0 {
+		logger.Info("Draining before shutdown", zap.Duration("delay", delay))
+		time.Sleep(delay)
+	}
+
+	// End availability streams, which would otherwise hold both servers
+	// open
+	a.availability.Close()
+	if err := a.availabilityListener.Close(); err != nil {
+		logger.Error("Availability listener close error", zap.Error(err))
+	}
+
 	// Shutdown HTTP
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -112,6 +292,31 @@ func (a *App) shutdown() error {
 	// Shutdown gRPC
 	a.grpcServer.GracefulStop()
 
+	// Stop background workers
+	if a.stopWorkers != nil {
+		a.stopWorkers()
+		a.workersDone.Wait()
+	}
+
+	// Close booking client
+	if a.bookingClient != nil {
+		if err := a.bookingClient.Close(); err != nil {
+			logger.Error("Booking client close error", zap.Error(err))
+		}
+	}
+
+	// Stop watching TLS files
+	for _, reloader := range a.tlsReloaders {
+		reloader.Close()
+	}
+
+	// Flush spans
+	if a.shutdownTracing != nil {
+		if err := a.shutdownTracing(ctx); err != nil {
+			logger.Error("Tracing shutdown error", zap.Error(err))
+		}
+	}
+
 	// Close DB
 	if err := a.db.Close(); err != nil {
 		logger.Error("DB close error", zap.Error(err))
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	Subject string
+	Roles   []Role
+}
+
+// HasRole reports whether the caller holds any of roles.
+func (i *Identity) HasRole(roles ...Role) bool {
+	for _, held := range i.Roles {
+		if slices.Contains(roles, held) {
+			return true
+		}
+	}
+	return false
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the caller put into ctx by the interceptor or the
+// gateway middleware. There is none when authentication is disabled or the
+// call did not come in over the API, e.g. from a background worker.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// Config points the verifier at its keys. At least one of HMACSecretFile and
+// JWKSFile must be set; with both, the token's alg header picks the key.
+type Config struct {
+	HMACSecretFile string
+	JWKSFile       string
+	// Issuer and Audience, when set, must match the token's iss and aud.
+	Issuer   string
+	Audience string
+	// Leeway absorbs clock skew when checking exp and nbf.
+	Leeway time.Duration
+}
+
+var (
+	hmacMethods       = []string{"HS256", "HS384", "HS512"}
+	asymmetricMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+)
+
+// Verifier validates bearer tokens against keys loaded from local files.
+type Verifier struct {
+	secret []byte
+	keys   map[string]*publicKey
+	parser *jwt.Parser
+}
+
+func NewVerifier(cfg Config) (*Verifier, error) {
+	if cfg.HMACSecretFile == "" && cfg.JWKSFile == "" {
+		return nil, errors.New("auth: no HMAC secret file or JWKS file configured")
+	}
+
+	v := &Verifier{}
+	var methods []string
+	if cfg.HMACSecretFile != "" {
+		data, err := os.ReadFile(cfg.HMACSecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("auth: reading HMAC secret: %w", err)
+		}
+		v.secret = bytes.TrimSpace(data)
+		if len(v.secret) < 32 {
+			return nil, errors.New("auth: HMAC secret must be at least 32 bytes")
+		}
+		methods = append(methods, hmacMethods...)
+	}
+	if cfg.JWKSFile != "" {
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("auth: loading JWKS: %w", err)
+		}
+		v.keys = keys
+		methods = append(methods, asymmetricMethods...)
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(methods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Roles []Role `json:"roles"`
+}
+
+// Verify checks the token's signature and registered claims and returns the
+// caller it was issued to, with the roles of its roles claim. A token without
+// roles is a customer's.
+func (v *Verifier) Verify(token string) (*Identity, error) {
+	c := &claims{}
+	if _, err := v.parser.ParseWithClaims(token, c, v.key); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if c.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidToken)
+	}
+
+	roles := c.Roles
+	if len(roles) == 0 {
+		roles = []Role{RoleCustomer}
+	}
+	return &Identity{Subject: c.Subject, Roles: roles}, nil
+}
+
+func (v *Verifier) key(token *jwt.Token) (any, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		return v.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := v.keys[kid]
+	if !ok && kid == "" && len(v.keys) == 1 {
+		for _, only := range v.keys {
+			key, ok = only, true
+		}
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	if key.alg != "" && key.alg != token.Method.Alg() {
+		return nil, fmt.Errorf("key %q is not for %s", kid, token.Method.Alg())
+	}
+	return key.key, nil
+}
@@ -0,0 +1,221 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func writeFile(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func hmacVerifier(t *testing.T) *Verifier {
+	t.Helper()
+	v, err := NewVerifier(Config{HMACSecretFile: writeFile(t, "secret", []byte(testSecret+"\n"))})
+	assert.NoError(t, err)
+	return v
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, key any, kid string, claims jwt.Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return signed
+}
+
+func userClaims(subject string) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestVerify_HMAC(t *testing.T) {
+	v := hmacVerifier(t)
+
+	identity, err := v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1")))
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "user-1", identity.Subject)
+	}
+}
+
+func TestVerify_RejectsBadTokens(t *testing.T) {
+	v := hmacVerifier(t)
+	expired := userClaims("user-1")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	noExpiry := userClaims("user-1")
+	noExpiry.ExpiresAt = nil
+
+	tests := map[string]string{
+		"wrong secret": sign(t, jwt.SigningMethodHS256, []byte("another-secret-another-secret-xx"), "", userClaims("user-1")),
+		"expired":      sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", expired),
+		"no expiry":    sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", noExpiry),
+		"no subject":   sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("")),
+		"garbage":      "not-a-token",
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := v.Verify(token)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestNewVerifier_RequiresKeys(t *testing.T) {
+	_, err := NewVerifier(Config{})
+	assert.Error(t, err)
+
+	_, err = NewVerifier(Config{HMACSecretFile: writeFile(t, "secret", []byte("short"))})
+	assert.Error(t, err)
+}
+
+func TestVerify_Roles(t *testing.T) {
+	v := hmacVerifier(t)
+	organizer := claims{RegisteredClaims: userClaims("org-1"), Roles: []Role{RoleOrganizer}}
+
+	identity, err := v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", organizer))
+	if assert.NoError(t, err) {
+		assert.True(t, identity.HasRole(RoleOrganizer, RoleAdmin))
+		assert.False(t, identity.HasRole(RoleCustomer))
+	}
+
+	identity, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1")))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Role{RoleCustomer}, identity.Roles)
+	}
+}
+
+var testPolicy = Policy{
+	"/svc/Public":  Public,
+	"/svc/Private": Allow(RoleCustomer),
+	"/svc/Service": Allow(RoleService),
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	v := hmacVerifier(t)
+	intercept := UnaryServerInterceptor(v, testPolicy)
+	var got *Identity
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = FromContext(ctx)
+		return "ok", nil
+	}
+	call := func(ctx context.Context, method string) error {
+		got = nil
+		_, err := intercept(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	}
+	customer := withToken(sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1")))
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background(), "/svc/Private")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(withToken("bad"), "/svc/Private")))
+
+	assert.NoError(t, call(context.Background(), "/svc/Public"))
+	assert.Nil(t, got)
+
+	assert.NoError(t, call(customer, "/svc/Private"))
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "user-1", got.Subject)
+	}
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(customer, "/svc/Service")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(customer, "/svc/Unlisted")))
+}
+
+// testStream is a server stream that only has a context.
+type testStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	v := hmacVerifier(t)
+	intercept := StreamServerInterceptor(v, testPolicy)
+	var got *Identity
+	handler := func(_ any, ss grpc.ServerStream) error {
+		got, _ = FromContext(ss.Context())
+		return nil
+	}
+	call := func(ctx context.Context, method string) error {
+		got = nil
+		return intercept(nil, &testStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: method}, handler)
+	}
+	token := sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1"))
+	customer := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background(), "/svc/Private")))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(customer, "/svc/Service")))
+	assert.NoError(t, call(customer, "/svc/Private"))
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "user-1", got.Subject)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	v := hmacVerifier(t)
+	routes := map[string]string{
+		"GET /v1/bookings/{booking_id=*}": "/svc/Private",
+		"DELETE /v1/events/{event_id=*}":  "/svc/Service",
+	}
+	var got *Identity
+	mux := runtime.NewServeMux(runtime.WithMiddlewares(Middleware(v, testPolicy, routes)))
+	ok := func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		got, _ = FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}
+	assert.NoError(t, mux.HandlePath(http.MethodGet, "/v1/bookings/{booking_id}", ok))
+	assert.NoError(t, mux.HandlePath(http.MethodDelete, "/v1/events/{event_id}", ok))
+	assert.NoError(t, mux.HandlePath(http.MethodGet, "/v1/unlisted", ok))
+	serve := func(method, path, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+	token := sign(t, jwt.SigningMethodHS256, []byte(testSecret), "", userClaims("user-1"))
+
+	rec := serve(http.MethodGet, "/v1/bookings/b-1", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), ErrMissingToken.Error())
+
+	rec = serve(http.MethodGet, "/v1/bookings/b-1", token)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	if assert.NotNil(t, got) {
+		assert.Equal(t, "user-1", got.Subject)
+	}
+
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "/v1/events/e-1", token).Code)
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodGet, "/v1/unlisted", token).Code)
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// UnaryServerInterceptor authenticates gRPC calls and applies the policy.
+func UnaryServerInterceptor(v *Verifier, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var header string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				header = values[0]
+			}
+		}
+
+		identity, err := policy.check(v, info.FullMethod, header)
+		if err != nil {
+			logger.FromContext(ctx).Debug("auth: call rejected", zap.String("method", info.FullMethod), zap.Error(err))
+			return nil, err
+		}
+		if identity != nil {
+			ctx = WithIdentity(ctx, identity)
+			ctx = logger.WithFields(ctx, zap.String("userID", identity.Subject))
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor does for streaming calls what UnaryServerInterceptor
+// does for unary ones.
+func StreamServerInterceptor(v *Verifier, policy Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		var header string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				header = values[0]
+			}
+		}
+
+		identity, err := policy.check(v, info.FullMethod, header)
+		if err != nil {
+			logger.FromContext(ctx).Debug("auth: call rejected", zap.String("method", info.FullMethod), zap.Error(err))
+			return err
+		}
+		if identity != nil {
+			ctx = WithIdentity(ctx, identity)
+			ctx = logger.WithFields(ctx, zap.String("userID", identity.Subject))
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream replaces the context of a stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// Middleware does for the gateway, which calls the handlers directly and so
+// bypasses the gRPC interceptor, what UnaryServerInterceptor does for gRPC.
+// routes, from GatewayRoutes, tells which RPC a request is for.
+func Middleware(v *Verifier, policy Policy, routes map[string]string) runtime.Middleware {
+	return func(next runtime.HandlerFunc) runtime.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+			var method string
+			if pattern, ok := runtime.HTTPPattern(r.Context()); ok {
+				method = routes[r.Method+" "+pattern.String()]
+			}
+
+			identity, err := policy.check(v, method, r.Header.Get("Authorization"))
+			if err != nil {
+				logger.FromContext(r.Context()).Debug("auth: request rejected", zap.String("path", r.URL.Path), zap.Error(err))
+				writeError(w, status.Convert(err))
+				return
+			}
+			if identity != nil {
+				ctx := WithIdentity(r.Context(), identity)
+				r = r.WithContext(logger.WithFields(ctx, zap.String("userID", identity.Subject)))
+			}
+
+			next(w, r, pathParams)
+		}
+	}
+}
+
+func (v *Verifier) authenticate(header string) (*Identity, error) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return nil, ErrMissingToken
+	}
+	return v.Verify(strings.TrimSpace(token))
+}
+
+// authMessage keeps the reason a token was refused out of the response.
+func authMessage(err error) string {
+	if errors.Is(err, ErrMissingToken) {
+		return ErrMissingToken.Error()
+	}
+	return ErrInvalidToken.Error()
+}
+
+// writeError answers in the gateway's error body format.
+func writeError(w http.ResponseWriter, st *status.Status) {
+	body, _ := protojson.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", `Bearer`)
+	}
+	w.WriteHeader(runtime.HTTPStatusFromCode(st.Code()))
+	w.Write(body)
+}
@@ -0,0 +1,128 @@
+package availability
+
+import "sync"
+
+// Hub hands the seat counts of events, as Postgres announces them, to the
+// subscriptions watching those events.
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[string]map[*Subscription]struct{}
+	closed bool
+	done   chan struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		subs: make(map[string]map[*Subscription]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Subscribe starts watching an event. The subscription must be closed.
+func (h *Hub) Subscribe(eventID string) *Subscription {
+	s := &Subscription{
+		hub:     h,
+		eventID: eventID,
+		changed: make(chan struct{}, 1),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[eventID] == nil {
+		h.subs[eventID] = make(map[*Subscription]struct{})
+	}
+	h.subs[eventID][s] = struct{}{}
+	return s
+}
+
+// Publish records the event's new seat count on its subscriptions.
+func (h *Hub) Publish(eventID string, seats int32) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subs[eventID] {
+		s.set(seats, true)
+	}
+}
+
+// Resync tells every subscription its count may be stale, e.g. because
+// notifications were lost while the listener was reconnecting.
+func (h *Hub) Resync() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, subs := range h.subs {
+		for s := range subs {
+			s.set(0, false)
+		}
+	}
+}
+
+// Close ends all subscriptions, present and future, on shutdown.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.closed {
+		h.closed = true
+		close(h.done)
+	}
+}
+
+// Watchers is the number of open subscriptions.
+func (h *Hub) Watchers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	n := 0
+	for _, subs := range h.subs {
+		n += len(subs)
+	}
+	return n
+}
+
+// Subscription keeps only the latest count of its event, so a watcher that
+// falls behind skips the counts it had no time to send.
+type Subscription struct {
+	hub     *Hub
+	eventID string
+	changed chan struct{}
+
+	mu    sync.Mutex
+	seats int32
+	known bool
+}
+
+// Changed receives when the count has changed since the last Latest.
+func (s *Subscription) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// Done is closed when the hub shuts down.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.hub.done
+}
+
+// Latest returns the last count announced, or false if it must be read
+// from the database instead.
+func (s *Subscription) Latest() (int32, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seats, s.known
+}
+
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	delete(s.hub.subs[s.eventID], s)
+	if len(s.hub.subs[s.eventID]) == 0 {
+		delete(s.hub.subs, s.eventID)
+	}
+}
+
+func (s *Subscription) set(seats int32, known bool) {
+	s.mu.Lock()
+	s.seats, s.known = seats, known
+	s.mu.Unlock()
+
+	select {
+	case s.changed <- struct{}{}:
+	default:
+	}
+}
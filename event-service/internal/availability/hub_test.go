@@ -0,0 +1,67 @@
+package availability
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub_PublishKeepsLatest(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe("event-1")
+	defer sub.Close()
+	other := hub.Subscribe("event-2")
+	defer other.Close()
+
+	hub.Publish("event-1", 10)
+	hub.Publish("event-1", 8)
+
+	<-sub.Changed()
+	seats, ok := sub.Latest()
+	assert.True(t, ok)
+	assert.Equal(t, int32(8), seats)
+	assert.Empty(t, sub.Changed(), "changes are coalesced")
+	assert.Empty(t, other.Changed(), "other events are not told")
+}
+
+func TestHub_Resync(t *testing.T) {
+	hub := NewHub()
+	sub := hub.Subscribe("event-1")
+	defer sub.Close()
+
+	hub.Publish("event-1", 10)
+	<-sub.Changed()
+	hub.Resync()
+
+	<-sub.Changed()
+	_, ok := sub.Latest()
+	assert.False(t, ok)
+}
+
+func TestHub_CloseAndWatchers(t *testing.T) {
+	hub := NewHub()
+	first := hub.Subscribe("event-1")
+	second := hub.Subscribe("event-1")
+	assert.Equal(t, 2, hub.Watchers())
+
+	first.Close()
+	second.Close()
+	assert.Zero(t, hub.Watchers())
+
+	hub.Close()
+	hub.Close()
+	_, open := <-hub.Subscribe("event-1").Done()
+	assert.False(t, open)
+}
+
+func TestParsePayload(t *testing.T) {
+	eventID, seats, err := parsePayload("2f1c6a3e-8d2b-4a7e-9c1d-5b6e7f8a9b0c:42")
+	assert.NoError(t, err)
+	assert.Equal(t, "2f1c6a3e-8d2b-4a7e-9c1d-5b6e7f8a9b0c", eventID)
+	assert.Equal(t, int32(42), seats)
+
+	for _, payload := range []string{"", "event-1", ":5", "event-1:many"} {
+		_, _, err := parsePayload(payload)
+		assert.ErrorIs(t, err, errBadPayload, payload)
+	}
+}
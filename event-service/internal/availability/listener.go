@@ -0,0 +1,98 @@
+package availability
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Channel is the Postgres channel the events table announces seat changes
+// on, with "<event_id>:<available_seats>" as payload.
+const Channel = "event_availability"
+
+const (
+	minReconnect = time.Second
+	maxReconnect = time.Minute
+	// pingInterval is how long the listener waits without notifications
+	// before checking that its connection is still alive.
+	pingInterval = 90 * time.Second
+)
+
+var errBadPayload = errors.New("availability: malformed notification")
+
+// Listener relays the notifications of Channel to a hub. It holds a
+// connection of its own, so every replica hears every change.
+type Listener struct {
+	pq  *pq.Listener
+	hub *Hub
+}
+
+func NewListener(dsn string, hub *Hub) (*Listener, error) {
+	l := pq.NewListener(dsn, minReconnect, maxReconnect, logListenerEvent)
+	if err := l.Listen(Channel); err != nil {
+		l.Close()
+		return nil, err
+	}
+	return &Listener{pq: l, hub: hub}, nil
+}
+
+// Run relays notifications until the listener is closed.
+func (l *Listener) Run() {
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case n, ok := <-l.pq.Notify:
+			if !ok {
+				return
+			}
+			if n == nil {
+				// lib/pq sends nil after reconnecting; changes made while
+				// it was away were not heard.
+				l.hub.Resync()
+				continue
+			}
+			eventID, seats, err := parsePayload(n.Extra)
+			if err != nil {
+				logger.Warn("Ignoring availability notification", zap.String("payload", n.Extra), zap.Error(err))
+				continue
+			}
+			l.hub.Publish(eventID, seats)
+		case <-ping.C:
+			go l.pq.Ping()
+		}
+	}
+}
+
+func (l *Listener) Close() error {
+	return l.pq.Close()
+}
+
+func parsePayload(payload string) (string, int32, error) {
+	eventID, count, ok := strings.Cut(payload, ":")
+	if !ok || eventID == "" {
+		return "", 0, errBadPayload
+	}
+	seats, err := strconv.ParseInt(count, 10, 32)
+	if err != nil {
+		return "", 0, errBadPayload
+	}
+	return eventID, int32(seats), nil
+}
+
+func logListenerEvent(event pq.ListenerEventType, err error) {
+	switch event {
+	case pq.ListenerEventDisconnected:
+		logger.Warn("Availability listener disconnected", zap.Error(err))
+	case pq.ListenerEventReconnected:
+		logger.Info("Availability listener reconnected")
+	case pq.ListenerEventConnectionAttemptFailed:
+		logger.Warn("Availability listener failed to connect", zap.Error(err))
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	bookingpb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/booking"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/metrics"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/requestid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+var ErrBookingService = errors.New("booking service error")
+
+// BookingClient cancels the bookings of a cancelled event. The call is
+// idempotent: bookings that are already cancelled are left alone. Errors
+// wrap ErrBookingService in a *StatusError that keeps the gRPC status
+// booking-service answered with.
+type BookingClient interface {
+	CancelEventBookings(ctx context.Context, eventID string) (int32, error)
+	Close() error
+}
+
+// StatusError is a client error together with the gRPC status it was mapped
+// from. status.FromError and errors.Is both see through it.
+type StatusError struct {
+	Err    error
+	Status *status.Status
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%v: %s: %s", e.Err, e.Status.Code(), e.Status.Message())
+}
+
+func (e *StatusError) Unwrap() error {
+	return e.Err
+}
+
+func (e *StatusError) GRPCStatus() *status.Status {
+	return e.Status
+}
+
+type bookingClient struct {
+	conn   *grpc.ClientConn
+	client bookingpb.BookingServiceClient
+}
+
+// NewBookingClient creates a gRPC client to booking-service. Calls carry the
+// bearer token stored in tokenFile, unless it is empty. Without creds the
+// connection is plaintext.
+func NewBookingClient(bookingServiceAddr, tokenFile string, creds credentials.TransportCredentials) (BookingClient, error) {
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), metrics.GRPCClient.UnaryClientInterceptor()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+	if tokenFile != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.TokenFile(tokenFile)))
+	}
+	conn, err := grpc.NewClient(bookingServiceAddr, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bookingClient{
+		conn:   conn,
+		client: bookingpb.NewBookingServiceClient(conn),
+	}, nil
+}
+
+func (c *bookingClient) CancelEventBookings(ctx context.Context, eventID string) (int32, error) {
+	resp, err := c.client.CancelEventBookings(ctx, &bookingpb.CancelEventBookingsRequest{
+		EventId: eventID,
+	})
+	if err != nil {
+		return 0, wrapError(err)
+	}
+
+	return resp.CancelledCount, nil
+}
+
+func (c *bookingClient) Close() error {
+	return c.conn.Close()
+}
+
+// wrapError wraps a failed call in ErrBookingService and keeps its status
+// alongside.
+func wrapError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return fmt.Errorf("%w: %w", ErrBookingService, err)
+	}
+	return &StatusError{Err: ErrBookingService, Status: st}
+}
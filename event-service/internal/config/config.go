@@ -3,6 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -20,16 +23,102 @@ type ServerConfig struct {
 	HTTP_Port string
 	GRPC_Port string
 	Host      string
+	// TLS applies to the gRPC listener.
+	TLS TLSConfig
+}
+
+// TLSConfig configures TLS on the gRPC listener. The files are reloaded when
+// they change.
+type TLSConfig struct {
+	Enabled  bool
+	CertFile string
+	KeyFile  string
+	// ClientCAFile turns on mutual TLS: callers must present a certificate
+	// signed by one of its CAs.
+	ClientCAFile string
+	// AllowedSANs restricts mutual TLS callers to certificates with one of
+	// these DNS, URI or IP SANs; empty allows any.
+	AllowedSANs []string
 }
 
 type AppConfig struct {
-	Environment string
+	Environment        string
+	BookingServiceAddr string
+	// BookingServiceTokenFile holds the bearer token event-service presents
+	// to booking-service.
+	BookingServiceTokenFile string
+	BookingServiceTLS       ClientTLSConfig
+}
+
+// ClientTLSConfig configures TLS on calls to another service. The files are
+// reloaded when they change.
+type ClientTLSConfig struct {
+	Enabled bool
+	// CAFile verifies the server's certificate; the system roots are used
+	// when empty.
+	CAFile string
+	// CertFile and KeyFile are presented when the server asks for a client
+	// certificate.
+	CertFile string
+	KeyFile  string
+	// ServerName, when set, is checked against the server's certificate
+	// instead of the dialed host.
+	ServerName string
+}
+
+// AuthConfig configures JWT authentication of incoming calls.
+type AuthConfig struct {
+	Enabled bool
+	// HMACSecretFile and JWKSFile hold the keys tokens are verified with;
+	// at least one is required when Enabled.
+	HMACSecretFile string
+	JWKSFile       string
+	Issuer         string
+	Audience       string
+	Leeway         time.Duration
+}
+
+// HealthConfig tunes the readiness checks.
+type HealthConfig struct {
+	// CheckTimeout bounds each run of the checks; CheckInterval is how often
+	// the gRPC health service is brought up to date.
+	CheckTimeout  time.Duration
+	CheckInterval time.Duration
+	// DrainDelay is how long to keep serving after readiness turns off on
+	// shutdown, so load balancers notice first.
+	DrainDelay time.Duration
+}
+
+// TracingConfig selects where OpenTelemetry spans are exported.
+type TracingConfig struct {
+	// Exporter is one of "none", "stdout", "file" or "otlp".
+	Exporter     string
+	FilePath     string
+	OTLPEndpoint string
+	OTLPInsecure bool
+	// SampleRatio is the share of new traces recorded; calls that arrive
+	// with a trace context follow the caller's decision.
+	SampleRatio float64
+}
+
+// SeriesConfig tunes how event series create their occurrences.
+type SeriesConfig struct {
+	// Horizon is how far ahead occurrences are created.
+	Horizon time.Duration
+	// ExtendInterval is how often series are checked for occurrences coming
+	// within the horizon, ExtendBatchSize series at a time.
+	ExtendInterval  time.Duration
+	ExtendBatchSize int
 }
 
 type Config struct {
 	Database DatabaseConfig
 	Server   ServerConfig
 	App      AppConfig
+	Auth     AuthConfig
+	Tracing  TracingConfig
+	Health   HealthConfig
+	Series   SeriesConfig
 }
 
 func Load() (*Config, error) {
@@ -50,9 +139,51 @@ func Load() (*Config, error) {
 			HTTP_Port: getEnv("HTTP_PORT", "8080"),
 			GRPC_Port: getEnv("GRPC_PORT", "9091"),
 			Host:      getEnv("HTTP_HOST", "0.0.0.0"),
+			TLS: TLSConfig{
+				Enabled:      getEnvBool("GRPC_TLS_ENABLED", false),
+				CertFile:     getEnv("GRPC_TLS_CERT_FILE", ""),
+				KeyFile:      getEnv("GRPC_TLS_KEY_FILE", ""),
+				ClientCAFile: getEnv("GRPC_TLS_CLIENT_CA_FILE", ""),
+				AllowedSANs:  getEnvList("GRPC_TLS_ALLOWED_SANS"),
+			},
 		},
 		App: AppConfig{
-			Environment: getEnv("APP_ENV", "development"),
+			Environment:        getEnv("APP_ENV", "development"),
+			BookingServiceAddr: getEnv("BOOKING_SERVICE_ADDR", "localhost:9091"),
+
+			BookingServiceTokenFile: getEnv("BOOKING_SERVICE_TOKEN_FILE", ""),
+			BookingServiceTLS: ClientTLSConfig{
+				Enabled:    getEnvBool("BOOKING_SERVICE_TLS_ENABLED", false),
+				CAFile:     getEnv("BOOKING_SERVICE_TLS_CA_FILE", ""),
+				CertFile:   getEnv("BOOKING_SERVICE_TLS_CERT_FILE", ""),
+				KeyFile:    getEnv("BOOKING_SERVICE_TLS_KEY_FILE", ""),
+				ServerName: getEnv("BOOKING_SERVICE_TLS_SERVER_NAME", ""),
+			},
+		},
+		Auth: AuthConfig{
+			Enabled:        getEnvBool("AUTH_ENABLED", true),
+			HMACSecretFile: getEnv("AUTH_HMAC_SECRET_FILE", ""),
+			JWKSFile:       getEnv("AUTH_JWKS_FILE", ""),
+			Issuer:         getEnv("AUTH_ISSUER", ""),
+			Audience:       getEnv("AUTH_AUDIENCE", ""),
+			Leeway:         getEnvDuration("AUTH_LEEWAY", 30*time.Second),
+		},
+		Tracing: TracingConfig{
+			Exporter:     getEnv("TRACING_EXPORTER", "none"),
+			FilePath:     getEnv("TRACING_FILE_PATH", "traces.jsonl"),
+			OTLPEndpoint: getEnv("TRACING_OTLP_ENDPOINT", "localhost:4317"),
+			OTLPInsecure: getEnvBool("TRACING_OTLP_INSECURE", false),
+			SampleRatio:  getEnvFloat("TRACING_SAMPLE_RATIO", 1.0),
+		},
+		Health: HealthConfig{
+			CheckTimeout:  getEnvDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+			CheckInterval: getEnvDuration("HEALTH_CHECK_INTERVAL", 5*time.Second),
+			DrainDelay:    getEnvDuration("SHUTDOWN_DRAIN_DELAY", 0),
+		},
+		Series: SeriesConfig{
+			Horizon:         getEnvDuration("SERIES_HORIZON", 90*24*time.Hour),
+			ExtendInterval:  getEnvDuration("SERIES_EXTEND_INTERVAL", time.Hour),
+			ExtendBatchSize: getEnvInt("SERIES_EXTEND_BATCH_SIZE", 50),
 		},
 	}
 
@@ -72,3 +203,54 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+		fmt.Printf("Invalid duration for %s, using default %s\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if i, err := strconv.Atoi(value); err == nil {
+			return i
+		}
+		fmt.Printf("Invalid integer for %s, using default %d\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+		fmt.Printf("Invalid number for %s, using default %g\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+		fmt.Printf("Invalid boolean for %s, using default %t\n", key, defaultValue)
+	}
+	return defaultValue
+}
+
+// getEnvList parses a comma-separated list, dropping empty entries.
+func getEnvList(key string) []string {
+	var result []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
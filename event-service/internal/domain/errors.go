@@ -6,4 +6,43 @@ var (
 	ErrEventNotFound     = errors.New("event not found")
 	ErrInvalidInput      = errors.New("invalid input")
 	ErrInsufficientSeats = errors.New("insufficient available seats")
+	ErrPermissionDenied  = errors.New("permission denied")
+	ErrInvalidPageToken  = errors.New("invalid page token")
+	ErrInvalidOrderBy    = errors.New("unsupported order_by")
+
+	ErrEventNotOnSale       = errors.New("event is not on sale")
+	ErrEventFinal           = errors.New("event is cancelled or completed")
+	ErrInvalidTransition    = errors.New("event status transition not allowed")
+	ErrEventChanged         = errors.New("event was modified concurrently")
+	ErrBookingCancellations = errors.New("event cancelled, but its bookings could not be cancelled")
+
+	ErrReservationConflict = errors.New("reservation id already used with different parameters")
+	ErrReservationReleased = errors.New("reservation already released")
+	ErrReservationNotFound = errors.New("reservation not found")
+	// Only general admission reservations of at most one ticket type can be
+	// resized; anything else would need seats or a ticket type picked.
+	ErrReservationNotResizable = errors.New("reservation cannot be resized")
+
+	ErrLayoutNotFound    = errors.New("venue layout not found")
+	ErrSeatNotFound      = errors.New("seat not found")
+	ErrSeatUnavailable   = errors.New("one or more seats are not available")
+	ErrNoAssignedSeating = errors.New("event has no assigned seating")
+	ErrSeatingMismatch   = errors.New("seat selection does not match the event's seating")
+
+	ErrTicketTypeNotFound     = errors.New("ticket type not found")
+	ErrTicketTypeNotOnSale    = errors.New("ticket type is not on sale")
+	ErrTicketTypeRequired     = errors.New("event is sold by ticket type")
+	ErrTicketCapacityExceeded = errors.New("ticket type capacity exceeds event capacity")
+
+	ErrVenueNotFound = errors.New("venue not found")
+	// ErrVenueCapacity is returned when an event would have more seats than
+	// its venue holds.
+	ErrVenueCapacity = errors.New("event seats exceed venue capacity")
+	ErrVenueInUse    = errors.New("venue has events")
+
+	ErrOrganizerNotFound = errors.New("organizer not found")
+	ErrOrganizerExists   = errors.New("organizer already exists")
+	ErrOrganizerInUse    = errors.New("organizer has events")
+
+	ErrSeriesNotFound = errors.New("event series not found")
 )
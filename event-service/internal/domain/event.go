@@ -2,11 +2,111 @@ package domain
 
 import "time"
 
+// Event is general admission unless LayoutID is set, in which case its seats
+// are tracked individually.
 type Event struct {
-	ID             string
-	Name           string
+	ID   string
+	Name string
+	EventDetails
 	StartTime      time.Time
 	TotalSeats     int32
 	AvailableSeats int32
-	CreatedAt      time.Time
+	LayoutID       string
+	Status         EventStatus
+	// OrganizerID is the subject of the caller who created the event, and
+	// the ID of its organizer profile if there is one.
+	OrganizerID string
+	// VenueID links the event to a venue, whose name and time zone are kept
+	// in Venue and TimeZone.
+	VenueID string
+	// TimeZone is the IANA time zone of the venue; empty without one.
+	TimeZone string
+	// MaxTicketsPerUser caps the tickets one user may hold across their live
+	// bookings; 0 means no limit.
+	MaxTicketsPerUser int32
+	// QueueAdmissionsPerMinute, when above 0, sends buyers through the
+	// waiting room at that rate.
+	QueueAdmissionsPerMinute int32
+	// SeriesID is set for the occurrences of an event series.
+	SeriesID string
+	// SeatsOverridden marks an occurrence whose total seats were set on
+	// their own; capacity changes to the series leave it alone.
+	SeatsOverridden bool
+	// Version counts the updates of the event's details and settings, so a
+	// change based on a stale read is refused.
+	Version   int32
+	CreatedAt time.Time
+}
+
+// EventDetails describe an event to people looking for one; SearchEvents
+// searches them along with the name.
+type EventDetails struct {
+	Description string
+	// Venue is the name of the place the event is held at.
+	Venue string
+	// Category is lowercase, so events of one category count together.
+	Category string
+	// Tags are lowercase and distinct.
+	Tags []string
+}
+
+// EventUpdate lists the changes to an event's name and details; nil fields
+// are left as they are.
+type EventUpdate struct {
+	Name        *string
+	Description *string
+	Venue       *string
+	Category    *string
+	Tags        *[]string
+	// VenueID links the event to another venue, or unlinks it if empty.
+	VenueID *string
+}
+
+type EventStatus int32
+
+const (
+	EventStatusUnspecified EventStatus = 0
+	EventStatusDraft       EventStatus = 1
+	EventStatusPublished   EventStatus = 2
+	EventStatusSalesClosed EventStatus = 3
+	EventStatusCancelled   EventStatus = 4
+	EventStatusCompleted   EventStatus = 5
+)
+
+// eventTransitions lists the statuses each status may move to. Cancelled and
+// completed events are final.
+var eventTransitions = map[EventStatus][]EventStatus{
+	EventStatusDraft:       {EventStatusPublished, EventStatusCancelled},
+	EventStatusPublished:   {EventStatusSalesClosed, EventStatusCancelled, EventStatusCompleted},
+	EventStatusSalesClosed: {EventStatusPublished, EventStatusCancelled, EventStatusCompleted},
+}
+
+// CanTransition reports whether an event in status s may move to status to.
+func (s EventStatus) CanTransition(to EventStatus) bool {
+	for _, next := range eventTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Final reports whether the event can no longer change.
+func (s EventStatus) Final() bool {
+	return s == EventStatusCancelled || s == EventStatusCompleted
+}
+
+// EventFilter narrows a list of events; zero fields match every event.
+type EventFilter struct {
+	// StartsFrom and StartsBefore bound the start time, inclusive and
+	// exclusive.
+	StartsFrom   time.Time
+	StartsBefore time.Time
+	Status       EventStatus
+	// NameContains matches names case-insensitively.
+	NameContains    string
+	HasAvailability bool
+	OrganizerID     string
+	VenueID         string
+	SeriesID        string
 }
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBookingClient struct {
+	mock.Mock
+}
+
+func (m *MockBookingClient) CancelEventBookings(ctx context.Context, eventID string) (int32, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *MockBookingClient) Close() error {
+	args := m.Called()
+	return args.Error(0)
+}
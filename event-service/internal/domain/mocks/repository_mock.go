@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
 	"github.com/stretchr/testify/mock"
@@ -24,15 +25,230 @@ func (m *MockEventRepository) GetByID(ctx context.Context, id string) (*domain.E
 	return args.Get(0).(*domain.Event), args.Error(1)
 }
 
-func (m *MockEventRepository) List(ctx context.Context, limit, offset int32) ([]*domain.Event, int32, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockEventRepository) List(ctx context.Context, filter domain.EventFilter, order domain.SortOrder, after *domain.PageCursor, limit int32) ([]*domain.Event, error) {
+	args := m.Called(ctx, filter, order, after, limit)
 	if args.Get(0) == nil {
-		return nil, args.Get(1).(int32), args.Error(2)
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Event), args.Error(1)
+}
+
+func (m *MockEventRepository) Search(ctx context.Context, search domain.EventSearch, offset, limit int32) ([]*domain.SearchHit, error) {
+	args := m.Called(ctx, search, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SearchHit), args.Error(1)
+}
+
+func (m *MockEventRepository) SearchFacets(ctx context.Context, search domain.EventSearch) (*domain.SearchFacets, error) {
+	args := m.Called(ctx, search)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*domain.Event), args.Get(1).(int32), args.Error(2)
+	return args.Get(0).(*domain.SearchFacets), args.Error(1)
 }
 
 func (m *MockEventRepository) UpdateAvailableSeats(ctx context.Context, id string, quantity int32) (int32, error) {
 	args := m.Called(ctx, id, quantity)
 	return args.Get(0).(int32), args.Error(1)
 }
+
+func (m *MockEventRepository) Update(ctx context.Context, event *domain.Event) (bool, error) {
+	args := m.Called(ctx, event)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockEventRepository) ReserveSeats(ctx context.Context, reservation *domain.Reservation) (*domain.Reservation, int32, error) {
+	args := m.Called(ctx, reservation)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.Reservation), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventRepository) ReleaseSeats(ctx context.Context, reservationID string, legacy *domain.LegacyHold) (*domain.Reservation, int32, error) {
+	args := m.Called(ctx, reservationID, legacy)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.Reservation), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventRepository) ResizeSeats(ctx context.Context, reservationID string, quantity int32) (*domain.Reservation, int32, error) {
+	args := m.Called(ctx, reservationID, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.Reservation), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventRepository) ListSeats(ctx context.Context, eventID string) ([]*domain.Seat, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Seat), args.Error(1)
+}
+
+func (m *MockEventRepository) SetTotalSeats(ctx context.Context, id string, totalSeats int32) error {
+	args := m.Called(ctx, id, totalSeats)
+	return args.Error(0)
+}
+
+type MockLayoutRepository struct {
+	mock.Mock
+}
+
+func (m *MockLayoutRepository) Create(ctx context.Context, layout *domain.Layout) error {
+	args := m.Called(ctx, layout)
+	return args.Error(0)
+}
+
+func (m *MockLayoutRepository) GetByID(ctx context.Context, id string) (*domain.Layout, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Layout), args.Error(1)
+}
+
+type MockTicketTypeRepository struct {
+	mock.Mock
+}
+
+func (m *MockTicketTypeRepository) Create(ctx context.Context, ticketType *domain.TicketType) error {
+	args := m.Called(ctx, ticketType)
+	return args.Error(0)
+}
+
+func (m *MockTicketTypeRepository) GetByID(ctx context.Context, id string) (*domain.TicketType, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TicketType), args.Error(1)
+}
+
+func (m *MockTicketTypeRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.TicketType, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TicketType), args.Error(1)
+}
+
+func (m *MockTicketTypeRepository) Update(ctx context.Context, ticketType *domain.TicketType) error {
+	args := m.Called(ctx, ticketType)
+	return args.Error(0)
+}
+
+type MockVenueRepository struct {
+	mock.Mock
+}
+
+func (m *MockVenueRepository) Create(ctx context.Context, venue *domain.Venue) error {
+	args := m.Called(ctx, venue)
+	return args.Error(0)
+}
+
+func (m *MockVenueRepository) GetByID(ctx context.Context, id string) (*domain.Venue, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Venue), args.Error(1)
+}
+
+func (m *MockVenueRepository) List(ctx context.Context, filter domain.VenueFilter, after *domain.PageCursor, limit int32) ([]*domain.Venue, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Venue), args.Error(1)
+}
+
+func (m *MockVenueRepository) Update(ctx context.Context, venue *domain.Venue) error {
+	args := m.Called(ctx, venue)
+	return args.Error(0)
+}
+
+func (m *MockVenueRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockOrganizerRepository struct {
+	mock.Mock
+}
+
+func (m *MockOrganizerRepository) Create(ctx context.Context, organizer *domain.Organizer) error {
+	args := m.Called(ctx, organizer)
+	return args.Error(0)
+}
+
+func (m *MockOrganizerRepository) GetByID(ctx context.Context, id string) (*domain.Organizer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organizer), args.Error(1)
+}
+
+func (m *MockOrganizerRepository) List(ctx context.Context, after *domain.PageCursor, limit int32) ([]*domain.Organizer, error) {
+	args := m.Called(ctx, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Organizer), args.Error(1)
+}
+
+func (m *MockOrganizerRepository) Update(ctx context.Context, organizer *domain.Organizer) error {
+	args := m.Called(ctx, organizer)
+	return args.Error(0)
+}
+
+func (m *MockOrganizerRepository) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockSeriesRepository struct {
+	mock.Mock
+}
+
+func (m *MockSeriesRepository) Create(ctx context.Context, series *domain.EventSeries, occurrences []*domain.Event) error {
+	args := m.Called(ctx, series, occurrences)
+	return args.Error(0)
+}
+
+func (m *MockSeriesRepository) GetByID(ctx context.Context, id string) (*domain.EventSeries, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventSeries), args.Error(1)
+}
+
+func (m *MockSeriesRepository) ListDue(ctx context.Context, horizon time.Time, limit int) ([]*domain.EventSeries, error) {
+	args := m.Called(ctx, horizon, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.EventSeries), args.Error(1)
+}
+
+func (m *MockSeriesRepository) AddOccurrences(ctx context.Context, series *domain.EventSeries, previousUntil time.Time, occurrences []*domain.Event) (bool, error) {
+	args := m.Called(ctx, series, previousUntil, occurrences)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockSeriesRepository) SetTotalSeats(ctx context.Context, id string, totalSeats int32, from time.Time) (int32, error) {
+	args := m.Called(ctx, id, totalSeats, from)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *MockSeriesRepository) End(ctx context.Context, id string, until time.Time) error {
+	args := m.Called(ctx, id, until)
+	return args.Error(0)
+}
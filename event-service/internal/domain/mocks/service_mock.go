@@ -12,8 +12,8 @@ type MockEventService struct {
 	mock.Mock
 }
 
-func (m *MockEventService) CreateEvent(ctx context.Context, name string, startTime time.Time, totalSeats int32) (*domain.Event, error) {
-	args := m.Called(ctx, name, startTime, totalSeats)
+func (m *MockEventService) CreateEvent(ctx context.Context, name string, details domain.EventDetails, startTime time.Time, totalSeats int32, layoutID, venueID string) (*domain.Event, error) {
+	args := m.Called(ctx, name, details, startTime, totalSeats, layoutID, venueID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -28,15 +28,257 @@ func (m *MockEventService) GetEvent(ctx context.Context, eventID string) (*domai
 	return args.Get(0).(*domain.Event), args.Error(1)
 }
 
-func (m *MockEventService) ListEvents(ctx context.Context, limit, offset int32) ([]*domain.Event, int32, error) {
-	args := m.Called(ctx, limit, offset)
+func (m *MockEventService) ListEvents(ctx context.Context, filter domain.EventFilter, page domain.PageRequest) ([]*domain.Event, string, error) {
+	args := m.Called(ctx, filter, page)
 	if args.Get(0) == nil {
-		return nil, args.Get(1).(int32), args.Error(2)
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Event), args.String(1), args.Error(2)
+}
+
+func (m *MockEventService) SearchEvents(ctx context.Context, search domain.EventSearch, page domain.PageRequest) (*domain.SearchResults, error) {
+	args := m.Called(ctx, search, page)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
 	}
-	return args.Get(0).([]*domain.Event), args.Get(1).(int32), args.Error(2)
+	return args.Get(0).(*domain.SearchResults), args.Error(1)
 }
 
 func (m *MockEventService) UpdateAvailableTickets(ctx context.Context, eventID string, quantity int32) (int32, error) {
 	args := m.Called(ctx, eventID, quantity)
 	return args.Get(0).(int32), args.Error(1)
 }
+
+func (m *MockEventService) UpdateEvent(ctx context.Context, eventID string, update domain.EventUpdate) (*domain.Event, error) {
+	args := m.Called(ctx, eventID, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Event), args.Error(1)
+}
+
+func (m *MockEventService) RescheduleEvent(ctx context.Context, eventID string, startTime time.Time) (*domain.Event, error) {
+	args := m.Called(ctx, eventID, startTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Event), args.Error(1)
+}
+
+func (m *MockEventService) SetPurchaseLimit(ctx context.Context, eventID string, maxTicketsPerUser int32) (*domain.Event, error) {
+	args := m.Called(ctx, eventID, maxTicketsPerUser)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Event), args.Error(1)
+}
+
+func (m *MockEventService) SetQueueMode(ctx context.Context, eventID string, admissionsPerMinute int32) (*domain.Event, error) {
+	args := m.Called(ctx, eventID, admissionsPerMinute)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Event), args.Error(1)
+}
+
+func (m *MockEventService) SetEventCapacity(ctx context.Context, eventID string, totalSeats int32) (*domain.Event, error) {
+	args := m.Called(ctx, eventID, totalSeats)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Event), args.Error(1)
+}
+
+func (m *MockEventService) UpdateEventStatus(ctx context.Context, eventID string, status domain.EventStatus) (*domain.Event, error) {
+	args := m.Called(ctx, eventID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Event), args.Error(1)
+}
+
+func (m *MockEventService) CancelEvent(ctx context.Context, eventID string) (*domain.Event, int32, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.Event), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventService) ReserveSeats(ctx context.Context, reservationID, eventID string, quantity int32, seatIDs []string, items []*domain.ReservationItem) (*domain.Reservation, int32, error) {
+	args := m.Called(ctx, reservationID, eventID, quantity, seatIDs, items)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.Reservation), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventService) ReleaseSeats(ctx context.Context, reservationID string, legacy *domain.LegacyHold) (*domain.Reservation, int32, error) {
+	args := m.Called(ctx, reservationID, legacy)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.Reservation), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventService) ResizeReservation(ctx context.Context, reservationID string, quantity int32) (*domain.Reservation, int32, error) {
+	args := m.Called(ctx, reservationID, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.Reservation), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventService) GetSeatMap(ctx context.Context, eventID string) (*domain.Event, []*domain.Seat, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.Event), args.Get(1).([]*domain.Seat), args.Error(2)
+}
+
+func (m *MockEventService) CreateLayout(ctx context.Context, name string, seats []*domain.LayoutSeat) (*domain.Layout, error) {
+	args := m.Called(ctx, name, seats)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Layout), args.Error(1)
+}
+
+func (m *MockEventService) GetLayout(ctx context.Context, layoutID string) (*domain.Layout, error) {
+	args := m.Called(ctx, layoutID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Layout), args.Error(1)
+}
+
+func (m *MockEventService) CreateTicketType(ctx context.Context, ticketType *domain.TicketType) (*domain.TicketType, error) {
+	args := m.Called(ctx, ticketType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TicketType), args.Error(1)
+}
+
+func (m *MockEventService) ListTicketTypes(ctx context.Context, eventID string) ([]*domain.TicketType, error) {
+	args := m.Called(ctx, eventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TicketType), args.Error(1)
+}
+
+func (m *MockEventService) UpdateTicketType(ctx context.Context, ticketType *domain.TicketType) (*domain.TicketType, error) {
+	args := m.Called(ctx, ticketType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TicketType), args.Error(1)
+}
+
+func (m *MockEventService) CreateVenue(ctx context.Context, venue *domain.Venue) (*domain.Venue, error) {
+	args := m.Called(ctx, venue)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Venue), args.Error(1)
+}
+
+func (m *MockEventService) GetVenue(ctx context.Context, venueID string) (*domain.Venue, error) {
+	args := m.Called(ctx, venueID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Venue), args.Error(1)
+}
+
+func (m *MockEventService) ListVenues(ctx context.Context, filter domain.VenueFilter, page domain.PageRequest) ([]*domain.Venue, string, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Venue), args.String(1), args.Error(2)
+}
+
+func (m *MockEventService) UpdateVenue(ctx context.Context, venue *domain.Venue) (*domain.Venue, error) {
+	args := m.Called(ctx, venue)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Venue), args.Error(1)
+}
+
+func (m *MockEventService) DeleteVenue(ctx context.Context, venueID string) error {
+	args := m.Called(ctx, venueID)
+	return args.Error(0)
+}
+
+func (m *MockEventService) CreateOrganizer(ctx context.Context, organizer *domain.Organizer) (*domain.Organizer, error) {
+	args := m.Called(ctx, organizer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organizer), args.Error(1)
+}
+
+func (m *MockEventService) GetOrganizer(ctx context.Context, organizerID string) (*domain.Organizer, error) {
+	args := m.Called(ctx, organizerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organizer), args.Error(1)
+}
+
+func (m *MockEventService) ListOrganizers(ctx context.Context, page domain.PageRequest) ([]*domain.Organizer, string, error) {
+	args := m.Called(ctx, page)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]*domain.Organizer), args.String(1), args.Error(2)
+}
+
+func (m *MockEventService) UpdateOrganizer(ctx context.Context, organizer *domain.Organizer) (*domain.Organizer, error) {
+	args := m.Called(ctx, organizer)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organizer), args.Error(1)
+}
+
+func (m *MockEventService) DeleteOrganizer(ctx context.Context, organizerID string) error {
+	args := m.Called(ctx, organizerID)
+	return args.Error(0)
+}
+
+func (m *MockEventService) CreateEventSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error) {
+	args := m.Called(ctx, series)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventSeries), args.Error(1)
+}
+
+func (m *MockEventService) GetEventSeries(ctx context.Context, seriesID string) (*domain.EventSeries, error) {
+	args := m.Called(ctx, seriesID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EventSeries), args.Error(1)
+}
+
+func (m *MockEventService) SetSeriesCapacity(ctx context.Context, seriesID string, totalSeats int32) (*domain.EventSeries, int32, error) {
+	args := m.Called(ctx, seriesID, totalSeats)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Error(2)
+	}
+	return args.Get(0).(*domain.EventSeries), args.Get(1).(int32), args.Error(2)
+}
+
+func (m *MockEventService) CancelEventSeries(ctx context.Context, seriesID string, from time.Time) (*domain.EventSeries, int32, int32, error) {
+	args := m.Called(ctx, seriesID, from)
+	if args.Get(0) == nil {
+		return nil, args.Get(1).(int32), args.Get(2).(int32), args.Error(3)
+	}
+	return args.Get(0).(*domain.EventSeries), args.Get(1).(int32), args.Get(2).(int32), args.Error(3)
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// Organizer is the profile of an account that runs events. Its ID is the
+// subject of that account, the same one events record as their OrganizerID,
+// so an organizer's events are the ones it created.
+type Organizer struct {
+	ID        string
+	Name      string
+	Email     string
+	Phone     string
+	Website   string
+	CreatedAt time.Time
+}
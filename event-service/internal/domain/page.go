@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// PageRequest asks for one page of a list. Token is the next page token of
+// the previous page, empty for the first; OrderBy must be one the list
+// allows.
+type PageRequest struct {
+	Size    int32
+	Token   string
+	OrderBy string
+}
+
+// SortOrder is the column a list is sorted by, with ties broken by ID in the
+// same direction.
+type SortOrder struct {
+	Column string
+	Desc   bool
+}
+
+// PageCursor is the sort key and ID of the last item of a page; the next page
+// starts right after it.
+type PageCursor struct {
+	Key time.Time
+	ID  string
+}
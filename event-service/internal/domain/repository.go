@@ -1,10 +1,104 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type EventRepository interface {
 	Create(ctx context.Context, event *Event) error
 	GetByID(ctx context.Context, id string) (*Event, error)
-	List(ctx context.Context, limit, offset int32) ([]*Event, int32, error)
+	// List returns up to limit events matching filter, in order, starting
+	// after the cursor when there is one.
+	List(ctx context.Context, filter EventFilter, order SortOrder, after *PageCursor, limit int32) ([]*Event, error)
+	// Search returns up to limit events matching the search, best first,
+	// skipping the first offset.
+	Search(ctx context.Context, search EventSearch, offset, limit int32) ([]*SearchHit, error)
+	// SearchFacets counts every event matching the search.
+	SearchFacets(ctx context.Context, search EventSearch) (*SearchFacets, error)
 	UpdateAvailableSeats(ctx context.Context, id string, quantity int32) (int32, error)
+	// Update saves the event's name, details, start time, status and sales
+	// settings if its stored version is still event.Version, and bumps the
+	// version. It reports false when someone else changed the event first.
+	Update(ctx context.Context, event *Event) (bool, error)
+	// ReserveSeats stores the reservation and takes its seats in one
+	// transaction. If the ID already exists, the stored reservation is returned
+	// and nothing changes.
+	ReserveSeats(ctx context.Context, reservation *Reservation) (*Reservation, int32, error)
+	// ReleaseSeats returns a reservation's seats, never above total_seats.
+	// Releasing an unknown ID records it as released so a late reserve is a
+	// no-op; with a legacy hold, the hold's seats are returned along with it.
+	ReleaseSeats(ctx context.Context, reservationID string, legacy *LegacyHold) (*Reservation, int32, error)
+	// ResizeSeats takes or returns seats so a held reservation ends up with
+	// quantity seats. Growing fails as a whole if any seats are short.
+	ResizeSeats(ctx context.Context, reservationID string, quantity int32) (*Reservation, int32, error)
+	ListSeats(ctx context.Context, eventID string) ([]*Seat, error)
+	// SetTotalSeats changes the capacity of an event that is not cancelled
+	// or completed, moving its available seats by as much, and marks an
+	// occurrence of a series as overridden. It fails with
+	// ErrInsufficientSeats if more seats are sold, and with
+	// ErrTicketCapacityExceeded if its ticket types hold more.
+	SetTotalSeats(ctx context.Context, id string, totalSeats int32) error
+}
+
+type TicketTypeRepository interface {
+	Create(ctx context.Context, ticketType *TicketType) error
+	GetByID(ctx context.Context, id string) (*TicketType, error)
+	ListByEventID(ctx context.Context, eventID string) ([]*TicketType, error)
+	Update(ctx context.Context, ticketType *TicketType) error
+}
+
+type LayoutRepository interface {
+	Create(ctx context.Context, layout *Layout) error
+	GetByID(ctx context.Context, id string) (*Layout, error)
+}
+
+type VenueRepository interface {
+	Create(ctx context.Context, venue *Venue) error
+	GetByID(ctx context.Context, id string) (*Venue, error)
+	// List returns up to limit venues matching filter, oldest first,
+	// starting after the cursor when there is one.
+	List(ctx context.Context, filter VenueFilter, after *PageCursor, limit int32) ([]*Venue, error)
+	// Update saves the venue and copies its name and time zone to its
+	// events and series. It fails with ErrVenueCapacity if the capacity is
+	// below the seats of an event at the venue that is not cancelled or
+	// completed, or of an unfinished series, and with ErrVenueNotFound if
+	// the venue is gone.
+	Update(ctx context.Context, venue *Venue) error
+	// Delete fails with ErrVenueInUse while events or series are linked to
+	// the venue.
+	Delete(ctx context.Context, id string) error
+}
+
+type OrganizerRepository interface {
+	// Create fails with ErrOrganizerExists if the ID is taken.
+	Create(ctx context.Context, organizer *Organizer) error
+	GetByID(ctx context.Context, id string) (*Organizer, error)
+	List(ctx context.Context, after *PageCursor, limit int32) ([]*Organizer, error)
+	// Update fails with ErrOrganizerNotFound if the organizer is gone.
+	Update(ctx context.Context, organizer *Organizer) error
+	// Delete fails with ErrOrganizerInUse while the organizer has events.
+	Delete(ctx context.Context, id string) error
+}
+
+type SeriesRepository interface {
+	// Create stores the series along with its first occurrences.
+	Create(ctx context.Context, series *EventSeries, occurrences []*Event) error
+	GetByID(ctx context.Context, id string) (*EventSeries, error)
+	// ListDue returns up to limit unfinished series whose occurrences have
+	// not been created up to horizon, furthest behind first.
+	ListDue(ctx context.Context, horizon time.Time, limit int) ([]*EventSeries, error)
+	// AddOccurrences creates more occurrences and saves the series'
+	// GeneratedUntil and Finished, provided neither they nor its seats or
+	// Until changed since previousUntil was loaded. It reports false
+	// otherwise.
+	AddOccurrences(ctx context.Context, series *EventSeries, previousUntil time.Time, occurrences []*Event) (bool, error)
+	// SetTotalSeats changes the capacity of the series and of its
+	// occurrences starting at or after from, leaving out cancelled,
+	// completed and overridden ones. Either all of them change or, failing
+	// as EventRepository.SetTotalSeats does, none. It returns how many
+	// occurrences changed.
+	SetTotalSeats(ctx context.Context, id string, totalSeats int32, from time.Time) (int32, error)
+	// End stops the series before until, unless it already ends sooner.
+	End(ctx context.Context, id string, until time.Time) error
 }
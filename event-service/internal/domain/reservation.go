@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+type ReservationStatus int32
+
+const (
+	ReservationStatusUnspecified ReservationStatus = 0
+	ReservationStatusReserved    ReservationStatus = 1
+	ReservationStatusReleased    ReservationStatus = 2
+)
+
+// Reservation is a set of seats held on behalf of a caller-chosen ID. Reserving
+// or releasing the same ID twice is a no-op.
+type Reservation struct {
+	ID         string
+	EventID    string
+	Quantity   int32
+	SeatIDs    []string
+	Items      []*ReservationItem
+	Status     ReservationStatus
+	CreatedAt  time.Time
+	ReleasedAt time.Time
+}
+
+// LegacyHold is the seat count a booking made before reservations existed took
+// straight off an event, with no reservation to show for it.
+type LegacyHold struct {
+	EventID  string
+	Quantity int32
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// EventSearch looks for events whose name, description, venue or tags match
+// Query, narrowed by the other fields; their zero values match every event.
+type EventSearch struct {
+	// Query is in web search syntax: words, "quoted phrases", "or" between
+	// alternatives and -word to exclude one.
+	Query    string
+	Category string
+	Venue    string
+	// StartsFrom and StartsBefore bound the start time, inclusive and
+	// exclusive.
+	StartsFrom   time.Time
+	StartsBefore time.Time
+	Status       EventStatus
+	OrganizerID  string
+}
+
+// SearchHit is an event matching a search.
+type SearchHit struct {
+	Event *Event
+	// Rank orders hits, best first, and lies between 0 and 1.
+	Rank float32
+	// NameHighlight and DescriptionHighlight are HTML-escaped, with the
+	// matching words wrapped in <mark> and </mark>. The description is cut
+	// down to the fragments around them.
+	NameHighlight        string
+	DescriptionHighlight string
+}
+
+// FacetCount is how many events matching a search share a value.
+type FacetCount struct {
+	Value string
+	Count int32
+}
+
+// SearchFacets count the events matching a search, leaving out empty values.
+type SearchFacets struct {
+	// Categories and Venues come most common first.
+	Categories []FacetCount
+	Venues     []FacetCount
+	// Months bucket the start times by month, as "2006-01", in order.
+	Months []FacetCount
+}
+
+// SearchResults is a page of hits. Facets cover every page and are only set
+// on the first.
+type SearchResults struct {
+	Hits          []*SearchHit
+	Facets        *SearchFacets
+	NextPageToken string
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+type SeatStatus int32
+
+const (
+	SeatStatusUnspecified SeatStatus = 0
+	SeatStatusAvailable   SeatStatus = 1
+	SeatStatusReserved    SeatStatus = 2
+)
+
+// Layout is a venue's seat plan. Events created from a layout get their own
+// copy of its seats, keyed by the layout seat IDs.
+type Layout struct {
+	ID        string
+	Name      string
+	Seats     []*LayoutSeat
+	CreatedAt time.Time
+}
+
+type LayoutSeat struct {
+	ID      string
+	Section string
+	Row     string
+	Number  int32
+}
+
+// Seat is one seat of an event's inventory.
+type Seat struct {
+	ID            string
+	EventID       string
+	Section       string
+	Row           string
+	Number        int32
+	Status        SeatStatus
+	ReservationID string
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// EventSeries is a show that recurs. Its occurrences are ordinary events,
+// each with its own seats, created for the starts of its recurrence rule up
+// to a horizon that moves forward with time.
+type EventSeries struct {
+	ID   string
+	Name string
+	EventDetails
+	// RRule is an RFC 5545 recurrence rule without DTSTART, such as
+	// "FREQ=DAILY;COUNT=30". It runs from FirstStart in TimeZone, so a show
+	// keeps its local hour across daylight saving changes.
+	RRule      string
+	FirstStart time.Time
+	// TimeZone is the venue's; empty without a venue, when the rule runs in
+	// UTC.
+	TimeZone   string
+	TotalSeats int32
+	LayoutID   string
+	VenueID    string
+	// OrganizerID is the subject of the caller who created the series, and
+	// of its occurrences.
+	OrganizerID string
+	// Publish puts occurrences on sale as they are created instead of
+	// leaving them drafts.
+	Publish bool
+	// Until, when set, ends the series early: nothing starting at or after
+	// it is created.
+	Until time.Time
+	// Occurrences starting before GeneratedUntil have been created.
+	GeneratedUntil time.Time
+	// Finished is set once the rule has no occurrences left to create.
+	Finished  bool
+	CreatedAt time.Time
+}
@@ -6,8 +6,46 @@ import (
 )
 
 type EventService interface {
-	CreateEvent(ctx context.Context, name string, startTime time.Time, totalSeats int32) (*Event, error)
+	CreateEvent(ctx context.Context, name string, details EventDetails, startTime time.Time, totalSeats int32, layoutID, venueID string) (*Event, error)
 	GetEvent(ctx context.Context, eventID string) (*Event, error)
-	ListEvents(ctx context.Context, limit, offset int32) ([]*Event, int32, error)
+	// ListEvents returns a page of events and the token of the next page,
+	// empty on the last one.
+	ListEvents(ctx context.Context, filter EventFilter, page PageRequest) ([]*Event, string, error)
+	// SearchEvents returns a page of events matching the search, best first.
+	// Only page.Size and page.Token apply.
+	SearchEvents(ctx context.Context, search EventSearch, page PageRequest) (*SearchResults, error)
 	UpdateAvailableTickets(ctx context.Context, eventID string, quantity int32) (int32, error)
+	UpdateEvent(ctx context.Context, eventID string, update EventUpdate) (*Event, error)
+	RescheduleEvent(ctx context.Context, eventID string, startTime time.Time) (*Event, error)
+	SetPurchaseLimit(ctx context.Context, eventID string, maxTicketsPerUser int32) (*Event, error)
+	SetQueueMode(ctx context.Context, eventID string, admissionsPerMinute int32) (*Event, error)
+	SetEventCapacity(ctx context.Context, eventID string, totalSeats int32) (*Event, error)
+	UpdateEventStatus(ctx context.Context, eventID string, status EventStatus) (*Event, error)
+	CancelEvent(ctx context.Context, eventID string) (*Event, int32, error)
+	ReserveSeats(ctx context.Context, reservationID, eventID string, quantity int32, seatIDs []string, items []*ReservationItem) (*Reservation, int32, error)
+	ReleaseSeats(ctx context.Context, reservationID string, legacy *LegacyHold) (*Reservation, int32, error)
+	ResizeReservation(ctx context.Context, reservationID string, quantity int32) (*Reservation, int32, error)
+	GetSeatMap(ctx context.Context, eventID string) (*Event, []*Seat, error)
+	CreateLayout(ctx context.Context, name string, seats []*LayoutSeat) (*Layout, error)
+	GetLayout(ctx context.Context, layoutID string) (*Layout, error)
+	CreateTicketType(ctx context.Context, ticketType *TicketType) (*TicketType, error)
+	ListTicketTypes(ctx context.Context, eventID string) ([]*TicketType, error)
+	UpdateTicketType(ctx context.Context, ticketType *TicketType) (*TicketType, error)
+	CreateVenue(ctx context.Context, venue *Venue) (*Venue, error)
+	GetVenue(ctx context.Context, venueID string) (*Venue, error)
+	ListVenues(ctx context.Context, filter VenueFilter, page PageRequest) ([]*Venue, string, error)
+	UpdateVenue(ctx context.Context, venue *Venue) (*Venue, error)
+	DeleteVenue(ctx context.Context, venueID string) error
+	CreateOrganizer(ctx context.Context, organizer *Organizer) (*Organizer, error)
+	GetOrganizer(ctx context.Context, organizerID string) (*Organizer, error)
+	ListOrganizers(ctx context.Context, page PageRequest) ([]*Organizer, string, error)
+	UpdateOrganizer(ctx context.Context, organizer *Organizer) (*Organizer, error)
+	DeleteOrganizer(ctx context.Context, organizerID string) error
+	CreateEventSeries(ctx context.Context, series *EventSeries) (*EventSeries, error)
+	GetEventSeries(ctx context.Context, seriesID string) (*EventSeries, error)
+	// SetSeriesCapacity returns how many occurrences took the new capacity.
+	SetSeriesCapacity(ctx context.Context, seriesID string, totalSeats int32) (*EventSeries, int32, error)
+	// CancelEventSeries returns how many occurrences and bookings it
+	// cancelled.
+	CancelEventSeries(ctx context.Context, seriesID string, from time.Time) (*EventSeries, int32, int32, error)
 }
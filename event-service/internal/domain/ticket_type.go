@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// TicketType is a priced tier of an event, such as GA or VIP. Its capacity is
+// carved out of the event's total seats. Prices are in minor currency units.
+type TicketType struct {
+	ID         string
+	EventID    string
+	Name       string
+	Capacity   int32
+	Available  int32
+	PriceMinor int64
+	Currency   string
+	SalesStart time.Time
+	SalesEnd   time.Time
+	CreatedAt  time.Time
+}
+
+// OnSale reports whether the ticket type can be sold at now. A zero bound
+// leaves that side of the sale window open.
+func (t *TicketType) OnSale(now time.Time) bool {
+	if !t.SalesStart.IsZero() && now.Before(t.SalesStart) {
+		return false
+	}
+	if !t.SalesEnd.IsZero() && !now.Before(t.SalesEnd) {
+		return false
+	}
+	return true
+}
+
+// ReservationItem is the part of a reservation taken from one ticket type. The
+// price is captured when the reservation is made.
+type ReservationItem struct {
+	TicketTypeID   string
+	Quantity       int32
+	UnitPriceMinor int64
+	Currency       string
+}
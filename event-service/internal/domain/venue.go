@@ -0,0 +1,41 @@
+package domain
+
+import "time"
+
+// Venue is a place events are held at. Its name and time zone are copied to
+// the events linked to it, which may not have more seats than its capacity.
+type Venue struct {
+	ID      string
+	Name    string
+	Address Address
+	// Location is nil when the coordinates are unknown.
+	Location *GeoPoint
+	// TimeZone is an IANA time zone name, such as "Europe/Berlin".
+	TimeZone string
+	Capacity int32
+	// OrganizerID is the subject of the caller who created the venue.
+	OrganizerID string
+	CreatedAt   time.Time
+}
+
+type Address struct {
+	Street     string
+	City       string
+	Region     string
+	PostalCode string
+	// CountryCode is an uppercase ISO 3166-1 alpha-2 code.
+	CountryCode string
+}
+
+// GeoPoint is a WGS 84 position in degrees.
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// VenueFilter narrows a list of venues; zero fields match every venue.
+type VenueFilter struct {
+	// City matches case-insensitively.
+	City        string
+	CountryCode string
+}
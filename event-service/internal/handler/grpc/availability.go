@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// availabilityCoalesce is the least time between two counts sent to a
+	// watcher; changes in between are folded into the next one.
+	availabilityCoalesce = 500 * time.Millisecond
+	// availabilityHeartbeat is how long a watcher waits without a change
+	// before the count is sent again, keeping idle connections open.
+	availabilityHeartbeat = 15 * time.Second
+)
+
+// AvailabilityEventsPath serves WatchEventAvailability as Server-Sent Events.
+const AvailabilityEventsPath = "/v1/events/{event_id}/availability"
+
+// WatchEventAvailability sends the event's available seats, then every
+// change to them, as Postgres announces it, until the caller leaves or the
+// server shuts down.
+func (h *EventHandler) WatchEventAvailability(req *pb.WatchEventAvailabilityRequest, stream pb.EventService_WatchEventAvailabilityServer) error {
+	ctx := stream.Context()
+
+	// Subscribing before reading the count means no change in between is
+	// missed.
+	sub := h.availability.Subscribe(req.EventId)
+	defer sub.Close()
+
+	event, err := h.svc.GetEvent(ctx, req.EventId)
+	if err != nil {
+		return getEventError(err)
+	}
+	seats := event.AvailableSeats
+	send := func(heartbeat bool) error {
+		return stream.Send(&pb.EventAvailability{
+			EventId:        event.ID,
+			AvailableSeats: seats,
+			TotalSeats:     event.TotalSeats,
+			Heartbeat:      heartbeat,
+			SentAt:         timestamppb.Now(),
+		})
+	}
+	if err := send(false); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTimer(h.availabilityHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-sub.Done():
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-heartbeat.C:
+			if err := send(true); err != nil {
+				return err
+			}
+		case <-sub.Changed():
+			latest, ok := sub.Latest()
+			if !ok {
+				current, err := h.svc.GetEvent(ctx, req.EventId)
+				if err != nil {
+					return getEventError(err)
+				}
+				latest = current.AvailableSeats
+			}
+			if latest == seats {
+				continue
+			}
+			seats = latest
+			if err := send(false); err != nil {
+				return err
+			}
+
+			coalesce := time.NewTimer(h.availabilityCoalesce)
+			select {
+			case <-ctx.Done():
+				coalesce.Stop()
+				return status.FromContextError(ctx.Err()).Err()
+			case <-coalesce.C:
+			}
+		}
+		heartbeat.Reset(h.availabilityHeartbeat)
+	}
+}
+
+// ServeAvailabilityEvents serves WatchEventAvailability over HTTP as
+// Server-Sent Events, which the gateway cannot do for a streaming RPC. It is
+// registered on the gateway mux, at AvailabilityEventsPath, so the gateway
+// middlewares apply.
+func (h *EventHandler) ServeAvailabilityEvents(mux *runtime.ServeMux) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		stream := &sseStream{ctx: r.Context(), w: w}
+		err := h.WatchEventAvailability(&pb.WatchEventAvailabilityRequest{EventId: pathParams["event_id"]}, stream)
+		if err == nil || r.Context().Err() != nil {
+			return
+		}
+		if !stream.started {
+			runtime.HTTPError(r.Context(), mux, &runtime.JSONPb{}, w, r, err)
+			return
+		}
+		// The status line is gone; the error becomes the last event.
+		body, _ := protojson.Marshal(status.Convert(err).Proto())
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", body)
+		http.NewResponseController(w).Flush()
+	}
+}
+
+// sseStream writes the messages of WatchEventAvailability as Server-Sent
+// Events. Only Context and Send are used by the handler.
+type sseStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	w       http.ResponseWriter
+	started bool
+}
+
+func (s *sseStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseStream) Send(msg *pb.EventAvailability) error {
+	if !s.started {
+		s.started = true
+		s.w.Header().Set("Content-Type", "text/event-stream")
+		s.w.Header().Set("Cache-Control", "no-cache")
+		// Keeps reverse proxies such as nginx from buffering the stream.
+		s.w.Header().Set("X-Accel-Buffering", "no")
+		s.w.WriteHeader(http.StatusOK)
+	}
+
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+	return http.NewResponseController(s.w).Flush()
+}
@@ -0,0 +1,160 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// availabilityStream hands what WatchEventAvailability sends to the test.
+type availabilityStream struct {
+	pb.EventService_WatchEventAvailabilityServer
+	ctx  context.Context
+	sent chan *pb.EventAvailability
+}
+
+func (s *availabilityStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *availabilityStream) Send(msg *pb.EventAvailability) error {
+	s.sent <- msg
+	return nil
+}
+
+// watchAvailability runs WatchEventAvailability for event-1 until the
+// returned cancel is called, which returns the stream's error.
+func watchAvailability(h *EventHandler) (*availabilityStream, func() error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &availabilityStream{ctx: ctx, sent: make(chan *pb.EventAvailability, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- h.WatchEventAvailability(&pb.WatchEventAvailabilityRequest{EventId: "event-1"}, stream)
+	}()
+	return stream, func() error {
+		cancel()
+		return <-done
+	}
+}
+
+func TestWatchEventAvailability_ChangesAndHeartbeats(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	hub := availability.NewHub()
+	h := NewEventHandler(svc, hub)
+	h.availabilityCoalesce = time.Millisecond
+	h.availabilityHeartbeat = 20 * time.Millisecond
+
+	svc.On("GetEvent", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", TotalSeats: 100, AvailableSeats: 50}, nil)
+	stream, stop := watchAvailability(h)
+
+	first := <-stream.sent
+	assert.Equal(t, int32(50), first.AvailableSeats)
+	assert.Equal(t, int32(100), first.TotalSeats)
+	assert.False(t, first.Heartbeat)
+
+	hub.Publish("event-1", 48)
+	changed := <-stream.sent
+	assert.Equal(t, int32(48), changed.AvailableSeats)
+	assert.False(t, changed.Heartbeat)
+
+	heartbeat := <-stream.sent
+	assert.Equal(t, int32(48), heartbeat.AvailableSeats)
+	assert.True(t, heartbeat.Heartbeat)
+
+	assert.Equal(t, codes.Canceled, status.Code(stop()))
+	assert.Zero(t, hub.Watchers())
+}
+
+func TestWatchEventAvailability_ResyncReadsDatabase(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	hub := availability.NewHub()
+	h := NewEventHandler(svc, hub)
+
+	svc.On("GetEvent", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", AvailableSeats: 50}, nil).Once()
+	svc.On("GetEvent", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", AvailableSeats: 45}, nil).Once()
+	stream, stop := watchAvailability(h)
+
+	<-stream.sent
+	hub.Resync()
+	assert.Equal(t, int32(45), (<-stream.sent).AvailableSeats)
+
+	stop()
+	svc.AssertExpectations(t)
+}
+
+func TestWatchEventAvailability_NotFound(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	h := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("GetEvent", mock.Anything, "event-1").Return(nil, domain.ErrEventNotFound)
+	stream, stop := watchAvailability(h)
+
+	assert.Equal(t, codes.NotFound, status.Code(stop()))
+	assert.Empty(t, stream.sent)
+}
+
+// serveAvailabilityEvents requests the Server-Sent Events of event-1 through
+// a gateway mux.
+func serveAvailabilityEvents(h *EventHandler) *httptest.ResponseRecorder {
+	mux := runtime.NewServeMux()
+	mux.HandlePath(http.MethodGet, AvailabilityEventsPath, h.ServeAvailabilityEvents(mux))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/events/event-1/availability", nil))
+	return rec
+}
+
+func TestServeAvailabilityEvents(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	hub := availability.NewHub()
+	h := NewEventHandler(svc, hub)
+
+	svc.On("GetEvent", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", TotalSeats: 100, AvailableSeats: 50}, nil)
+	// A closed hub ends the stream right after the first count.
+	hub.Close()
+
+	rec := serveAvailabilityEvents(h)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	events := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n\n"), "\n\n")
+	if assert.Len(t, events, 2) {
+		msg := &pb.EventAvailability{}
+		assert.NoError(t, protojson.Unmarshal([]byte(strings.TrimPrefix(events[0], "data: ")), msg))
+		assert.Equal(t, int32(50), msg.AvailableSeats)
+		assert.Equal(t, int32(100), msg.TotalSeats)
+
+		name, data, _ := strings.Cut(events[1], "\n")
+		assert.Equal(t, "event: error", name)
+		st := &statuspb.Status{}
+		assert.NoError(t, protojson.Unmarshal([]byte(strings.TrimPrefix(data, "data: ")), st))
+		assert.Equal(t, int32(codes.Unavailable), st.Code)
+	}
+}
+
+func TestServeAvailabilityEvents_NotFound(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	h := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("GetEvent", mock.Anything, "event-1").Return(nil, domain.ErrEventNotFound)
+
+	rec := serveAvailabilityEvents(h)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+}
@@ -3,21 +3,45 @@ package grpc
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
 	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// ErrorInfo reasons attached to FailedPrecondition so callers can tell a taken
+// seat, a closed ticket type or an event that is not on sale apart from the
+// event lacking capacity.
+const (
+	ReasonSeatUnavailable     = "SEAT_UNAVAILABLE"
+	ReasonTicketTypeNotOnSale = "TICKET_TYPE_NOT_ON_SALE"
+	ReasonEventNotOnSale      = "EVENT_NOT_ON_SALE"
+)
+
 type EventHandler struct {
 	pb.UnimplementedEventServiceServer
-	svc domain.EventService
+	svc          domain.EventService
+	availability *availability.Hub
+
+	availabilityCoalesce  time.Duration
+	availabilityHeartbeat time.Duration
 }
 
-func NewEventHandler(svc domain.EventService) *EventHandler {
-	return &EventHandler{svc: svc}
+func NewEventHandler(svc domain.EventService, hub *availability.Hub) *EventHandler {
+	return &EventHandler{
+		svc:                   svc,
+		availability:          hub,
+		availabilityCoalesce:  availabilityCoalesce,
+		availabilityHeartbeat: availabilityHeartbeat,
+	}
 }
 
 func (h *EventHandler) CreateEvent(ctx context.Context, req *pb.CreateEventRequest) (*pb.CreateEventResponse, error) {
@@ -25,11 +49,26 @@ func (h *EventHandler) CreateEvent(ctx context.Context, req *pb.CreateEventReque
 		return nil, status.Error(codes.InvalidArgument, "start_time is required")
 	}
 
-	event, err := h.svc.CreateEvent(ctx, req.Name, req.StartTime.AsTime(), req.TotalSeats)
+	details := domain.EventDetails{
+		Description: req.Description,
+		Venue:       req.Venue,
+		Category:    req.Category,
+		Tags:        req.Tags,
+	}
+	event, err := h.svc.CreateEvent(ctx, req.Name, details, req.StartTime.AsTime(), req.TotalSeats, req.LayoutId, req.VenueId)
 	if err != nil {
 		if errors.Is(err, domain.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, domain.ErrLayoutNotFound) || errors.Is(err, domain.ErrVenueNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrVenueCapacity) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to create event")
 	}
 
@@ -41,13 +80,7 @@ func (h *EventHandler) CreateEvent(ctx context.Context, req *pb.CreateEventReque
 func (h *EventHandler) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.GetEventResponse, error) {
 	event, err := h.svc.GetEvent(ctx, req.EventId)
 	if err != nil {
-		if errors.Is(err, domain.ErrEventNotFound) {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		if errors.Is(err, domain.ErrInvalidInput) {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		}
-		return nil, status.Error(codes.Internal, "failed to get event")
+		return nil, getEventError(err)
 	}
 
 	return &pb.GetEventResponse{
@@ -55,9 +88,44 @@ func (h *EventHandler) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*
 	}, nil
 }
 
+func getEventError(err error) error {
+	if errors.Is(err, domain.ErrEventNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, "failed to get event")
+}
+
 func (h *EventHandler) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
-	events, totalCount, err := h.svc.ListEvents(ctx, req.Limit, req.Offset)
+	filter := domain.EventFilter{
+		Status:          domain.EventStatus(req.Status),
+		NameContains:    req.Name,
+		HasAvailability: req.HasAvailability,
+		OrganizerID:     req.OrganizerId,
+		VenueID:         req.VenueId,
+		SeriesID:        req.SeriesId,
+	}
+	if req.StartsFrom != nil {
+		filter.StartsFrom = req.StartsFrom.AsTime()
+	}
+	if req.StartsBefore != nil {
+		filter.StartsBefore = req.StartsBefore.AsTime()
+	}
+
+	events, nextPageToken, err := h.svc.ListEvents(ctx, filter, domain.PageRequest{
+		Size:    req.Limit,
+		Token:   req.PageToken,
+		OrderBy: req.OrderBy,
+	})
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrInvalidPageToken) || errors.Is(err, domain.ErrInvalidOrderBy) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to list events")
 	}
 
@@ -67,8 +135,8 @@ func (h *EventHandler) ListEvents(ctx context.Context, req *pb.ListEventsRequest
 	}
 
 	return &pb.ListEventsResponse{
-		Events:     pbEvents,
-		TotalCount: totalCount,
+		Events:        pbEvents,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
@@ -90,6 +158,9 @@ func (h *EventHandler) UpdateAvailableTickets(ctx context.Context, req *pb.Updat
 		if errors.Is(err, domain.ErrInvalidInput) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, domain.ErrSeatingMismatch) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "failed to update tickets")
 	}
 
@@ -99,13 +170,562 @@ func (h *EventHandler) UpdateAvailableTickets(ctx context.Context, req *pb.Updat
 	}, nil
 }
 
+func (h *EventHandler) ReserveSeats(ctx context.Context, req *pb.ReserveSeatsRequest) (*pb.ReserveSeatsResponse, error) {
+	items := make([]*domain.ReservationItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = &domain.ReservationItem{
+			TicketTypeID: item.GetTicketTypeId(),
+			Quantity:     item.GetQuantity(),
+		}
+	}
+
+	reservation, available, err := h.svc.ReserveSeats(ctx, req.ReservationId, req.EventId, req.Quantity, req.SeatIds, items)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) ||
+			errors.Is(err, domain.ErrSeatNotFound) ||
+			errors.Is(err, domain.ErrSeatingMismatch) ||
+			errors.Is(err, domain.ErrTicketTypeNotFound) ||
+			errors.Is(err, domain.ErrTicketTypeRequired) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInsufficientSeats) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrSeatUnavailable) {
+			return nil, failedPrecondition(err, ReasonSeatUnavailable)
+		}
+		if errors.Is(err, domain.ErrTicketTypeNotOnSale) {
+			return nil, failedPrecondition(err, ReasonTicketTypeNotOnSale)
+		}
+		if errors.Is(err, domain.ErrEventNotOnSale) {
+			return nil, failedPrecondition(err, ReasonEventNotOnSale)
+		}
+		if errors.Is(err, domain.ErrReservationConflict) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, domain.ErrReservationReleased) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to reserve seats")
+	}
+
+	return &pb.ReserveSeatsResponse{
+		Reservation:    toProtoReservation(reservation),
+		AvailableSeats: available,
+	}, nil
+}
+
+func (h *EventHandler) ReleaseSeats(ctx context.Context, req *pb.ReleaseSeatsRequest) (*pb.ReleaseSeatsResponse, error) {
+	var legacy *domain.LegacyHold
+	if req.Legacy != nil {
+		legacy = &domain.LegacyHold{EventID: req.Legacy.EventId, Quantity: req.Legacy.Quantity}
+	}
+	reservation, available, err := h.svc.ReleaseSeats(ctx, req.ReservationId, legacy)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to release seats")
+	}
+
+	return &pb.ReleaseSeatsResponse{
+		Reservation:    toProtoReservation(reservation),
+		AvailableSeats: available,
+	}, nil
+}
+
+func (h *EventHandler) ResizeReservation(ctx context.Context, req *pb.ResizeReservationRequest) (*pb.ResizeReservationResponse, error) {
+	reservation, available, err := h.svc.ResizeReservation(ctx, req.ReservationId, req.Quantity)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrReservationNotResizable) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrReservationNotFound) ||
+			errors.Is(err, domain.ErrEventNotFound) ||
+			errors.Is(err, domain.ErrTicketTypeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInsufficientSeats) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrTicketTypeNotOnSale) {
+			return nil, failedPrecondition(err, ReasonTicketTypeNotOnSale)
+		}
+		if errors.Is(err, domain.ErrEventNotOnSale) {
+			return nil, failedPrecondition(err, ReasonEventNotOnSale)
+		}
+		if errors.Is(err, domain.ErrReservationReleased) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to resize reservation")
+	}
+
+	return &pb.ResizeReservationResponse{
+		Reservation:    toProtoReservation(reservation),
+		AvailableSeats: available,
+	}, nil
+}
+
+func (h *EventHandler) GetSeatMap(ctx context.Context, req *pb.GetSeatMapRequest) (*pb.GetSeatMapResponse, error) {
+	event, seats, err := h.svc.GetSeatMap(ctx, req.EventId)
+	if err != nil {
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrNoAssignedSeating) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get seat map")
+	}
+
+	pbSeats := make([]*pb.Seat, len(seats))
+	for i, s := range seats {
+		pbSeats[i] = &pb.Seat{
+			Id:      s.ID,
+			Section: s.Section,
+			Row:     s.Row,
+			Number:  s.Number,
+			Status:  pb.SeatStatus(s.Status),
+		}
+	}
+
+	return &pb.GetSeatMapResponse{
+		EventId:        event.ID,
+		LayoutId:       event.LayoutID,
+		Seats:          pbSeats,
+		AvailableSeats: event.AvailableSeats,
+	}, nil
+}
+
+func (h *EventHandler) CreateVenueLayout(ctx context.Context, req *pb.CreateVenueLayoutRequest) (*pb.CreateVenueLayoutResponse, error) {
+	seats := make([]*domain.LayoutSeat, len(req.Seats))
+	for i, s := range req.Seats {
+		seats[i] = &domain.LayoutSeat{
+			Section: s.GetSection(),
+			Row:     s.GetRow(),
+			Number:  s.GetNumber(),
+		}
+	}
+
+	layout, err := h.svc.CreateLayout(ctx, req.Name, seats)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to create layout")
+	}
+
+	return &pb.CreateVenueLayoutResponse{
+		Layout: toProtoLayout(layout),
+	}, nil
+}
+
+func (h *EventHandler) GetVenueLayout(ctx context.Context, req *pb.GetVenueLayoutRequest) (*pb.GetVenueLayoutResponse, error) {
+	layout, err := h.svc.GetLayout(ctx, req.LayoutId)
+	if err != nil {
+		if errors.Is(err, domain.ErrLayoutNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to get layout")
+	}
+
+	return &pb.GetVenueLayoutResponse{
+		Layout: toProtoLayout(layout),
+	}, nil
+}
+
+func (h *EventHandler) CreateTicketType(ctx context.Context, req *pb.CreateTicketTypeRequest) (*pb.CreateTicketTypeResponse, error) {
+	ticketType, err := h.svc.CreateTicketType(ctx, &domain.TicketType{
+		EventID:    req.EventId,
+		Name:       req.Name,
+		Capacity:   req.Capacity,
+		PriceMinor: req.PriceMinor,
+		Currency:   req.Currency,
+		SalesStart: timeOrZero(req.SalesStart),
+		SalesEnd:   timeOrZero(req.SalesEnd),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrTicketCapacityExceeded) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to create ticket type")
+	}
+
+	return &pb.CreateTicketTypeResponse{
+		TicketType: toProtoTicketType(ticketType),
+	}, nil
+}
+
+func (h *EventHandler) ListTicketTypes(ctx context.Context, req *pb.ListTicketTypesRequest) (*pb.ListTicketTypesResponse, error) {
+	ticketTypes, err := h.svc.ListTicketTypes(ctx, req.EventId)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrEventNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to list ticket types")
+	}
+
+	pbTicketTypes := make([]*pb.TicketType, len(ticketTypes))
+	for i, t := range ticketTypes {
+		pbTicketTypes[i] = toProtoTicketType(t)
+	}
+
+	return &pb.ListTicketTypesResponse{
+		TicketTypes: pbTicketTypes,
+	}, nil
+}
+
+func (h *EventHandler) UpdateTicketType(ctx context.Context, req *pb.UpdateTicketTypeRequest) (*pb.UpdateTicketTypeResponse, error) {
+	ticketType, err := h.svc.UpdateTicketType(ctx, &domain.TicketType{
+		ID:         req.TicketTypeId,
+		Name:       req.Name,
+		PriceMinor: req.PriceMinor,
+		SalesStart: timeOrZero(req.SalesStart),
+		SalesEnd:   timeOrZero(req.SalesEnd),
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrTicketTypeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to update ticket type")
+	}
+
+	return &pb.UpdateTicketTypeResponse{
+		TicketType: toProtoTicketType(ticketType),
+	}, nil
+}
+
+func (h *EventHandler) UpdateEvent(ctx context.Context, req *pb.UpdateEventRequest) (*pb.UpdateEventResponse, error) {
+	update, err := eventUpdate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := h.svc.UpdateEvent(ctx, req.EventId, update)
+	if err != nil {
+		return nil, eventChangeError(err, "failed to update event")
+	}
+
+	return &pb.UpdateEventResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+// eventUpdate takes the fields in the request's update mask, or the ones set
+// when there is none.
+func eventUpdate(req *pb.UpdateEventRequest) (domain.EventUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		for path, set := range map[string]bool{
+			"name":        req.Name != "",
+			"description": req.Description != "",
+			"venue":       req.Venue != "",
+			"category":    req.Category != "",
+			"tags":        len(req.Tags) > 0,
+			"venue_id":    req.VenueId != "",
+		} {
+			if set {
+				paths = append(paths, path)
+			}
+		}
+	}
+
+	var update domain.EventUpdate
+	for _, path := range paths {
+		switch path {
+		case "name":
+			update.Name = &req.Name
+		case "description":
+			update.Description = &req.Description
+		case "venue":
+			update.Venue = &req.Venue
+		case "category":
+			update.Category = &req.Category
+		case "tags":
+			update.Tags = &req.Tags
+		case "venue_id":
+			update.VenueID = &req.VenueId
+		default:
+			return domain.EventUpdate{}, status.Errorf(codes.InvalidArgument, "cannot update %q", path)
+		}
+	}
+	return update, nil
+}
+
+func (h *EventHandler) RescheduleEvent(ctx context.Context, req *pb.RescheduleEventRequest) (*pb.RescheduleEventResponse, error) {
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time is required")
+	}
+
+	event, err := h.svc.RescheduleEvent(ctx, req.EventId, req.StartTime.AsTime())
+	if err != nil {
+		return nil, eventChangeError(err, "failed to reschedule event")
+	}
+
+	return &pb.RescheduleEventResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+func (h *EventHandler) SetPurchaseLimit(ctx context.Context, req *pb.SetPurchaseLimitRequest) (*pb.SetPurchaseLimitResponse, error) {
+	event, err := h.svc.SetPurchaseLimit(ctx, req.EventId, req.MaxTicketsPerUser)
+	if err != nil {
+		return nil, eventChangeError(err, "failed to set purchase limit")
+	}
+
+	return &pb.SetPurchaseLimitResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+func (h *EventHandler) SetQueueMode(ctx context.Context, req *pb.SetQueueModeRequest) (*pb.SetQueueModeResponse, error) {
+	event, err := h.svc.SetQueueMode(ctx, req.EventId, req.QueueAdmissionsPerMinute)
+	if err != nil {
+		return nil, eventChangeError(err, "failed to set queue mode")
+	}
+
+	return &pb.SetQueueModeResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+func (h *EventHandler) SetEventCapacity(ctx context.Context, req *pb.SetEventCapacityRequest) (*pb.SetEventCapacityResponse, error) {
+	event, err := h.svc.SetEventCapacity(ctx, req.EventId, req.TotalSeats)
+	if err != nil {
+		if errors.Is(err, domain.ErrInsufficientSeats) ||
+			errors.Is(err, domain.ErrTicketCapacityExceeded) ||
+			errors.Is(err, domain.ErrSeatingMismatch) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, eventChangeError(err, "failed to set event capacity")
+	}
+
+	return &pb.SetEventCapacityResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+func (h *EventHandler) UpdateEventStatus(ctx context.Context, req *pb.UpdateEventStatusRequest) (*pb.UpdateEventStatusResponse, error) {
+	event, err := h.svc.UpdateEventStatus(ctx, req.EventId, domain.EventStatus(req.Status))
+	if err != nil {
+		return nil, eventChangeError(err, "failed to update event status")
+	}
+
+	return &pb.UpdateEventStatusResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+func (h *EventHandler) CancelEvent(ctx context.Context, req *pb.CancelEventRequest) (*pb.CancelEventResponse, error) {
+	event, cancelled, err := h.svc.CancelEvent(ctx, req.EventId)
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingCancellations) {
+			return nil, bookingCancellationsError(ctx, err)
+		}
+		return nil, eventChangeError(err, "failed to cancel event")
+	}
+
+	return &pb.CancelEventResponse{
+		Event:             toProtoEvent(event),
+		CancelledBookings: cancelled,
+	}, nil
+}
+
+// bookingCancellationsError maps a failure to cancel the bookings of a
+// cancelled event. The event stays cancelled, and retrying finishes the
+// bookings unless booking-service turned the call down for good, as with
+// missing credentials, which needs fixing first.
+func bookingCancellationsError(ctx context.Context, err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied, codes.Unauthenticated, codes.Unimplemented:
+		logger.FromContext(ctx).Error("Booking Service rejected cancelling bookings", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+	logger.FromContext(ctx).Warn("Cancelling bookings failed", zap.Error(err))
+	return status.Error(codes.Unavailable, err.Error())
+}
+
+// eventChangeError maps the errors shared by the event lifecycle RPCs.
+func eventChangeError(err error, internalMsg string) error {
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrEventNotFound) || errors.Is(err, domain.ErrVenueNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrEventFinal) || errors.Is(err, domain.ErrInvalidTransition) || errors.Is(err, domain.ErrVenueCapacity) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, domain.ErrEventChanged) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	if errors.Is(err, domain.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, internalMsg)
+}
+
+func failedPrecondition(err error, reason string) error {
+	st, detailErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: "event-service",
+	})
+	if detailErr != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return st.Err()
+}
+
+func toProtoLayout(l *domain.Layout) *pb.VenueLayout {
+	seats := make([]*pb.LayoutSeat, len(l.Seats))
+	for i, s := range l.Seats {
+		seats[i] = &pb.LayoutSeat{
+			Id:      s.ID,
+			Section: s.Section,
+			Row:     s.Row,
+			Number:  s.Number,
+		}
+	}
+
+	return &pb.VenueLayout{
+		Id:        l.ID,
+		Name:      l.Name,
+		Seats:     seats,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+	}
+}
+
+func toProtoTicketType(t *domain.TicketType) *pb.TicketType {
+	return &pb.TicketType{
+		Id:         t.ID,
+		EventId:    t.EventID,
+		Name:       t.Name,
+		Capacity:   t.Capacity,
+		Available:  t.Available,
+		PriceMinor: t.PriceMinor,
+		Currency:   t.Currency,
+		SalesStart: timestampOrNil(t.SalesStart),
+		SalesEnd:   timestampOrNil(t.SalesEnd),
+		CreatedAt:  timestamppb.New(t.CreatedAt),
+	}
+}
+
+func toProtoReservation(r *domain.Reservation) *pb.Reservation {
+	reservation := &pb.Reservation{
+		Id:        r.ID,
+		EventId:   r.EventID,
+		Quantity:  r.Quantity,
+		SeatIds:   r.SeatIDs,
+		Status:    pb.ReservationStatus(r.Status),
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+	for _, item := range r.Items {
+		reservation.Items = append(reservation.Items, &pb.ReservationItem{
+			TicketTypeId:   item.TicketTypeID,
+			Quantity:       item.Quantity,
+			UnitPriceMinor: item.UnitPriceMinor,
+			Currency:       item.Currency,
+		})
+	}
+	if !r.ReleasedAt.IsZero() {
+		reservation.ReleasedAt = timestamppb.New(r.ReleasedAt)
+	}
+	return reservation
+}
+
 func toProtoEvent(e *domain.Event) *pb.Event {
 	return &pb.Event{
-		Id:             e.ID,
-		Name:           e.Name,
-		StartTime:      timestamppb.New(e.StartTime),
-		TotalSeats:     e.TotalSeats,
-		AvailableSeats: e.AvailableSeats,
-		CreatedAt:      timestamppb.New(e.CreatedAt),
+		Id:                       e.ID,
+		Name:                     e.Name,
+		StartTime:                timestamppb.New(e.StartTime),
+		TotalSeats:               e.TotalSeats,
+		AvailableSeats:           e.AvailableSeats,
+		CreatedAt:                timestamppb.New(e.CreatedAt),
+		LayoutId:                 e.LayoutID,
+		Status:                   pb.EventStatus(e.Status),
+		OrganizerId:              e.OrganizerID,
+		MaxTicketsPerUser:        e.MaxTicketsPerUser,
+		QueueAdmissionsPerMinute: e.QueueAdmissionsPerMinute,
+		Description:              e.Description,
+		Venue:                    e.Venue,
+		Category:                 e.Category,
+		Tags:                     e.Tags,
+		VenueId:                  e.VenueID,
+		TimeZone:                 e.TimeZone,
+		LocalStartTime:           localStartTime(e),
+		SeriesId:                 e.SeriesID,
+		SeatsOverridden:          e.SeatsOverridden,
+	}
+}
+
+// localStartTime renders the event's start time in its venue's time zone, or
+// in UTC without one.
+func localStartTime(e *domain.Event) string {
+	start := e.StartTime.UTC()
+	if e.TimeZone != "" {
+		if loc, err := loadLocation(e.TimeZone); err == nil {
+			start = start.In(loc)
+		}
+	}
+	return start.Format(time.RFC3339)
+}
+
+// locations caches the time zones loaded by name; there are only a few
+// hundred.
+var locations sync.Map
+
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timeOrZero(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
 	}
+	return ts.AsTime()
 }
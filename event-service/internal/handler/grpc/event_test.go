@@ -2,22 +2,27 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/client"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
 	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestCreateEvent_Success(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	startTime := time.Now().Add(24 * time.Hour)
 	expected := &domain.Event{
@@ -26,12 +31,16 @@ func TestCreateEvent_Success(t *testing.T) {
 		StartTime:  startTime,
 		TotalSeats: 100,
 	}
-	svc.On("CreateEvent", mock.Anything, "Concert", mock.AnythingOfType("time.Time"), int32(100)).Return(expected, nil)
+	details := domain.EventDetails{Venue: "Town Hall", Category: "music", Tags: []string{"jazz"}}
+	svc.On("CreateEvent", mock.Anything, "Concert", details, mock.AnythingOfType("time.Time"), int32(100), "", "").Return(expected, nil)
 
 	resp, err := handler.CreateEvent(context.Background(), &pb.CreateEventRequest{
 		Name:       "Concert",
 		StartTime:  timestamppb.New(startTime),
 		TotalSeats: 100,
+		Venue:      "Town Hall",
+		Category:   "music",
+		Tags:       []string{"jazz"},
 	})
 
 	assert.NoError(t, err)
@@ -41,7 +50,7 @@ func TestCreateEvent_Success(t *testing.T) {
 
 func TestCreateEvent_NilStartTime(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	_, err := handler.CreateEvent(context.Background(), &pb.CreateEventRequest{
 		Name:       "Concert",
@@ -56,9 +65,9 @@ func TestCreateEvent_NilStartTime(t *testing.T) {
 
 func TestCreateEvent_InvalidInput(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
-	svc.On("CreateEvent", mock.Anything, "", mock.AnythingOfType("time.Time"), int32(100)).Return(nil, domain.ErrInvalidInput)
+	svc.On("CreateEvent", mock.Anything, "", domain.EventDetails{}, mock.AnythingOfType("time.Time"), int32(100), "", "").Return(nil, domain.ErrInvalidInput)
 
 	startTime := time.Now().Add(24 * time.Hour)
 	_, err := handler.CreateEvent(context.Background(), &pb.CreateEventRequest{
@@ -75,7 +84,7 @@ func TestCreateEvent_InvalidInput(t *testing.T) {
 
 func TestGetEvent_Success(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	now := time.Now()
 	expected := &domain.Event{
@@ -102,7 +111,7 @@ func TestGetEvent_Success(t *testing.T) {
 
 func TestGetEvent_NotFound(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	svc.On("GetEvent", mock.Anything, "nonexistent").Return(nil, domain.ErrEventNotFound)
 
@@ -118,29 +127,61 @@ func TestGetEvent_NotFound(t *testing.T) {
 
 func TestListEvents_Success(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	now := time.Now()
 	events := []*domain.Event{
 		{ID: "1", Name: "Concert", StartTime: now, CreatedAt: now},
 		{ID: "2", Name: "Theater", StartTime: now, CreatedAt: now},
 	}
-	svc.On("ListEvents", mock.Anything, int32(10), int32(0)).Return(events, int32(2), nil)
+	svc.On("ListEvents", mock.Anything, domain.EventFilter{
+		StartsFrom:      now.Truncate(time.Second).UTC(),
+		Status:          domain.EventStatusPublished,
+		NameContains:    "con",
+		HasAvailability: true,
+	}, domain.PageRequest{Size: 10, Token: "token-1", OrderBy: "start_time desc"}).Return(events, "token-2", nil)
 
 	resp, err := handler.ListEvents(context.Background(), &pb.ListEventsRequest{
-		Limit:  10,
-		Offset: 0,
+		Limit:           10,
+		PageToken:       "token-1",
+		StartsFrom:      timestamppb.New(now.Truncate(time.Second)),
+		Status:          pb.EventStatus_EVENT_STATUS_PUBLISHED,
+		Name:            "con",
+		HasAvailability: true,
+		OrderBy:         "start_time desc",
 	})
 
 	assert.NoError(t, err)
 	assert.Len(t, resp.Events, 2)
-	assert.Equal(t, int32(2), resp.TotalCount)
+	assert.Equal(t, "token-2", resp.NextPageToken)
 	svc.AssertExpectations(t)
 }
 
+func TestListEvents_InvalidArguments(t *testing.T) {
+	for _, err := range []error{domain.ErrInvalidInput, domain.ErrInvalidPageToken, domain.ErrInvalidOrderBy} {
+		svc := new(mocks.MockEventService)
+		handler := NewEventHandler(svc, availability.NewHub())
+		svc.On("ListEvents", mock.Anything, mock.Anything, mock.Anything).Return(nil, "", err)
+
+		_, got := handler.ListEvents(context.Background(), &pb.ListEventsRequest{OrderBy: "name"})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(got), err.Error())
+	}
+}
+
+func TestListEvents_StatusDenied(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+	svc.On("ListEvents", mock.Anything, domain.EventFilter{Status: domain.EventStatusDraft}, mock.Anything).Return(nil, "", domain.ErrPermissionDenied)
+
+	_, err := handler.ListEvents(context.Background(), &pb.ListEventsRequest{Status: pb.EventStatus_EVENT_STATUS_DRAFT})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
 func TestUpdateAvailableTickets_Success(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	svc.On("UpdateAvailableTickets", mock.Anything, "event-1", int32(2)).Return(int32(48), nil)
 
@@ -157,7 +198,7 @@ func TestUpdateAvailableTickets_Success(t *testing.T) {
 
 func TestUpdateAvailableTickets_NotFound(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	svc.On("UpdateAvailableTickets", mock.Anything, "nonexistent", int32(2)).Return(int32(0), domain.ErrEventNotFound)
 
@@ -174,7 +215,7 @@ func TestUpdateAvailableTickets_NotFound(t *testing.T) {
 
 func TestUpdateAvailableTickets_InsufficientSeats(t *testing.T) {
 	svc := new(mocks.MockEventService)
-	handler := NewEventHandler(svc)
+	handler := NewEventHandler(svc, availability.NewHub())
 
 	svc.On("UpdateAvailableTickets", mock.Anything, "event-1", int32(100)).Return(int32(0), domain.ErrInsufficientSeats)
 
@@ -187,3 +228,462 @@ func TestUpdateAvailableTickets_InsufficientSeats(t *testing.T) {
 	assert.False(t, resp.Success)
 	svc.AssertExpectations(t)
 }
+
+func TestReserveSeats_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("ReserveSeats", mock.Anything, "res-1", "event-1", int32(2), []string(nil), []*domain.ReservationItem{}).Return(&domain.Reservation{
+		ID:        "res-1",
+		EventID:   "event-1",
+		Quantity:  2,
+		Status:    domain.ReservationStatusReserved,
+		CreatedAt: time.Now(),
+	}, int32(48), nil)
+
+	resp, err := handler.ReserveSeats(context.Background(), &pb.ReserveSeatsRequest{
+		ReservationId: "res-1",
+		EventId:       "event-1",
+		Quantity:      2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "res-1", resp.Reservation.Id)
+	assert.Equal(t, pb.ReservationStatus_RESERVATION_STATUS_RESERVED, resp.Reservation.Status)
+	assert.Equal(t, int32(48), resp.AvailableSeats)
+}
+
+func TestReserveSeats_ErrorCodes(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{domain.ErrInvalidInput, codes.InvalidArgument},
+		{domain.ErrEventNotFound, codes.NotFound},
+		{domain.ErrInsufficientSeats, codes.FailedPrecondition},
+		{domain.ErrReservationConflict, codes.AlreadyExists},
+		{domain.ErrReservationReleased, codes.Aborted},
+	}
+
+	for _, tc := range cases {
+		svc := new(mocks.MockEventService)
+		handler := NewEventHandler(svc, availability.NewHub())
+		svc.On("ReserveSeats", mock.Anything, "res-1", "event-1", int32(2), []string(nil), []*domain.ReservationItem{}).Return(nil, int32(0), tc.err)
+
+		_, err := handler.ReserveSeats(context.Background(), &pb.ReserveSeatsRequest{
+			ReservationId: "res-1",
+			EventId:       "event-1",
+			Quantity:      2,
+		})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, tc.code, st.Code(), tc.err.Error())
+	}
+}
+
+func TestReleaseSeats_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("ReleaseSeats", mock.Anything, "res-1", (*domain.LegacyHold)(nil)).Return(&domain.Reservation{
+		ID:         "res-1",
+		EventID:    "event-1",
+		Quantity:   2,
+		Status:     domain.ReservationStatusReleased,
+		CreatedAt:  time.Now(),
+		ReleasedAt: time.Now(),
+	}, int32(50), nil)
+
+	resp, err := handler.ReleaseSeats(context.Background(), &pb.ReleaseSeatsRequest{ReservationId: "res-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ReservationStatus_RESERVATION_STATUS_RELEASED, resp.Reservation.Status)
+	assert.NotNil(t, resp.Reservation.ReleasedAt)
+	assert.Equal(t, int32(50), resp.AvailableSeats)
+}
+
+func TestResizeReservation_ErrorCodes(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{domain.ErrReservationNotResizable, codes.InvalidArgument},
+		{domain.ErrReservationNotFound, codes.NotFound},
+		{domain.ErrInsufficientSeats, codes.FailedPrecondition},
+		{domain.ErrEventNotOnSale, codes.FailedPrecondition},
+		{domain.ErrReservationReleased, codes.Aborted},
+	}
+
+	for _, tc := range cases {
+		svc := new(mocks.MockEventService)
+		handler := NewEventHandler(svc, availability.NewHub())
+		svc.On("ResizeReservation", mock.Anything, "res-1", int32(4)).Return(nil, int32(0), tc.err)
+
+		_, err := handler.ResizeReservation(context.Background(), &pb.ResizeReservationRequest{
+			ReservationId: "res-1",
+			Quantity:      4,
+		})
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, tc.code, st.Code(), tc.err.Error())
+	}
+}
+
+func TestReserveSeats_SeatUnavailableDetail(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("ReserveSeats", mock.Anything, "res-1", "event-1", int32(0), []string{"seat-a1"}, []*domain.ReservationItem{}).Return(nil, int32(0), domain.ErrSeatUnavailable)
+
+	_, err := handler.ReserveSeats(context.Background(), &pb.ReserveSeatsRequest{
+		ReservationId: "res-1",
+		EventId:       "event-1",
+		SeatIds:       []string{"seat-a1"},
+	})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		assert.True(t, ok)
+		assert.Equal(t, ReasonSeatUnavailable, info.Reason)
+	}
+}
+
+func TestGetSeatMap_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("GetSeatMap", mock.Anything, "event-1").Return(&domain.Event{
+		ID:             "event-1",
+		LayoutID:       "layout-1",
+		AvailableSeats: 1,
+	}, []*domain.Seat{
+		{ID: "seat-a1", Section: "Stalls", Row: "A", Number: 1, Status: domain.SeatStatusReserved},
+		{ID: "seat-a2", Section: "Stalls", Row: "A", Number: 2, Status: domain.SeatStatusAvailable},
+	}, nil)
+
+	resp, err := handler.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{EventId: "event-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "layout-1", resp.LayoutId)
+	assert.Len(t, resp.Seats, 2)
+	assert.Equal(t, pb.SeatStatus_SEAT_STATUS_RESERVED, resp.Seats[0].Status)
+	assert.Equal(t, int32(1), resp.AvailableSeats)
+}
+
+func TestGetSeatMap_GeneralAdmission(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("GetSeatMap", mock.Anything, "event-1").Return(nil, nil, domain.ErrNoAssignedSeating)
+
+	_, err := handler.GetSeatMap(context.Background(), &pb.GetSeatMapRequest{EventId: "event-1"})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestCreateVenueLayout_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CreateLayout", mock.Anything, "Main Hall", mock.MatchedBy(func(seats []*domain.LayoutSeat) bool {
+		return len(seats) == 1 && seats[0].Row == "A" && seats[0].Number == 1
+	})).Return(&domain.Layout{
+		ID:        "layout-1",
+		Name:      "Main Hall",
+		Seats:     []*domain.LayoutSeat{{ID: "seat-a1", Section: "Stalls", Row: "A", Number: 1}},
+		CreatedAt: time.Now(),
+	}, nil)
+
+	resp, err := handler.CreateVenueLayout(context.Background(), &pb.CreateVenueLayoutRequest{
+		Name:  "Main Hall",
+		Seats: []*pb.LayoutSeat{{Section: "Stalls", Row: "A", Number: 1}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "layout-1", resp.Layout.Id)
+	assert.Equal(t, "seat-a1", resp.Layout.Seats[0].Id)
+}
+
+func TestGetVenueLayout_NotFound(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("GetLayout", mock.Anything, "layout-1").Return(nil, domain.ErrLayoutNotFound)
+
+	_, err := handler.GetVenueLayout(context.Background(), &pb.GetVenueLayoutRequest{LayoutId: "layout-1"})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestReserveSeats_WithItems(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("ReserveSeats", mock.Anything, "res-1", "event-1", int32(0), []string(nil), []*domain.ReservationItem{
+		{TicketTypeID: "vip", Quantity: 2},
+	}).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 2,
+		Items:    []*domain.ReservationItem{{TicketTypeID: "vip", Quantity: 2, UnitPriceMinor: 15000, Currency: "USD"}},
+		Status:   domain.ReservationStatusReserved,
+	}, int32(98), nil)
+
+	resp, err := handler.ReserveSeats(context.Background(), &pb.ReserveSeatsRequest{
+		ReservationId: "res-1",
+		EventId:       "event-1",
+		Items:         []*pb.ReservationItem{{TicketTypeId: "vip", Quantity: 2}},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.Reservation.Items, 1)
+	assert.Equal(t, int64(15000), resp.Reservation.Items[0].UnitPriceMinor)
+	assert.Equal(t, "USD", resp.Reservation.Items[0].Currency)
+}
+
+func TestReserveSeats_TicketTypeNotOnSale(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("ReserveSeats", mock.Anything, "res-1", "event-1", int32(0), []string(nil), mock.Anything).Return(nil, int32(0), domain.ErrTicketTypeNotOnSale)
+
+	_, err := handler.ReserveSeats(context.Background(), &pb.ReserveSeatsRequest{
+		ReservationId: "res-1",
+		EventId:       "event-1",
+		Items:         []*pb.ReservationItem{{TicketTypeId: "early", Quantity: 1}},
+	})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		assert.Equal(t, ReasonTicketTypeNotOnSale, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+	}
+}
+
+func TestCreateTicketType_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	svc.On("CreateTicketType", mock.Anything, mock.MatchedBy(func(tt *domain.TicketType) bool {
+		return tt.EventID == "event-1" && tt.SalesStart.Equal(start) && tt.SalesEnd.IsZero()
+	})).Return(&domain.TicketType{
+		ID:         "early",
+		EventID:    "event-1",
+		Name:       "Early Bird",
+		Capacity:   50,
+		Available:  50,
+		PriceMinor: 3500,
+		Currency:   "USD",
+		SalesStart: start,
+		CreatedAt:  time.Now(),
+	}, nil)
+
+	resp, err := handler.CreateTicketType(context.Background(), &pb.CreateTicketTypeRequest{
+		EventId:    "event-1",
+		Name:       "Early Bird",
+		Capacity:   50,
+		PriceMinor: 3500,
+		Currency:   "USD",
+		SalesStart: timestamppb.New(start),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "early", resp.TicketType.Id)
+	assert.NotNil(t, resp.TicketType.SalesStart)
+	assert.Nil(t, resp.TicketType.SalesEnd)
+}
+
+func TestCreateTicketType_CapacityExceeded(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CreateTicketType", mock.Anything, mock.Anything).Return(nil, domain.ErrTicketCapacityExceeded)
+
+	_, err := handler.CreateTicketType(context.Background(), &pb.CreateTicketTypeRequest{EventId: "event-1"})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestReserveSeats_EventNotOnSale(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("ReserveSeats", mock.Anything, "res-1", "event-1", int32(2), []string(nil), mock.Anything).Return(nil, int32(0), domain.ErrEventNotOnSale)
+
+	_, err := handler.ReserveSeats(context.Background(), &pb.ReserveSeatsRequest{
+		ReservationId: "res-1",
+		EventId:       "event-1",
+		Quantity:      2,
+	})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	if assert.Len(t, st.Details(), 1) {
+		assert.Equal(t, ReasonEventNotOnSale, st.Details()[0].(*errdetails.ErrorInfo).Reason)
+	}
+}
+
+func TestUpdateEventStatus_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("UpdateEventStatus", mock.Anything, "event-1", domain.EventStatusPublished).Return(&domain.Event{
+		ID:     "event-1",
+		Status: domain.EventStatusPublished,
+	}, nil)
+
+	resp, err := handler.UpdateEventStatus(context.Background(), &pb.UpdateEventStatusRequest{
+		EventId: "event-1",
+		Status:  pb.EventStatus_EVENT_STATUS_PUBLISHED,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, pb.EventStatus_EVENT_STATUS_PUBLISHED, resp.Event.Status)
+}
+
+func TestUpdateEventStatus_InvalidTransition(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("UpdateEventStatus", mock.Anything, "event-1", domain.EventStatusDraft).Return(nil, domain.ErrInvalidTransition)
+
+	_, err := handler.UpdateEventStatus(context.Background(), &pb.UpdateEventStatusRequest{
+		EventId: "event-1",
+		Status:  pb.EventStatus_EVENT_STATUS_DRAFT,
+	})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+}
+
+func TestRescheduleEvent_NilStartTime(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	_, err := handler.RescheduleEvent(context.Background(), &pb.RescheduleEventRequest{EventId: "event-1"})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	svc.AssertNotCalled(t, "RescheduleEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateEvent_Changed(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("UpdateEvent", mock.Anything, "event-1", mock.Anything).Return(nil, domain.ErrEventChanged)
+
+	_, err := handler.UpdateEvent(context.Background(), &pb.UpdateEventRequest{EventId: "event-1", Name: "Concert"})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+}
+
+func TestUpdateEvent_SetFields(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("UpdateEvent", mock.Anything, "event-1", mock.MatchedBy(func(u domain.EventUpdate) bool {
+		return u.Name == nil && *u.Venue == "Town Hall" && len(*u.Tags) == 1 && u.Description == nil && u.Category == nil
+	})).Return(&domain.Event{ID: "event-1"}, nil)
+
+	_, err := handler.UpdateEvent(context.Background(), &pb.UpdateEventRequest{
+		EventId: "event-1",
+		Venue:   "Town Hall",
+		Tags:    []string{"jazz"},
+	})
+
+	assert.NoError(t, err)
+	svc.AssertExpectations(t)
+}
+
+func TestUpdateEvent_UpdateMaskClears(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("UpdateEvent", mock.Anything, "event-1", mock.MatchedBy(func(u domain.EventUpdate) bool {
+		return u.Name == nil && *u.Description == "" && len(*u.Tags) == 0 && u.Venue == nil
+	})).Return(&domain.Event{ID: "event-1"}, nil)
+
+	_, err := handler.UpdateEvent(context.Background(), &pb.UpdateEventRequest{
+		EventId:    "event-1",
+		Name:       "Ignored",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description", "tags"}},
+	})
+
+	assert.NoError(t, err)
+	svc.AssertExpectations(t)
+}
+
+func TestUpdateEvent_UnknownMaskPath(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	_, err := handler.UpdateEvent(context.Background(), &pb.UpdateEventRequest{
+		EventId:    "event-1",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"total_seats"}},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	svc.AssertNotCalled(t, "UpdateEvent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCancelEvent_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CancelEvent", mock.Anything, "event-1").Return(&domain.Event{
+		ID:     "event-1",
+		Status: domain.EventStatusCancelled,
+	}, int32(4), nil)
+
+	resp, err := handler.CancelEvent(context.Background(), &pb.CancelEventRequest{EventId: "event-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, pb.EventStatus_EVENT_STATUS_CANCELLED, resp.Event.Status)
+	assert.Equal(t, int32(4), resp.CancelledBookings)
+}
+
+func TestCancelEvent_BookingServiceDown(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CancelEvent", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1"}, int32(0), domain.ErrBookingCancellations)
+
+	_, err := handler.CancelEvent(context.Background(), &pb.CancelEventRequest{EventId: "event-1"})
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+}
+
+func TestCancelEvent_BookingServiceRejected(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	rejected := &client.StatusError{Err: client.ErrBookingService, Status: status.New(codes.PermissionDenied, "role service required")}
+	svc.On("CancelEvent", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1"}, int32(0), fmt.Errorf("%w: %w", domain.ErrBookingCancellations, rejected))
+
+	_, err := handler.CancelEvent(context.Background(), &pb.CancelEventRequest{EventId: "event-1"})
+
+	// Retrying cannot help until the credentials are fixed.
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "role service required")
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *EventHandler) CreateOrganizer(ctx context.Context, req *pb.CreateOrganizerRequest) (*pb.CreateOrganizerResponse, error) {
+	organizer, err := h.svc.CreateOrganizer(ctx, &domain.Organizer{
+		ID:      req.OrganizerId,
+		Name:    req.Name,
+		Email:   req.Email,
+		Phone:   req.Phone,
+		Website: req.Website,
+	})
+	if err != nil {
+		return nil, organizerError(err, "failed to create organizer")
+	}
+
+	return &pb.CreateOrganizerResponse{
+		Organizer: toProtoOrganizer(organizer),
+	}, nil
+}
+
+func (h *EventHandler) GetOrganizer(ctx context.Context, req *pb.GetOrganizerRequest) (*pb.GetOrganizerResponse, error) {
+	organizer, err := h.svc.GetOrganizer(ctx, req.OrganizerId)
+	if err != nil {
+		return nil, organizerError(err, "failed to get organizer")
+	}
+
+	return &pb.GetOrganizerResponse{
+		Organizer: toProtoOrganizer(organizer),
+	}, nil
+}
+
+func (h *EventHandler) ListOrganizers(ctx context.Context, req *pb.ListOrganizersRequest) (*pb.ListOrganizersResponse, error) {
+	organizers, nextPageToken, err := h.svc.ListOrganizers(ctx, domain.PageRequest{
+		Size:  req.PageSize,
+		Token: req.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, organizerError(err, "failed to list organizers")
+	}
+
+	pbOrganizers := make([]*pb.Organizer, len(organizers))
+	for i, o := range organizers {
+		pbOrganizers[i] = toProtoOrganizer(o)
+	}
+
+	return &pb.ListOrganizersResponse{
+		Organizers:    pbOrganizers,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *EventHandler) UpdateOrganizer(ctx context.Context, req *pb.UpdateOrganizerRequest) (*pb.UpdateOrganizerResponse, error) {
+	organizer, err := h.svc.UpdateOrganizer(ctx, &domain.Organizer{
+		ID:      req.OrganizerId,
+		Name:    req.Name,
+		Email:   req.Email,
+		Phone:   req.Phone,
+		Website: req.Website,
+	})
+	if err != nil {
+		return nil, organizerError(err, "failed to update organizer")
+	}
+
+	return &pb.UpdateOrganizerResponse{
+		Organizer: toProtoOrganizer(organizer),
+	}, nil
+}
+
+func (h *EventHandler) DeleteOrganizer(ctx context.Context, req *pb.DeleteOrganizerRequest) (*pb.DeleteOrganizerResponse, error) {
+	if err := h.svc.DeleteOrganizer(ctx, req.OrganizerId); err != nil {
+		return nil, organizerError(err, "failed to delete organizer")
+	}
+
+	return &pb.DeleteOrganizerResponse{}, nil
+}
+
+func organizerError(err error, internalMsg string) error {
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrOrganizerNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrOrganizerExists) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	if errors.Is(err, domain.ErrOrganizerInUse) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, domain.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, internalMsg)
+}
+
+func toProtoOrganizer(o *domain.Organizer) *pb.Organizer {
+	return &pb.Organizer{
+		Id:        o.ID,
+		Name:      o.Name,
+		Email:     o.Email,
+		Phone:     o.Phone,
+		Website:   o.Website,
+		CreatedAt: timestamppb.New(o.CreatedAt),
+	}
+}
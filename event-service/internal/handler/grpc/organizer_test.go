@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateOrganizer_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CreateOrganizer", mock.Anything, &domain.Organizer{Name: "Night Owl", Email: "hello@nightowl.example"}).
+		Return(&domain.Organizer{ID: "org-1", Name: "Night Owl", Email: "hello@nightowl.example"}, nil)
+
+	resp, err := handler.CreateOrganizer(context.Background(), &pb.CreateOrganizerRequest{Name: "Night Owl", Email: "hello@nightowl.example"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "org-1", resp.Organizer.Id)
+	assert.Equal(t, "hello@nightowl.example", resp.Organizer.Email)
+}
+
+func TestOrganizer_ErrorCodes(t *testing.T) {
+	for err, code := range map[error]codes.Code{
+		domain.ErrInvalidInput:      codes.InvalidArgument,
+		domain.ErrOrganizerNotFound: codes.NotFound,
+		domain.ErrOrganizerExists:   codes.AlreadyExists,
+		domain.ErrOrganizerInUse:    codes.FailedPrecondition,
+		domain.ErrPermissionDenied:  codes.PermissionDenied,
+	} {
+		svc := new(mocks.MockEventService)
+		handler := NewEventHandler(svc, availability.NewHub())
+		svc.On("CreateOrganizer", mock.Anything, mock.Anything).Return(nil, err)
+
+		_, got := handler.CreateOrganizer(context.Background(), &pb.CreateOrganizerRequest{Name: "Night Owl"})
+
+		assert.Equal(t, code, status.Code(got), err.Error())
+	}
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var (
+	// organizers manage their own events; admins manage all of them.
+	organizers = auth.Allow(auth.RoleOrganizer, auth.RoleAdmin)
+	// services are the other services of the system, i.e. booking-service.
+	services = auth.Allow(auth.RoleService)
+)
+
+// AccessPolicy says who may call each EventService RPC.
+var AccessPolicy = auth.Policy{
+	pb.EventService_GetEvent_FullMethodName:        auth.Public,
+	pb.EventService_ListEvents_FullMethodName:      auth.Public,
+	pb.EventService_SearchEvents_FullMethodName:    auth.Public,
+	pb.EventService_GetSeatMap_FullMethodName:      auth.Public,
+	pb.EventService_GetVenueLayout_FullMethodName:  auth.Public,
+	pb.EventService_ListTicketTypes_FullMethodName: auth.Public,
+	pb.EventService_GetVenue_FullMethodName:        auth.Public,
+	pb.EventService_ListVenues_FullMethodName:      auth.Public,
+	pb.EventService_GetOrganizer_FullMethodName:    auth.Public,
+	pb.EventService_ListOrganizers_FullMethodName:  auth.Public,
+	pb.EventService_GetEventSeries_FullMethodName:  auth.Public,
+
+	pb.EventService_WatchEventAvailability_FullMethodName: auth.Public,
+
+	pb.EventService_CreateEvent_FullMethodName:            organizers,
+	pb.EventService_UpdateEvent_FullMethodName:            organizers,
+	pb.EventService_RescheduleEvent_FullMethodName:        organizers,
+	pb.EventService_UpdateEventStatus_FullMethodName:      organizers,
+	pb.EventService_SetPurchaseLimit_FullMethodName:       organizers,
+	pb.EventService_SetQueueMode_FullMethodName:           organizers,
+	pb.EventService_CancelEvent_FullMethodName:            organizers,
+	pb.EventService_CreateTicketType_FullMethodName:       organizers,
+	pb.EventService_UpdateTicketType_FullMethodName:       organizers,
+	pb.EventService_CreateVenueLayout_FullMethodName:      organizers,
+	pb.EventService_CreateVenue_FullMethodName:            organizers,
+	pb.EventService_UpdateVenue_FullMethodName:            organizers,
+	pb.EventService_DeleteVenue_FullMethodName:            organizers,
+	pb.EventService_CreateOrganizer_FullMethodName:        organizers,
+	pb.EventService_UpdateOrganizer_FullMethodName:        organizers,
+	pb.EventService_DeleteOrganizer_FullMethodName:        organizers,
+	pb.EventService_SetEventCapacity_FullMethodName:       organizers,
+	pb.EventService_CreateEventSeries_FullMethodName:      organizers,
+	pb.EventService_SetEventSeriesCapacity_FullMethodName: organizers,
+	pb.EventService_CancelEventSeries_FullMethodName:      organizers,
+
+	// Seat counts only change through bookings.
+	pb.EventService_UpdateAvailableTickets_FullMethodName: services,
+	pb.EventService_ReserveSeats_FullMethodName:           services,
+	pb.EventService_ReleaseSeats_FullMethodName:           services,
+	pb.EventService_ResizeReservation_FullMethodName:      services,
+
+	// Probed by load balancers and booking-service.
+	healthpb.Health_Check_FullMethodName: auth.Public,
+	healthpb.Health_List_FullMethodName:  auth.Public,
+	healthpb.Health_Watch_FullMethodName: auth.Public,
+}
@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"testing"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestAccessPolicy_CoversEveryRPC(t *testing.T) {
+	service := pb.File_event_proto.Services().ByName("EventService")
+	methods := service.Methods()
+
+	for i := 0; i < methods.Len(); i++ {
+		name := "/" + string(service.FullName()) + "/" + string(methods.Get(i).Name())
+		assert.Contains(t, AccessPolicy, name)
+	}
+}
+
+func TestAccessPolicy_HealthIsPublic(t *testing.T) {
+	desc := healthpb.Health_ServiceDesc
+	var names []string
+	for _, m := range desc.Methods {
+		names = append(names, m.MethodName)
+	}
+	for _, s := range desc.Streams {
+		names = append(names, s.StreamName)
+	}
+
+	for _, name := range names {
+		access, ok := AccessPolicy["/"+desc.ServiceName+"/"+name]
+		assert.True(t, ok, name)
+		assert.True(t, access.Public, name)
+	}
+}
+
+func TestAccessPolicy_UpdateAvailableTicketsIsServiceOnly(t *testing.T) {
+	access := AccessPolicy[pb.EventService_UpdateAvailableTickets_FullMethodName]
+	routes := auth.GatewayRoutes(pb.File_event_proto.Services().ByName("EventService"))
+
+	assert.Equal(t, []auth.Role{auth.RoleService}, access.Roles)
+	assert.False(t, access.Public)
+	assert.Equal(t, pb.EventService_UpdateAvailableTickets_FullMethodName, routes["PUT /v1/event/{event_id=*}"])
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *EventHandler) SearchEvents(ctx context.Context, req *pb.SearchEventsRequest) (*pb.SearchEventsResponse, error) {
+	search := domain.EventSearch{
+		Query:        req.Query,
+		Category:     req.Category,
+		Venue:        req.Venue,
+		StartsFrom:   timeOrZero(req.StartsFrom),
+		StartsBefore: timeOrZero(req.StartsBefore),
+		Status:       domain.EventStatus(req.Status),
+		OrganizerID:  req.OrganizerId,
+	}
+
+	results, err := h.svc.SearchEvents(ctx, search, domain.PageRequest{
+		Size:  req.PageSize,
+		Token: req.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "failed to search events")
+	}
+
+	resp := &pb.SearchEventsResponse{
+		Results:       make([]*pb.SearchResult, len(results.Hits)),
+		NextPageToken: results.NextPageToken,
+	}
+	for i, hit := range results.Hits {
+		resp.Results[i] = &pb.SearchResult{
+			Event:                toProtoEvent(hit.Event),
+			Rank:                 hit.Rank,
+			NameHighlight:        hit.NameHighlight,
+			DescriptionHighlight: hit.DescriptionHighlight,
+		}
+	}
+	if results.Facets != nil {
+		resp.Facets = &pb.SearchFacets{
+			Categories: toProtoFacetCounts(results.Facets.Categories),
+			Venues:     toProtoFacetCounts(results.Facets.Venues),
+			Months:     toProtoFacetCounts(results.Facets.Months),
+		}
+	}
+
+	return resp, nil
+}
+
+func toProtoFacetCounts(counts []domain.FacetCount) []*pb.FacetCount {
+	pbCounts := make([]*pb.FacetCount, len(counts))
+	for i, c := range counts {
+		pbCounts[i] = &pb.FacetCount{Value: c.Value, Count: c.Count}
+	}
+	return pbCounts
+}
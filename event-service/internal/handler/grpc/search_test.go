@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchEvents_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("SearchEvents", mock.Anything, domain.EventSearch{Query: "jazz", Venue: "Town Hall"}, domain.PageRequest{Size: 5}).Return(&domain.SearchResults{
+		Hits: []*domain.SearchHit{{
+			Event:         &domain.Event{ID: "event-1", Name: "Jazz Night"},
+			Rank:          0.5,
+			NameHighlight: "<mark>Jazz</mark> Night",
+		}},
+		Facets: &domain.SearchFacets{
+			Categories: []domain.FacetCount{{Value: "music", Count: 3}},
+			Months:     []domain.FacetCount{{Value: "2026-06", Count: 2}, {Value: "2026-07", Count: 1}},
+		},
+		NextPageToken: "next",
+	}, nil)
+
+	resp, err := handler.SearchEvents(context.Background(), &pb.SearchEventsRequest{Query: "jazz", Venue: "Town Hall", PageSize: 5})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Results, 1) {
+		assert.Equal(t, "event-1", resp.Results[0].Event.Id)
+		assert.Equal(t, float32(0.5), resp.Results[0].Rank)
+		assert.Equal(t, "<mark>Jazz</mark> Night", resp.Results[0].NameHighlight)
+	}
+	assert.Equal(t, "next", resp.NextPageToken)
+	assert.Equal(t, "music", resp.Facets.Categories[0].Value)
+	assert.Len(t, resp.Facets.Months, 2)
+	assert.Empty(t, resp.Facets.Venues)
+}
+
+func TestSearchEvents_InvalidArguments(t *testing.T) {
+	for _, err := range []error{domain.ErrInvalidInput, domain.ErrInvalidPageToken} {
+		svc := new(mocks.MockEventService)
+		handler := NewEventHandler(svc, availability.NewHub())
+		svc.On("SearchEvents", mock.Anything, mock.Anything, mock.Anything).Return(nil, err)
+
+		_, got := handler.SearchEvents(context.Background(), &pb.SearchEventsRequest{})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(got), err.Error())
+	}
+}
+
+func TestSearchEvents_StatusDenied(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+	svc.On("SearchEvents", mock.Anything, domain.EventSearch{Query: "jazz", Status: domain.EventStatusDraft, OrganizerID: "org-1"}, mock.Anything).Return(nil, domain.ErrPermissionDenied)
+
+	_, err := handler.SearchEvents(context.Background(), &pb.SearchEventsRequest{
+		Query:       "jazz",
+		Status:      pb.EventStatus_EVENT_STATUS_DRAFT,
+		OrganizerId: "org-1",
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
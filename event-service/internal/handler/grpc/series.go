@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *EventHandler) CreateEventSeries(ctx context.Context, req *pb.CreateEventSeriesRequest) (*pb.CreateEventSeriesResponse, error) {
+	if req.FirstStart == nil {
+		return nil, status.Error(codes.InvalidArgument, "first_start is required")
+	}
+
+	series, err := h.svc.CreateEventSeries(ctx, &domain.EventSeries{
+		Name: req.Name,
+		EventDetails: domain.EventDetails{
+			Description: req.Description,
+			Venue:       req.Venue,
+			Category:    req.Category,
+			Tags:        req.Tags,
+		},
+		RRule:      req.Rrule,
+		FirstStart: req.FirstStart.AsTime(),
+		TotalSeats: req.TotalSeats,
+		LayoutID:   req.LayoutId,
+		VenueID:    req.VenueId,
+		Publish:    req.Publish,
+	})
+	if err != nil {
+		return nil, seriesError(err, "failed to create event series")
+	}
+
+	return &pb.CreateEventSeriesResponse{
+		Series: toProtoSeries(series),
+	}, nil
+}
+
+func (h *EventHandler) GetEventSeries(ctx context.Context, req *pb.GetEventSeriesRequest) (*pb.GetEventSeriesResponse, error) {
+	series, err := h.svc.GetEventSeries(ctx, req.SeriesId)
+	if err != nil {
+		return nil, seriesError(err, "failed to get event series")
+	}
+
+	return &pb.GetEventSeriesResponse{
+		Series: toProtoSeries(series),
+	}, nil
+}
+
+func (h *EventHandler) SetEventSeriesCapacity(ctx context.Context, req *pb.SetEventSeriesCapacityRequest) (*pb.SetEventSeriesCapacityResponse, error) {
+	series, updated, err := h.svc.SetSeriesCapacity(ctx, req.SeriesId, req.TotalSeats)
+	if err != nil {
+		return nil, seriesError(err, "failed to set event series capacity")
+	}
+
+	return &pb.SetEventSeriesCapacityResponse{
+		Series:        toProtoSeries(series),
+		UpdatedEvents: updated,
+	}, nil
+}
+
+func (h *EventHandler) CancelEventSeries(ctx context.Context, req *pb.CancelEventSeriesRequest) (*pb.CancelEventSeriesResponse, error) {
+	series, cancelledEvents, cancelledBookings, err := h.svc.CancelEventSeries(ctx, req.SeriesId, timeOrZero(req.From))
+	if err != nil {
+		if errors.Is(err, domain.ErrBookingCancellations) {
+			return nil, bookingCancellationsError(ctx, err)
+		}
+		return nil, seriesError(err, "failed to cancel event series")
+	}
+
+	return &pb.CancelEventSeriesResponse{
+		Series:            toProtoSeries(series),
+		CancelledEvents:   cancelledEvents,
+		CancelledBookings: cancelledBookings,
+	}, nil
+}
+
+func seriesError(err error, internalMsg string) error {
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrSeriesNotFound) ||
+		errors.Is(err, domain.ErrEventNotFound) ||
+		errors.Is(err, domain.ErrVenueNotFound) ||
+		errors.Is(err, domain.ErrLayoutNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrVenueCapacity) ||
+		errors.Is(err, domain.ErrInsufficientSeats) ||
+		errors.Is(err, domain.ErrTicketCapacityExceeded) ||
+		errors.Is(err, domain.ErrSeatingMismatch) ||
+		errors.Is(err, domain.ErrEventFinal) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, domain.ErrEventChanged) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	if errors.Is(err, domain.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, internalMsg)
+}
+
+func toProtoSeries(s *domain.EventSeries) *pb.EventSeries {
+	return &pb.EventSeries{
+		Id:             s.ID,
+		Name:           s.Name,
+		Description:    s.Description,
+		Venue:          s.Venue,
+		Category:       s.Category,
+		Tags:           s.Tags,
+		Rrule:          s.RRule,
+		FirstStart:     timestamppb.New(s.FirstStart),
+		TimeZone:       s.TimeZone,
+		TotalSeats:     s.TotalSeats,
+		LayoutId:       s.LayoutID,
+		VenueId:        s.VenueID,
+		OrganizerId:    s.OrganizerID,
+		Publish:        s.Publish,
+		Until:          timestampOrNil(s.Until),
+		GeneratedUntil: timestampOrNil(s.GeneratedUntil),
+		Finished:       s.Finished,
+		CreatedAt:      timestamppb.New(s.CreatedAt),
+	}
+}
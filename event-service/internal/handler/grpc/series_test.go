@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateEventSeries_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	firstStart := time.Date(2026, 6, 5, 18, 0, 0, 0, time.UTC)
+	svc.On("CreateEventSeries", mock.Anything, &domain.EventSeries{
+		Name:         "Friday Jazz",
+		EventDetails: domain.EventDetails{Category: "music"},
+		RRule:        "FREQ=WEEKLY;COUNT=10",
+		FirstStart:   firstStart,
+		TotalSeats:   200,
+		VenueID:      "venue-1",
+		Publish:      true,
+	}).Return(&domain.EventSeries{
+		ID:             "series-1",
+		Name:           "Friday Jazz",
+		EventDetails:   domain.EventDetails{Venue: "Town Hall", Category: "music"},
+		RRule:          "FREQ=WEEKLY;COUNT=10",
+		FirstStart:     firstStart,
+		TimeZone:       "Europe/Berlin",
+		TotalSeats:     200,
+		VenueID:        "venue-1",
+		Publish:        true,
+		GeneratedUntil: firstStart.AddDate(0, 0, 70),
+		Finished:       true,
+	}, nil)
+
+	resp, err := handler.CreateEventSeries(context.Background(), &pb.CreateEventSeriesRequest{
+		Name:       "Friday Jazz",
+		Rrule:      "FREQ=WEEKLY;COUNT=10",
+		FirstStart: timestamppb.New(firstStart),
+		TotalSeats: 200,
+		Category:   "music",
+		VenueId:    "venue-1",
+		Publish:    true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "series-1", resp.Series.Id)
+	assert.Equal(t, "Town Hall", resp.Series.Venue)
+	assert.True(t, resp.Series.Finished)
+	assert.Nil(t, resp.Series.Until)
+}
+
+func TestCreateEventSeries_NilFirstStart(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	_, err := handler.CreateEventSeries(context.Background(), &pb.CreateEventSeriesRequest{
+		Name:  "Friday Jazz",
+		Rrule: "FREQ=WEEKLY",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	svc.AssertNotCalled(t, "CreateEventSeries", mock.Anything, mock.Anything)
+}
+
+func TestSetEventSeriesCapacity_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("SetSeriesCapacity", mock.Anything, "series-1", int32(250)).Return(&domain.EventSeries{ID: "series-1", TotalSeats: 250}, int32(6), nil)
+
+	resp, err := handler.SetEventSeriesCapacity(context.Background(), &pb.SetEventSeriesCapacityRequest{SeriesId: "series-1", TotalSeats: 250})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(250), resp.Series.TotalSeats)
+	assert.Equal(t, int32(6), resp.UpdatedEvents)
+}
+
+func TestCancelEventSeries_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	svc.On("CancelEventSeries", mock.Anything, "series-1", from).Return(&domain.EventSeries{ID: "series-1", Until: from}, int32(4), int32(37), nil)
+
+	resp, err := handler.CancelEventSeries(context.Background(), &pb.CancelEventSeriesRequest{SeriesId: "series-1", From: timestamppb.New(from)})
+
+	assert.NoError(t, err)
+	assert.Equal(t, from, resp.Series.Until.AsTime())
+	assert.Equal(t, int32(4), resp.CancelledEvents)
+	assert.Equal(t, int32(37), resp.CancelledBookings)
+}
+
+func TestCancelEventSeries_BookingServiceDown(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CancelEventSeries", mock.Anything, "series-1", time.Time{}).Return(&domain.EventSeries{ID: "series-1"}, int32(4), int32(0), fmt.Errorf("%w: unavailable", domain.ErrBookingCancellations))
+
+	_, err := handler.CancelEventSeries(context.Background(), &pb.CancelEventSeriesRequest{SeriesId: "series-1"})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestEventSeries_ErrorCodes(t *testing.T) {
+	for err, code := range map[error]codes.Code{
+		domain.ErrInvalidInput:           codes.InvalidArgument,
+		domain.ErrSeriesNotFound:         codes.NotFound,
+		domain.ErrVenueCapacity:          codes.FailedPrecondition,
+		domain.ErrInsufficientSeats:      codes.FailedPrecondition,
+		domain.ErrTicketCapacityExceeded: codes.FailedPrecondition,
+		domain.ErrSeatingMismatch:        codes.FailedPrecondition,
+		domain.ErrPermissionDenied:       codes.PermissionDenied,
+	} {
+		svc := new(mocks.MockEventService)
+		handler := NewEventHandler(svc, availability.NewHub())
+		svc.On("SetSeriesCapacity", mock.Anything, "series-1", int32(10)).Return(nil, int32(0), err)
+		svc.On("SetEventCapacity", mock.Anything, "event-1", int32(10)).Return(nil, err)
+
+		_, got := handler.SetEventSeriesCapacity(context.Background(), &pb.SetEventSeriesCapacityRequest{SeriesId: "series-1", TotalSeats: 10})
+		assert.Equal(t, code, status.Code(got), err.Error())
+
+		if err == domain.ErrSeriesNotFound {
+			continue
+		}
+		_, got = handler.SetEventCapacity(context.Background(), &pb.SetEventCapacityRequest{EventId: "event-1", TotalSeats: 10})
+		assert.Equal(t, code, status.Code(got), err.Error())
+	}
+}
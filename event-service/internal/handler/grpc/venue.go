@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *EventHandler) CreateVenue(ctx context.Context, req *pb.CreateVenueRequest) (*pb.CreateVenueResponse, error) {
+	venue, err := h.svc.CreateVenue(ctx, &domain.Venue{
+		Name:     req.Name,
+		Address:  toDomainAddress(req.Address),
+		Location: toDomainGeoPoint(req.Location),
+		TimeZone: req.TimeZone,
+		Capacity: req.Capacity,
+	})
+	if err != nil {
+		return nil, venueError(err, "failed to create venue")
+	}
+
+	return &pb.CreateVenueResponse{
+		Venue: toProtoVenue(venue),
+	}, nil
+}
+
+func (h *EventHandler) GetVenue(ctx context.Context, req *pb.GetVenueRequest) (*pb.GetVenueResponse, error) {
+	venue, err := h.svc.GetVenue(ctx, req.VenueId)
+	if err != nil {
+		return nil, venueError(err, "failed to get venue")
+	}
+
+	return &pb.GetVenueResponse{
+		Venue: toProtoVenue(venue),
+	}, nil
+}
+
+func (h *EventHandler) ListVenues(ctx context.Context, req *pb.ListVenuesRequest) (*pb.ListVenuesResponse, error) {
+	venues, nextPageToken, err := h.svc.ListVenues(ctx, domain.VenueFilter{
+		City:        req.City,
+		CountryCode: req.CountryCode,
+	}, domain.PageRequest{
+		Size:  req.PageSize,
+		Token: req.PageToken,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidPageToken) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, venueError(err, "failed to list venues")
+	}
+
+	pbVenues := make([]*pb.Venue, len(venues))
+	for i, v := range venues {
+		pbVenues[i] = toProtoVenue(v)
+	}
+
+	return &pb.ListVenuesResponse{
+		Venues:        pbVenues,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (h *EventHandler) UpdateVenue(ctx context.Context, req *pb.UpdateVenueRequest) (*pb.UpdateVenueResponse, error) {
+	venue, err := h.svc.UpdateVenue(ctx, &domain.Venue{
+		ID:       req.VenueId,
+		Name:     req.Name,
+		Address:  toDomainAddress(req.Address),
+		Location: toDomainGeoPoint(req.Location),
+		TimeZone: req.TimeZone,
+		Capacity: req.Capacity,
+	})
+	if err != nil {
+		return nil, venueError(err, "failed to update venue")
+	}
+
+	return &pb.UpdateVenueResponse{
+		Venue: toProtoVenue(venue),
+	}, nil
+}
+
+func (h *EventHandler) DeleteVenue(ctx context.Context, req *pb.DeleteVenueRequest) (*pb.DeleteVenueResponse, error) {
+	if err := h.svc.DeleteVenue(ctx, req.VenueId); err != nil {
+		return nil, venueError(err, "failed to delete venue")
+	}
+
+	return &pb.DeleteVenueResponse{}, nil
+}
+
+func venueError(err error, internalMsg string) error {
+	if errors.Is(err, domain.ErrInvalidInput) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, domain.ErrVenueNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, domain.ErrVenueCapacity) || errors.Is(err, domain.ErrVenueInUse) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, domain.ErrPermissionDenied) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, internalMsg)
+}
+
+func toDomainAddress(a *pb.Address) domain.Address {
+	return domain.Address{
+		Street:      a.GetStreet(),
+		City:        a.GetCity(),
+		Region:      a.GetRegion(),
+		PostalCode:  a.GetPostalCode(),
+		CountryCode: a.GetCountryCode(),
+	}
+}
+
+func toDomainGeoPoint(p *pb.GeoPoint) *domain.GeoPoint {
+	if p == nil {
+		return nil
+	}
+	return &domain.GeoPoint{Latitude: p.Latitude, Longitude: p.Longitude}
+}
+
+func toProtoVenue(v *domain.Venue) *pb.Venue {
+	venue := &pb.Venue{
+		Id:   v.ID,
+		Name: v.Name,
+		Address: &pb.Address{
+			Street:      v.Address.Street,
+			City:        v.Address.City,
+			Region:      v.Address.Region,
+			PostalCode:  v.Address.PostalCode,
+			CountryCode: v.Address.CountryCode,
+		},
+		TimeZone:    v.TimeZone,
+		Capacity:    v.Capacity,
+		OrganizerId: v.OrganizerID,
+		CreatedAt:   timestamppb.New(v.CreatedAt),
+	}
+	if v.Location != nil {
+		venue.Location = &pb.GeoPoint{Latitude: v.Location.Latitude, Longitude: v.Location.Longitude}
+	}
+	return venue
+}
@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/availability"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	pb "github.com/azatmuhammetamanov01/online-ticket-booking/event-service/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreateVenue_Success(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CreateVenue", mock.Anything, &domain.Venue{
+		Name:     "Town Hall",
+		Address:  domain.Address{City: "Berlin", CountryCode: "DE"},
+		Location: &domain.GeoPoint{Latitude: 52.52, Longitude: 13.4},
+		TimeZone: "Europe/Berlin",
+		Capacity: 500,
+	}).Return(&domain.Venue{
+		ID:          "venue-1",
+		Name:        "Town Hall",
+		Address:     domain.Address{City: "Berlin", CountryCode: "DE"},
+		Location:    &domain.GeoPoint{Latitude: 52.52, Longitude: 13.4},
+		TimeZone:    "Europe/Berlin",
+		Capacity:    500,
+		OrganizerID: "org-1",
+	}, nil)
+
+	resp, err := handler.CreateVenue(context.Background(), &pb.CreateVenueRequest{
+		Name:     "Town Hall",
+		Address:  &pb.Address{City: "Berlin", CountryCode: "DE"},
+		Location: &pb.GeoPoint{Latitude: 52.52, Longitude: 13.4},
+		TimeZone: "Europe/Berlin",
+		Capacity: 500,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "venue-1", resp.Venue.Id)
+	assert.Equal(t, "Berlin", resp.Venue.Address.City)
+	assert.Equal(t, 52.52, resp.Venue.Location.Latitude)
+	assert.Equal(t, "org-1", resp.Venue.OrganizerId)
+}
+
+func TestVenue_ErrorCodes(t *testing.T) {
+	for err, code := range map[error]codes.Code{
+		domain.ErrInvalidInput:     codes.InvalidArgument,
+		domain.ErrVenueNotFound:    codes.NotFound,
+		domain.ErrVenueCapacity:    codes.FailedPrecondition,
+		domain.ErrVenueInUse:       codes.FailedPrecondition,
+		domain.ErrPermissionDenied: codes.PermissionDenied,
+	} {
+		svc := new(mocks.MockEventService)
+		handler := NewEventHandler(svc, availability.NewHub())
+		svc.On("UpdateVenue", mock.Anything, mock.Anything).Return(nil, err)
+		svc.On("DeleteVenue", mock.Anything, "venue-1").Return(err)
+
+		_, got := handler.UpdateVenue(context.Background(), &pb.UpdateVenueRequest{VenueId: "venue-1"})
+		assert.Equal(t, code, status.Code(got), err.Error())
+
+		_, got = handler.DeleteVenue(context.Background(), &pb.DeleteVenueRequest{VenueId: "venue-1"})
+		assert.Equal(t, code, status.Code(got), err.Error())
+	}
+}
+
+func TestCreateEvent_VenueCapacity(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	svc.On("CreateEvent", mock.Anything, "Concert", domain.EventDetails{}, mock.Anything, int32(600), "", "venue-1").Return(nil, domain.ErrVenueCapacity)
+
+	_, err := handler.CreateEvent(context.Background(), &pb.CreateEventRequest{
+		Name:       "Concert",
+		StartTime:  timestamppb.New(time.Now().Add(24 * time.Hour)),
+		TotalSeats: 600,
+		VenueId:    "venue-1",
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestGetEvent_LocalStartTime(t *testing.T) {
+	svc := new(mocks.MockEventService)
+	handler := NewEventHandler(svc, availability.NewHub())
+
+	start := time.Date(2026, 6, 12, 18, 0, 0, 0, time.UTC)
+	svc.On("GetEvent", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", StartTime: start, VenueID: "venue-1", TimeZone: "Europe/Berlin"}, nil)
+	svc.On("GetEvent", mock.Anything, "event-2").Return(&domain.Event{ID: "event-2", StartTime: start}, nil)
+
+	resp, err := handler.GetEvent(context.Background(), &pb.GetEventRequest{EventId: "event-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-06-12T20:00:00+02:00", resp.Event.LocalStartTime)
+	assert.Equal(t, "Europe/Berlin", resp.Event.TimeZone)
+
+	resp, err = handler.GetEvent(context.Background(), &pb.GetEventRequest{EventId: "event-2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-06-12T18:00:00Z", resp.Event.LocalStartTime)
+}
@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+
+	grpcprom "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds everything served on /metrics.
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+// GRPCServer and GRPCClient count calls and time them per method and status
+// code.
+var (
+	GRPCServer = grpcprom.NewServerMetrics(grpcprom.WithServerHandlingTimeHistogram())
+	GRPCClient = grpcprom.NewClientMetrics(grpcprom.WithClientHandlingTimeHistogram())
+)
+
+var (
+	// SeatsReserved and SeatsReleased count seats taken from and given back
+	// to events. Retried calls that change nothing are not counted.
+	SeatsReserved = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: "event",
+		Name:      "seats_reserved_total",
+		Help:      "Seats reserved.",
+	})
+	SeatsReleased = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: "event",
+		Name:      "seats_released_total",
+		Help:      "Seats released.",
+	})
+
+	// InsufficientSeats counts requests turned away because the event or
+	// ticket type had too few seats left, by operation: "reserve", "resize"
+	// or "update".
+	InsufficientSeats = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "event",
+		Name:      "insufficient_seats_rejections_total",
+		Help:      "Requests rejected for lack of seats, by operation.",
+	}, []string{"operation"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		GRPCServer,
+		GRPCClient,
+	)
+}
+
+// RegisterDB exports the connection pool stats of db.
+func RegisterDB(db *sql.DB, name string) {
+	Registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// RegisterAvailabilityWatchers exports the number of open availability
+// streams, as counted by watchers.
+func RegisterAvailabilityWatchers(watchers func() int) {
+	Registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "event",
+		Name:      "availability_watchers",
+		Help:      "Open WatchEventAvailability streams.",
+	}, func() float64 {
+		return float64(watchers())
+	}))
+}
+
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
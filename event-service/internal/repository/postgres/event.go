@@ -3,12 +3,17 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/metrics"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const eventColumns = `id, name, description, venue, category, tags, start_time, total_seats, available_seats, layout_id, status, organizer_id, venue_id, time_zone, max_tickets_per_user, queue_admissions_per_minute, series_id, seats_overridden, version, created_at`
+
 type EventRepository struct {
 	db *sql.DB
 }
@@ -18,44 +23,67 @@ func NewEventRepository(db *sql.DB) *EventRepository {
 }
 
 func (r *EventRepository) Create(ctx context.Context, event *domain.Event) error {
+	event.Status = domain.EventStatusDraft
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		return insertEvent(ctx, tx, event)
+	})
+}
+
+// insertEvent stores a new event in the status it has, along with the seats
+// of its layout.
+func insertEvent(ctx context.Context, tx *sql.Tx, event *domain.Event) error {
 	event.ID = uuid.New().String()
 	event.CreatedAt = time.Now()
 	event.AvailableSeats = event.TotalSeats
 
 	query := `
-		INSERT INTO events (id, name, start_time, total_seats, available_seats, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO events (` + eventColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := tx.ExecContext(ctx, query,
 		event.ID,
 		event.Name,
+		event.Description,
+		event.Venue,
+		event.Category,
+		tagsArray(event.Tags),
 		event.StartTime,
 		event.TotalSeats,
 		event.AvailableSeats,
+		nullString(event.LayoutID),
+		event.Status,
+		nullString(event.OrganizerID),
+		nullString(event.VenueID),
+		event.TimeZone,
+		event.MaxTicketsPerUser,
+		event.QueueAdmissionsPerMinute,
+		nullString(event.SeriesID),
+		event.SeatsOverridden,
+		event.Version,
 		event.CreatedAt,
 	)
+	if err != nil || event.LayoutID == "" {
+		return err
+	}
 
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO event_seats (event_id, id, section, row_label, number, status)
+		SELECT $1, id, section, row_label, number, $2
+		FROM layout_seats
+		WHERE layout_id = $3
+	`, event.ID, domain.SeatStatusAvailable, event.LayoutID)
 	return err
 }
 
 func (r *EventRepository) GetByID(ctx context.Context, id string) (*domain.Event, error) {
 	query := `
-		SELECT id, name, start_time, total_seats, available_seats, created_at
+		SELECT ` + eventColumns + `
 		FROM events
 		WHERE id = $1
 	`
 
-	event := &domain.Event{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&event.ID,
-		&event.Name,
-		&event.StartTime,
-		&event.TotalSeats,
-		&event.AvailableSeats,
-		&event.CreatedAt,
-	)
-
+	event, err := scanEvent(r.db.QueryRowContext(ctx, query, id))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -66,57 +94,65 @@ func (r *EventRepository) GetByID(ctx context.Context, id string) (*domain.Event
 	return event, nil
 }
 
-func (r *EventRepository) List(ctx context.Context, limit, offset int32) ([]*domain.Event, int32, error) {
-	if limit <= 0 {
-		limit = 10
-	}
-	if limit > 100 {
-		limit = 100
-	}
+// eventSortColumns are the columns List sorts by, each backed by an index
+// on (column, id).
+var eventSortColumns = map[string]bool{"start_time": true, "created_at": true}
 
-	countQuery := `SELECT COUNT(*) FROM events`
-	var totalCount int32
-	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&totalCount); err != nil {
-		return nil, 0, err
+func (r *EventRepository) List(ctx context.Context, filter domain.EventFilter, order domain.SortOrder, after *domain.PageCursor, limit int32) ([]*domain.Event, error) {
+	if !eventSortColumns[order.Column] {
+		return nil, fmt.Errorf("postgres: cannot sort events by %q", order.Column)
 	}
 
-	query := `
-		SELECT id, name, start_time, total_seats, available_seats, created_at
-		FROM events
-		ORDER BY start_time ASC
-		LIMIT $1 OFFSET $2
-	`
+	var q listQuery
+	if !filter.StartsFrom.IsZero() {
+		q.where("start_time >= " + q.arg(filter.StartsFrom))
+	}
+	if !filter.StartsBefore.IsZero() {
+		q.where("start_time < " + q.arg(filter.StartsBefore))
+	}
+	if filter.Status != domain.EventStatusUnspecified {
+		q.where("status = " + q.arg(filter.Status))
+	}
+	if filter.NameContains != "" {
+		q.where("name ILIKE " + q.arg("%"+escapeLike(filter.NameContains)+"%"))
+	}
+	if filter.HasAvailability {
+		q.where("available_seats > 0")
+	}
+	if filter.OrganizerID != "" {
+		q.where("organizer_id = " + q.arg(filter.OrganizerID))
+	}
+	if filter.VenueID != "" {
+		q.where("venue_id = " + q.arg(filter.VenueID))
+	}
+	if filter.SeriesID != "" {
+		q.where("series_id = " + q.arg(filter.SeriesID))
+	}
+	q.after(order, after)
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	query := `SELECT ` + eventColumns + ` FROM events` + q.clauses(order, limit)
+	rows, err := r.db.QueryContext(ctx, query, q.args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer rows.Close()
 
 	var events []*domain.Event
 	for rows.Next() {
-		event := &domain.Event{}
-		err := rows.Scan(
-			&event.ID,
-			&event.Name,
-			&event.StartTime,
-			&event.TotalSeats,
-			&event.AvailableSeats,
-			&event.CreatedAt,
-		)
+		event, err := scanEvent(rows)
 		if err != nil {
-			return nil, 0, err
+			return nil, err
 		}
 		events = append(events, event)
 	}
 
-	return events, totalCount, rows.Err()
+	return events, rows.Err()
 }
 
 func (r *EventRepository) UpdateAvailableSeats(ctx context.Context, id string, quantity int32) (int32, error) {
 	query := `
 		UPDATE events
-		SET available_seats = available_seats - $1
+		SET available_seats = LEAST(total_seats, available_seats - $1)
 		WHERE id = $2 AND available_seats >= $1
 		RETURNING available_seats
 	`
@@ -130,5 +166,212 @@ func (r *EventRepository) UpdateAvailableSeats(ctx context.Context, id string, q
 		return 0, err
 	}
 
+	if quantity > 0 {
+		metrics.SeatsReserved.Add(float64(quantity))
+	} else {
+		metrics.SeatsReleased.Add(float64(-quantity))
+	}
 	return newAvailable, nil
 }
+
+func (r *EventRepository) Update(ctx context.Context, event *domain.Event) (bool, error) {
+	query := `
+		UPDATE events
+		SET name = $1, description = $2, venue = $3, category = $4, tags = $5, venue_id = $6, time_zone = $7,
+			start_time = $8, status = $9, max_tickets_per_user = $10, queue_admissions_per_minute = $11,
+			version = version + 1
+		WHERE id = $12 AND version = $13
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		event.Name,
+		event.Description,
+		event.Venue,
+		event.Category,
+		tagsArray(event.Tags),
+		nullString(event.VenueID),
+		event.TimeZone,
+		event.StartTime,
+		event.Status,
+		event.MaxTicketsPerUser,
+		event.QueueAdmissionsPerMinute,
+		event.ID,
+		event.Version,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	event.Version++
+	return true, nil
+}
+
+func (r *EventRepository) ListSeats(ctx context.Context, eventID string) ([]*domain.Seat, error) {
+	query := `
+		SELECT event_id, id, section, row_label, number, status, reservation_id
+		FROM event_seats
+		WHERE event_id = $1
+		ORDER BY section, row_label, number
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var seats []*domain.Seat
+	for rows.Next() {
+		seat := &domain.Seat{}
+		var reservationID sql.NullString
+		err := rows.Scan(
+			&seat.EventID,
+			&seat.ID,
+			&seat.Section,
+			&seat.Row,
+			&seat.Number,
+			&seat.Status,
+			&reservationID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		seat.ReservationID = reservationID.String
+		seats = append(seats, seat)
+	}
+
+	return seats, rows.Err()
+}
+
+func (r *EventRepository) SetTotalSeats(ctx context.Context, id string, totalSeats int32) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		var q listQuery
+		q.where("id = " + q.arg(id))
+		if _, err := resizeEvents(ctx, tx, q, totalSeats); err != nil {
+			return err
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			UPDATE events
+			SET seats_overridden = TRUE
+			WHERE id = $1 AND series_id IS NOT NULL AND NOT seats_overridden
+		`, id)
+		return err
+	})
+}
+
+// resizeEvents sets the total seats of the events q selects, leaving out
+// cancelled and completed ones, and moves their available seats by as much.
+// It fails before changing any if one has sold more seats than totalSeats or
+// has ticket types holding more. It returns how many events changed.
+func resizeEvents(ctx context.Context, tx *sql.Tx, q listQuery, totalSeats int32) (int32, error) {
+	total := q.arg(totalSeats)
+	q.where("total_seats <> " + total)
+	q.where(fmt.Sprintf("status NOT IN (%s, %s)", q.arg(domain.EventStatusCancelled), q.arg(domain.EventStatusCompleted)))
+
+	// Locking the events keeps reservations from selling seats between the
+	// check and the update.
+	rows, err := tx.QueryContext(ctx, `
+		SELECT total_seats - available_seats,
+			(SELECT COALESCE(SUM(capacity), 0) FROM ticket_types WHERE event_id = events.id)
+		FROM events`+q.whereClause()+`
+		FOR UPDATE
+	`, q.args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var count int32
+	for rows.Next() {
+		var sold, ticketTypeSeats int32
+		if err := rows.Scan(&sold, &ticketTypeSeats); err != nil {
+			return 0, err
+		}
+		if sold > totalSeats {
+			return 0, domain.ErrInsufficientSeats
+		}
+		if ticketTypeSeats > totalSeats {
+			return 0, domain.ErrTicketCapacityExceeded
+		}
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE events
+		SET available_seats = available_seats + (`+total+` - total_seats), total_seats = `+total+q.whereClause(), q.args...)
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanEvent(row rowScanner) (*domain.Event, error) {
+	event := &domain.Event{}
+	var layoutID, organizerID, venueID, seriesID sql.NullString
+	var tags pq.StringArray
+	err := row.Scan(
+		&event.ID,
+		&event.Name,
+		&event.Description,
+		&event.Venue,
+		&event.Category,
+		&tags,
+		&event.StartTime,
+		&event.TotalSeats,
+		&event.AvailableSeats,
+		&layoutID,
+		&event.Status,
+		&organizerID,
+		&venueID,
+		&event.TimeZone,
+		&event.MaxTicketsPerUser,
+		&event.QueueAdmissionsPerMinute,
+		&seriesID,
+		&event.SeatsOverridden,
+		&event.Version,
+		&event.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	event.LayoutID = layoutID.String
+	event.OrganizerID = organizerID.String
+	event.VenueID = venueID.String
+	event.SeriesID = seriesID.String
+	if len(tags) > 0 {
+		event.Tags = tags
+	}
+
+	return event, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+// tagsArray stores no tags as an empty array rather than NULL.
+func tagsArray(tags []string) pq.StringArray {
+	if tags == nil {
+		return pq.StringArray{}
+	}
+	return tags
+}
@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRepository_UpdateRefusesStaleVersion(t *testing.T) {
+	repo := NewEventRepository(testDB(t))
+	ctx := context.Background()
+
+	event := &domain.Event{
+		Name:       "Concurrent edits",
+		StartTime:  time.Now().Add(24 * time.Hour),
+		TotalSeats: 10,
+	}
+	require.NoError(t, repo.Create(ctx, event))
+
+	// Two callers read the event, then each changes a different setting.
+	limit, err := repo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+	queue, err := repo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+
+	limit.MaxTicketsPerUser = 4
+	updated, err := repo.Update(ctx, limit)
+	require.NoError(t, err)
+	assert.True(t, updated)
+
+	queue.QueueAdmissionsPerMinute = 100
+	updated, err = repo.Update(ctx, queue)
+	require.NoError(t, err)
+	assert.False(t, updated)
+
+	stored, err := repo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), stored.MaxTicketsPerUser)
+	assert.Zero(t, stored.QueueAdmissionsPerMinute)
+	assert.Equal(t, limit.Version, stored.Version)
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+type LayoutRepository struct {
+	db *sql.DB
+}
+
+func NewLayoutRepository(db *sql.DB) *LayoutRepository {
+	return &LayoutRepository{db: db}
+}
+
+func (r *LayoutRepository) Create(ctx context.Context, layout *domain.Layout) error {
+	layout.ID = uuid.New().String()
+	layout.CreatedAt = time.Now()
+
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO venue_layouts (id, name, created_at)
+			VALUES ($1, $2, $3)
+		`, layout.ID, layout.Name, layout.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		stmt, err := tx.PrepareContext(ctx, `
+			INSERT INTO layout_seats (id, layout_id, section, row_label, number)
+			VALUES ($1, $2, $3, $4, $5)
+		`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		for _, seat := range layout.Seats {
+			seat.ID = uuid.New().String()
+			if _, err := stmt.ExecContext(ctx, seat.ID, layout.ID, seat.Section, seat.Row, seat.Number); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *LayoutRepository) GetByID(ctx context.Context, id string) (*domain.Layout, error) {
+	layout := &domain.Layout{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, name, created_at
+		FROM venue_layouts
+		WHERE id = $1
+	`, id).Scan(&layout.ID, &layout.Name, &layout.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, section, row_label, number
+		FROM layout_seats
+		WHERE layout_id = $1
+		ORDER BY section, row_label, number
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		seat := &domain.LayoutSeat{}
+		if err := rows.Scan(&seat.ID, &seat.Section, &seat.Row, &seat.Number); err != nil {
+			return nil, err
+		}
+		layout.Seats = append(layout.Seats, seat)
+	}
+
+	return layout, rows.Err()
+}
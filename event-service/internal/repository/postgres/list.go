@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+// listQuery builds the WHERE, ORDER BY and LIMIT clauses of a keyset
+// paginated list. Column names must come from the caller's allow-list; only
+// values are passed as arguments.
+type listQuery struct {
+	conds []string
+	args  []any
+}
+
+// arg adds an argument and returns its placeholder.
+func (q *listQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *listQuery) where(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+// after skips the rows up to and including the cursor.
+func (q *listQuery) after(order domain.SortOrder, cursor *domain.PageCursor) {
+	if cursor == nil {
+		return
+	}
+	cmp := ">"
+	if order.Desc {
+		cmp = "<"
+	}
+	q.where(fmt.Sprintf("(%s, id) %s (%s, %s)", order.Column, cmp, q.arg(cursor.Key), q.arg(cursor.ID)))
+}
+
+// whereClause joins the conditions, or is empty without any.
+func (q *listQuery) whereClause() string {
+	if len(q.conds) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(q.conds, " AND ")
+}
+
+func (q *listQuery) clauses(order domain.SortOrder, limit int32) string {
+	var b strings.Builder
+	b.WriteString(q.whereClause())
+	dir := "ASC"
+	if order.Desc {
+		dir = "DESC"
+	}
+	fmt.Fprintf(&b, " ORDER BY %s %s, id %s LIMIT %s", order.Column, dir, dir, q.arg(limit))
+	return b.String()
+}
+
+// escapeLike makes s match literally inside a LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
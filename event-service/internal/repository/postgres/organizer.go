@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+const organizerColumns = `id, name, email, phone, website, created_at`
+
+type OrganizerRepository struct {
+	db *sql.DB
+}
+
+func NewOrganizerRepository(db *sql.DB) *OrganizerRepository {
+	return &OrganizerRepository{db: db}
+}
+
+func (r *OrganizerRepository) Create(ctx context.Context, organizer *domain.Organizer) error {
+	organizer.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO organizers (` + organizerColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		organizer.ID,
+		organizer.Name,
+		organizer.Email,
+		organizer.Phone,
+		organizer.Website,
+		organizer.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrOrganizerExists
+	}
+	return nil
+}
+
+func (r *OrganizerRepository) GetByID(ctx context.Context, id string) (*domain.Organizer, error) {
+	query := `
+		SELECT ` + organizerColumns + `
+		FROM organizers
+		WHERE id = $1
+	`
+
+	organizer, err := scanOrganizer(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return organizer, nil
+}
+
+func (r *OrganizerRepository) List(ctx context.Context, after *domain.PageCursor, limit int32) ([]*domain.Organizer, error) {
+	order := domain.SortOrder{Column: "created_at"}
+
+	var q listQuery
+	q.after(order, after)
+
+	query := `SELECT ` + organizerColumns + ` FROM organizers` + q.clauses(order, limit)
+	rows, err := r.db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var organizers []*domain.Organizer
+	for rows.Next() {
+		organizer, err := scanOrganizer(rows)
+		if err != nil {
+			return nil, err
+		}
+		organizers = append(organizers, organizer)
+	}
+
+	return organizers, rows.Err()
+}
+
+func (r *OrganizerRepository) Update(ctx context.Context, organizer *domain.Organizer) error {
+	query := `
+		UPDATE organizers
+		SET name = $1, email = $2, phone = $3, website = $4
+		WHERE id = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		organizer.Name,
+		organizer.Email,
+		organizer.Phone,
+		organizer.Website,
+		organizer.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrOrganizerNotFound
+	}
+	return nil
+}
+
+func (r *OrganizerRepository) Delete(ctx context.Context, id string) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			DELETE FROM organizers
+			WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM events WHERE organizer_id = $1)
+		`, id)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil || rowsAffected > 0 {
+			return err
+		}
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM organizers WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return domain.ErrOrganizerNotFound
+		}
+		return domain.ErrOrganizerInUse
+	})
+}
+
+func scanOrganizer(row rowScanner) (*domain.Organizer, error) {
+	organizer := &domain.Organizer{}
+	err := row.Scan(
+		&organizer.ID,
+		&organizer.Name,
+		&organizer.Email,
+		&organizer.Phone,
+		&organizer.Website,
+		&organizer.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return organizer, nil
+}
@@ -0,0 +1,579 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/metrics"
+	"github.com/lib/pq"
+)
+
+const reservationColumns = `id, event_id, quantity, seat_ids, status, created_at, released_at`
+
+// ReserveSeats, ReleaseSeats and ResizeSeats count the seats they move; only
+// the transaction knows whether a retried call changed anything.
+func (r *EventRepository) ReserveSeats(ctx context.Context, reservation *domain.Reservation) (*domain.Reservation, int32, error) {
+	reservation.Status = domain.ReservationStatusReserved
+	reservation.CreatedAt = time.Now()
+
+	var (
+		result    *domain.Reservation
+		available int32
+		reserved  int32
+	)
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		inserted, err := tx.ExecContext(ctx, `
+			INSERT INTO seat_reservations (id, event_id, quantity, seat_ids, status, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (id) DO NOTHING
+		`,
+			reservation.ID,
+			reservation.EventID,
+			reservation.Quantity,
+			seatIDsArray(reservation.SeatIDs),
+			reservation.Status,
+			reservation.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := inserted.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			// A retry of an earlier call: hand back what was stored.
+			result, err = getReservation(ctx, tx, reservation.ID)
+			if err != nil {
+				return err
+			}
+			// A released reservation holds no seats, and a release that came
+			// first left a tombstone with no event at all.
+			if result.Status == domain.ReservationStatusReleased || result.EventID == "" {
+				return nil
+			}
+			available, err = availableSeats(ctx, tx, result.EventID)
+			return err
+		}
+
+		var (
+			layoutID sql.NullString
+			status   domain.EventStatus
+			tiered   bool
+		)
+		// The row lock keeps the event from being closed or cancelled while
+		// this reservation is in flight.
+		err = tx.QueryRowContext(ctx, `
+			SELECT layout_id, status, EXISTS (SELECT 1 FROM ticket_types WHERE event_id = events.id)
+			FROM events
+			WHERE id = $1
+			FOR UPDATE
+		`, reservation.EventID).Scan(&layoutID, &status, &tiered)
+		if err == sql.ErrNoRows {
+			return domain.ErrEventNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if status != domain.EventStatusPublished {
+			return domain.ErrEventNotOnSale
+		}
+		// Assigned-seating events are only sold by seat, general admission
+		// events only by count.
+		if layoutID.Valid != (len(reservation.SeatIDs) > 0) {
+			return domain.ErrSeatingMismatch
+		}
+		if layoutID.Valid {
+			if err := takeSeats(ctx, tx, reservation); err != nil {
+				return err
+			}
+		}
+		// Once an event has ticket types, every sale goes through one.
+		if tiered && len(reservation.Items) == 0 {
+			return domain.ErrTicketTypeRequired
+		}
+		if len(reservation.Items) > 0 {
+			if err := takeTickets(ctx, tx, reservation); err != nil {
+				return err
+			}
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			UPDATE events
+			SET available_seats = available_seats - $1
+			WHERE id = $2 AND available_seats >= $1
+			RETURNING available_seats
+		`, reservation.Quantity, reservation.EventID).Scan(&available)
+		if err == sql.ErrNoRows {
+			return domain.ErrInsufficientSeats
+		}
+		if err != nil {
+			return err
+		}
+
+		result = reservation
+		reserved = reservation.Quantity
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	metrics.SeatsReserved.Add(float64(reserved))
+	return result, available, nil
+}
+
+func (r *EventRepository) ReleaseSeats(ctx context.Context, reservationID string, legacy *domain.LegacyHold) (*domain.Reservation, int32, error) {
+	var (
+		result    *domain.Reservation
+		available int32
+		freed     int32
+	)
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		released, err := scanReservation(tx.QueryRowContext(ctx, `
+			UPDATE seat_reservations
+			SET status = $1, released_at = $2
+			WHERE id = $3 AND status = $4
+			RETURNING `+reservationColumns+`
+		`,
+			domain.ReservationStatusReleased,
+			time.Now(),
+			reservationID,
+			domain.ReservationStatusReserved,
+		))
+		if err == sql.ErrNoRows {
+			return r.releaseUnreserved(ctx, tx, reservationID, legacy, &result, &available, &freed)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE event_seats
+			SET status = $1, reservation_id = NULL
+			WHERE event_id = $2 AND reservation_id = $3
+		`, domain.SeatStatusAvailable, released.EventID, released.ID)
+		if err != nil {
+			return err
+		}
+
+		released.Items, err = reservationItems(ctx, tx, released.ID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE ticket_types t
+			SET available = LEAST(t.capacity, t.available + i.quantity)
+			FROM reservation_items i
+			WHERE i.reservation_id = $1 AND t.id = i.ticket_type_id
+		`, released.ID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			UPDATE events
+			SET available_seats = LEAST(total_seats, available_seats + $1)
+			WHERE id = $2
+			RETURNING available_seats
+		`, released.Quantity, released.EventID).Scan(&available)
+		if err != nil {
+			return err
+		}
+
+		result = released
+		freed = released.Quantity
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	metrics.SeatsReleased.Add(float64(freed))
+	return result, available, nil
+}
+
+func (r *EventRepository) ResizeSeats(ctx context.Context, reservationID string, quantity int32) (*domain.Reservation, int32, error) {
+	var (
+		result    *domain.Reservation
+		available int32
+		delta     int32
+	)
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		reservation, err := scanReservation(tx.QueryRowContext(ctx, `
+			SELECT `+reservationColumns+`
+			FROM seat_reservations
+			WHERE id = $1
+			FOR UPDATE
+		`, reservationID))
+		if err == sql.ErrNoRows {
+			return domain.ErrReservationNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if reservation.Status != domain.ReservationStatusReserved {
+			return domain.ErrReservationReleased
+		}
+		if len(reservation.SeatIDs) > 0 {
+			return domain.ErrReservationNotResizable
+		}
+		reservation.Items, err = reservationItems(ctx, tx, reservation.ID)
+		if err != nil {
+			return err
+		}
+		if len(reservation.Items) > 1 {
+			return domain.ErrReservationNotResizable
+		}
+
+		delta = quantity - reservation.Quantity
+		if delta == 0 {
+			result = reservation
+			available, err = availableSeats(ctx, tx, reservation.EventID)
+			return err
+		}
+
+		if delta > 0 {
+			// Growing is a new sale, so it needs the event on sale just like
+			// a reservation does.
+			var status domain.EventStatus
+			err = tx.QueryRowContext(ctx, `
+				SELECT status FROM events WHERE id = $1 FOR UPDATE
+			`, reservation.EventID).Scan(&status)
+			if err == sql.ErrNoRows {
+				return domain.ErrEventNotFound
+			}
+			if err != nil {
+				return err
+			}
+			if status != domain.EventStatusPublished {
+				return domain.ErrEventNotOnSale
+			}
+		}
+
+		if len(reservation.Items) == 1 {
+			if err := resizeTickets(ctx, tx, reservation, reservation.Items[0], delta); err != nil {
+				return err
+			}
+		}
+
+		// Shrinking always matches; growing only while enough seats are left.
+		err = tx.QueryRowContext(ctx, `
+			UPDATE events
+			SET available_seats = LEAST(total_seats, available_seats - $1)
+			WHERE id = $2 AND available_seats >= $1
+			RETURNING available_seats
+		`, delta, reservation.EventID).Scan(&available)
+		if err == sql.ErrNoRows {
+			return domain.ErrInsufficientSeats
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE seat_reservations SET quantity = $1 WHERE id = $2
+		`, quantity, reservation.ID)
+		if err != nil {
+			return err
+		}
+
+		reservation.Quantity = quantity
+		result = reservation
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if delta > 0 {
+		metrics.SeatsReserved.Add(float64(delta))
+	} else {
+		metrics.SeatsReleased.Add(float64(-delta))
+	}
+	return result, available, nil
+}
+
+// resizeTickets moves delta tickets between the reservation's item and its
+// ticket type. Added tickets keep the price the item was reserved at.
+func resizeTickets(ctx context.Context, tx *sql.Tx, reservation *domain.Reservation, item *domain.ReservationItem, delta int32) error {
+	if delta > 0 {
+		now := time.Now()
+		result, err := tx.ExecContext(ctx, `
+			UPDATE ticket_types
+			SET available = available - $1
+			WHERE id = $2 AND available >= $1
+				AND (sales_start IS NULL OR sales_start <= $3)
+				AND (sales_end IS NULL OR sales_end > $3)
+		`, delta, item.TicketTypeID, now)
+		if err != nil {
+			return err
+		}
+		taken, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if taken == 0 {
+			return ticketShortfall(ctx, tx, &domain.Reservation{EventID: reservation.EventID, CreatedAt: now}, item)
+		}
+	} else {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE ticket_types
+			SET available = LEAST(capacity, available - $1)
+			WHERE id = $2
+		`, delta, item.TicketTypeID)
+		if err != nil {
+			return err
+		}
+	}
+
+	item.Quantity += delta
+	_, err := tx.ExecContext(ctx, `
+		UPDATE reservation_items
+		SET quantity = $1
+		WHERE reservation_id = $2 AND ticket_type_id = $3
+	`, item.Quantity, reservation.ID, item.TicketTypeID)
+	return err
+}
+
+// releaseUnreserved handles a release for a reservation that is already
+// released or was never seen. The latter is stored as a released tombstone so
+// a reserve that arrives late cannot take seats nobody will give back. A legacy
+// hold's tombstone keeps its event and count, and its seats are returned only
+// by the call that writes it.
+func (r *EventRepository) releaseUnreserved(ctx context.Context, tx *sql.Tx, reservationID string, legacy *domain.LegacyHold, result **domain.Reservation, available, freed *int32) error {
+	var hold domain.LegacyHold
+	if legacy != nil {
+		hold = *legacy
+		err := tx.QueryRowContext(ctx, `SELECT 1 FROM events WHERE id = $1 FOR UPDATE`, hold.EventID).Scan(new(int))
+		if err == sql.ErrNoRows {
+			return domain.ErrEventNotFound
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	inserted, err := tx.ExecContext(ctx, `
+		INSERT INTO seat_reservations (id, event_id, quantity, status, created_at, released_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (id) DO NOTHING
+	`, reservationID, nullString(hold.EventID), hold.Quantity, domain.ReservationStatusReleased, time.Now())
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := inserted.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 1 && hold.Quantity > 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE events
+			SET available_seats = LEAST(total_seats, available_seats + $1)
+			WHERE id = $2
+		`, hold.Quantity, hold.EventID)
+		if err != nil {
+			return err
+		}
+		*freed = hold.Quantity
+	}
+
+	existing, err := getReservation(ctx, tx, reservationID)
+	if err != nil {
+		return err
+	}
+	*result = existing
+
+	if existing.EventID == "" {
+		*available = 0
+		return nil
+	}
+	*available, err = availableSeats(ctx, tx, existing.EventID)
+	return err
+}
+
+// takeSeats marks the reservation's seats as reserved. Racing reservations
+// serialize on the seat row locks; whichever commits second finds a seat taken
+// and rolls back entirely, so a reservation never holds part of its seats.
+func takeSeats(ctx context.Context, tx *sql.Tx, reservation *domain.Reservation) error {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE event_seats
+		SET status = $1, reservation_id = $2
+		WHERE event_id = $3 AND id::text = ANY($4) AND status = $5
+	`,
+		domain.SeatStatusReserved,
+		reservation.ID,
+		reservation.EventID,
+		pq.Array(reservation.SeatIDs),
+		domain.SeatStatusAvailable,
+	)
+	if err != nil {
+		return err
+	}
+
+	taken, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if taken == int64(len(reservation.SeatIDs)) {
+		return nil
+	}
+
+	var known int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM event_seats WHERE event_id = $1 AND id::text = ANY($2)
+	`, reservation.EventID, pq.Array(reservation.SeatIDs)).Scan(&known)
+	if err != nil {
+		return err
+	}
+	if known < len(reservation.SeatIDs) {
+		return domain.ErrSeatNotFound
+	}
+	return domain.ErrSeatUnavailable
+}
+
+// takeTickets takes each item's quantity from its ticket type and records the
+// price it was sold at.
+func takeTickets(ctx context.Context, tx *sql.Tx, reservation *domain.Reservation) error {
+	for _, item := range reservation.Items {
+		err := tx.QueryRowContext(ctx, `
+			UPDATE ticket_types
+			SET available = available - $1
+			WHERE id = $2 AND event_id = $3 AND available >= $1
+				AND (sales_start IS NULL OR sales_start <= $4)
+				AND (sales_end IS NULL OR sales_end > $4)
+			RETURNING price_minor, currency
+		`,
+			item.Quantity,
+			item.TicketTypeID,
+			reservation.EventID,
+			reservation.CreatedAt,
+		).Scan(&item.UnitPriceMinor, &item.Currency)
+		if err == sql.ErrNoRows {
+			return ticketShortfall(ctx, tx, reservation, item)
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO reservation_items (reservation_id, ticket_type_id, quantity, unit_price_minor, currency)
+			VALUES ($1, $2, $3, $4, $5)
+		`,
+			reservation.ID,
+			item.TicketTypeID,
+			item.Quantity,
+			item.UnitPriceMinor,
+			item.Currency,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ticketShortfall explains why an item could not be taken.
+func ticketShortfall(ctx context.Context, tx *sql.Tx, reservation *domain.Reservation, item *domain.ReservationItem) error {
+	ticketType, err := scanTicketType(tx.QueryRowContext(ctx, `
+		SELECT `+ticketTypeColumns+`
+		FROM ticket_types
+		WHERE id = $1 AND event_id = $2
+	`, item.TicketTypeID, reservation.EventID))
+	if err == sql.ErrNoRows {
+		return domain.ErrTicketTypeNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !ticketType.OnSale(reservation.CreatedAt) {
+		return domain.ErrTicketTypeNotOnSale
+	}
+	return domain.ErrInsufficientSeats
+}
+
+func getReservation(ctx context.Context, tx *sql.Tx, id string) (*domain.Reservation, error) {
+	reservation, err := scanReservation(tx.QueryRowContext(ctx, `
+		SELECT `+reservationColumns+`
+		FROM seat_reservations
+		WHERE id = $1
+	`, id))
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Items, err = reservationItems(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+func reservationItems(ctx context.Context, tx *sql.Tx, reservationID string) ([]*domain.ReservationItem, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT ticket_type_id, quantity, unit_price_minor, currency
+		FROM reservation_items
+		WHERE reservation_id = $1
+		ORDER BY ticket_type_id
+	`, reservationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*domain.ReservationItem
+	for rows.Next() {
+		item := &domain.ReservationItem{}
+		if err := rows.Scan(&item.TicketTypeID, &item.Quantity, &item.UnitPriceMinor, &item.Currency); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+func scanReservation(row *sql.Row) (*domain.Reservation, error) {
+	reservation := &domain.Reservation{}
+	var (
+		eventID    sql.NullString
+		releasedAt sql.NullTime
+	)
+	err := row.Scan(
+		&reservation.ID,
+		&eventID,
+		&reservation.Quantity,
+		pq.Array(&reservation.SeatIDs),
+		&reservation.Status,
+		&reservation.CreatedAt,
+		&releasedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	reservation.EventID = eventID.String
+	reservation.ReleasedAt = releasedAt.Time
+
+	return reservation, nil
+}
+
+func availableSeats(ctx context.Context, tx *sql.Tx, eventID string) (int32, error) {
+	var available int32
+	err := tx.QueryRowContext(ctx, `SELECT available_seats FROM events WHERE id = $1`, eventID).Scan(&available)
+	if err == sql.ErrNoRows {
+		return 0, domain.ErrEventNotFound
+	}
+	return available, err
+}
+
+// seatIDsArray encodes seat IDs for a NOT NULL text[] column; pq encodes a nil
+// slice as NULL.
+func seatIDsArray(ids []string) any {
+	if ids == nil {
+		ids = []string{}
+	}
+	return pq.Array(ids)
+}
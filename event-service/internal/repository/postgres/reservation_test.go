@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventRepository_ReserveAfterReleaseReturnsTombstone(t *testing.T) {
+	repo := NewEventRepository(testDB(t))
+	ctx := context.Background()
+
+	event := &domain.Event{
+		Name:       "Late reserve",
+		StartTime:  time.Now().Add(24 * time.Hour),
+		TotalSeats: 10,
+	}
+	require.NoError(t, repo.Create(ctx, event))
+
+	// The release overtook the reserve it was meant to undo.
+	reservationID := uuid.New().String()
+	_, _, err := repo.ReleaseSeats(ctx, reservationID, nil)
+	require.NoError(t, err)
+
+	reservation, available, err := repo.ReserveSeats(ctx, &domain.Reservation{
+		ID:       reservationID,
+		EventID:  event.ID,
+		Quantity: 2,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReservationStatusReleased, reservation.Status)
+	assert.Empty(t, reservation.EventID)
+	assert.Zero(t, available)
+
+	stored, err := repo.GetByID(ctx, event.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int32(10), stored.AvailableSeats)
+}
+
+func TestEventRepository_ReleaseLegacyHoldReturnsSeatsOnce(t *testing.T) {
+	repo := NewEventRepository(testDB(t))
+	ctx := context.Background()
+
+	event := &domain.Event{
+		Name:       "Legacy hold",
+		StartTime:  time.Now().Add(24 * time.Hour),
+		TotalSeats: 10,
+	}
+	require.NoError(t, repo.Create(ctx, event))
+	// A booking from before reservations took its seats by count.
+	_, err := repo.UpdateAvailableSeats(ctx, event.ID, 3)
+	require.NoError(t, err)
+
+	bookingID := uuid.New().String()
+	legacy := &domain.LegacyHold{EventID: event.ID, Quantity: 3}
+	for i := 0; i < 2; i++ {
+		reservation, available, err := repo.ReleaseSeats(ctx, bookingID, legacy)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ReservationStatusReleased, reservation.Status)
+		assert.Equal(t, event.ID, reservation.EventID)
+		assert.Equal(t, int32(10), available)
+	}
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+// ts_headline leaves the text as it is, and names and descriptions are written
+// by organizers, so matches are marked with control characters rather than
+// tags, taken out of the text first. highlight escapes the rest before turning
+// the marks into <mark> tags.
+const (
+	matchStart = "\x02"
+	matchStop  = "\x03"
+
+	// nameHeadline marks every match in the name; descriptionHeadline cuts
+	// the description down to up to two fragments around the matches.
+	nameHeadline        = `ts_headline('english', translate(name, E'\x02\x03', ''), query, E'HighlightAll=true, StartSel=\x02, StopSel=\x03')`
+	descriptionHeadline = `ts_headline('english', translate(description, E'\x02\x03', ''), query, E'MaxFragments=2, MaxWords=30, MinWords=10, StartSel=\x02, StopSel=\x03')`
+)
+
+var markTags = strings.NewReplacer(matchStart, "<mark>", matchStop, "</mark>")
+
+// highlight turns a ts_headline result into HTML.
+func highlight(headline string) string {
+	return markTags.Replace(html.EscapeString(headline))
+}
+
+// searchMatches selects the events matching a search, each with the parsed
+// query as "query". search_vector is kept up to date by a trigger and
+// indexed with GIN.
+func searchMatches(search domain.EventSearch, columns string) (string, *listQuery) {
+	q := &listQuery{}
+	from := ` FROM events, websearch_to_tsquery('english', ` + q.arg(search.Query) + `) AS query`
+	q.where("search_vector @@ query")
+	if search.Category != "" {
+		q.where("category = " + q.arg(search.Category))
+	}
+	if search.Venue != "" {
+		q.where("venue = " + q.arg(search.Venue))
+	}
+	if !search.StartsFrom.IsZero() {
+		q.where("start_time >= " + q.arg(search.StartsFrom))
+	}
+	if !search.StartsBefore.IsZero() {
+		q.where("start_time < " + q.arg(search.StartsBefore))
+	}
+	if search.Status != domain.EventStatusUnspecified {
+		q.where("status = " + q.arg(search.Status))
+	}
+	if search.OrganizerID != "" {
+		q.where("organizer_id = " + q.arg(search.OrganizerID))
+	}
+	return `SELECT ` + columns + from + q.whereClause(), q
+}
+
+func (r *EventRepository) Search(ctx context.Context, search domain.EventSearch, offset, limit int32) ([]*domain.SearchHit, error) {
+	// Only the page is highlighted, which is the costly part.
+	matches, q := searchMatches(search, eventColumns+`, query, ts_rank_cd(search_vector, query, 32) AS rank`)
+	query := `
+		WITH page AS (` + matches + `
+			ORDER BY rank DESC, start_time, id
+			LIMIT ` + q.arg(limit) + ` OFFSET ` + q.arg(offset) + `
+		)
+		SELECT ` + eventColumns + `, rank,
+			` + nameHeadline + `,
+			` + descriptionHeadline + `
+		FROM page
+		ORDER BY rank DESC, start_time, id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []*domain.SearchHit
+	for rows.Next() {
+		hit := &domain.SearchHit{}
+		event, err := scanEvent(searchHitScanner{rows, hit})
+		if err != nil {
+			return nil, err
+		}
+		hit.Event = event
+		hit.NameHighlight = highlight(hit.NameHighlight)
+		hit.DescriptionHighlight = highlight(hit.DescriptionHighlight)
+		hits = append(hits, hit)
+	}
+
+	return hits, rows.Err()
+}
+
+// searchHitScanner scans an event followed by the rank and highlights of a
+// search hit.
+type searchHitScanner struct {
+	row rowScanner
+	hit *domain.SearchHit
+}
+
+func (s searchHitScanner) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, &s.hit.Rank, &s.hit.NameHighlight, &s.hit.DescriptionHighlight)...)
+}
+
+func (r *EventRepository) SearchFacets(ctx context.Context, search domain.EventSearch) (*domain.SearchFacets, error) {
+	matches, q := searchMatches(search, `category, venue, to_char(start_time, 'YYYY-MM') AS month`)
+	query := `
+		SELECT
+			CASE WHEN GROUPING(category) = 0 THEN 'category' WHEN GROUPING(venue) = 0 THEN 'venue' ELSE 'month' END,
+			COALESCE(category, venue, month),
+			COUNT(*)
+		FROM (` + matches + `) AS matches
+		GROUP BY GROUPING SETS ((category), (venue), (month))
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	facets := &domain.SearchFacets{}
+	for rows.Next() {
+		var facet string
+		var count domain.FacetCount
+		if err := rows.Scan(&facet, &count.Value, &count.Count); err != nil {
+			return nil, err
+		}
+		if count.Value == "" {
+			continue
+		}
+		switch facet {
+		case "category":
+			facets.Categories = append(facets.Categories, count)
+		case "venue":
+			facets.Venues = append(facets.Venues, count)
+		default:
+			facets.Months = append(facets.Months, count)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(facets.Months, func(i, j int) bool {
+		return facets.Months[i].Value < facets.Months[j].Value
+	})
+	return facets, nil
+}
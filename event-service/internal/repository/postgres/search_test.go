@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testDB connects to the migrated database in TEST_DATABASE_URL and skips the
+// test when it is not set.
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	db, err := sql.Open("postgres", url)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	require.NoError(t, db.Ping())
+	return db
+}
+
+func TestHighlight(t *testing.T) {
+	got := highlight(matchStart + "Jazz" + matchStop + ` <script>alert("x")</script> & co`)
+
+	assert.Equal(t, `<mark>Jazz</mark> &lt;script&gt;alert(&#34;x&#34;)&lt;/script&gt; &amp; co`, got)
+}
+
+func TestEventRepository_SearchEscapesHighlights(t *testing.T) {
+	repo := NewEventRepository(testDB(t))
+	ctx := context.Background()
+
+	// The venue keeps other events in the database out of the results.
+	venue := uuid.New().String()
+	require.NoError(t, repo.Create(ctx, &domain.Event{
+		Name: `<script>alert(1)</script> Jazz "Night" & more` + matchStart,
+		EventDetails: domain.EventDetails{
+			Description: `Late jazz <img src=x onerror=alert(1)> at the club`,
+			Venue:       venue,
+		},
+		StartTime:  time.Now().Add(24 * time.Hour),
+		TotalSeats: 10,
+	}))
+
+	hits, err := repo.Search(ctx, domain.EventSearch{Query: "jazz", Venue: venue}, 0, 10)
+
+	require.NoError(t, err)
+	require.Len(t, hits, 1)
+	assert.Contains(t, hits[0].NameHighlight, "<mark>Jazz</mark>")
+	assert.Contains(t, hits[0].DescriptionHighlight, "<mark>jazz</mark>")
+	for _, text := range []string{hits[0].NameHighlight, hits[0].DescriptionHighlight} {
+		assert.NotContains(t, text, "<script")
+		assert.NotContains(t, text, "<img")
+		assert.NotContains(t, text, `"`)
+		// The marker character in the name does not open a mark of its own.
+		assert.Equal(t, 1, strings.Count(text, "<mark>"), text)
+	}
+}
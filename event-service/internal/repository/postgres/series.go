@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const seriesColumns = `id, name, description, venue, category, tags, rrule, first_start, time_zone, total_seats, layout_id, venue_id, organizer_id, publish, until, generated_until, finished, created_at`
+
+type SeriesRepository struct {
+	db *sql.DB
+}
+
+func NewSeriesRepository(db *sql.DB) *SeriesRepository {
+	return &SeriesRepository{db: db}
+}
+
+func (r *SeriesRepository) Create(ctx context.Context, series *domain.EventSeries, occurrences []*domain.Event) error {
+	series.ID = uuid.New().String()
+	series.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO event_series (` + seriesColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	`
+
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, query,
+			series.ID,
+			series.Name,
+			series.Description,
+			series.Venue,
+			series.Category,
+			tagsArray(series.Tags),
+			series.RRule,
+			series.FirstStart,
+			series.TimeZone,
+			series.TotalSeats,
+			nullString(series.LayoutID),
+			nullString(series.VenueID),
+			nullString(series.OrganizerID),
+			series.Publish,
+			nullTime(series.Until),
+			series.GeneratedUntil,
+			series.Finished,
+			series.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+		return insertOccurrences(ctx, tx, series.ID, occurrences)
+	})
+}
+
+func (r *SeriesRepository) GetByID(ctx context.Context, id string) (*domain.EventSeries, error) {
+	query := `
+		SELECT ` + seriesColumns + `
+		FROM event_series
+		WHERE id = $1
+	`
+
+	series, err := scanSeries(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (r *SeriesRepository) ListDue(ctx context.Context, horizon time.Time, limit int) ([]*domain.EventSeries, error) {
+	query := `
+		SELECT ` + seriesColumns + `
+		FROM event_series
+		WHERE NOT finished AND generated_until < $1
+		ORDER BY generated_until
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, horizon, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var due []*domain.EventSeries
+	for rows.Next() {
+		series, err := scanSeries(rows)
+		if err != nil {
+			return nil, err
+		}
+		due = append(due, series)
+	}
+
+	return due, rows.Err()
+}
+
+func (r *SeriesRepository) AddOccurrences(ctx context.Context, series *domain.EventSeries, previousUntil time.Time, occurrences []*domain.Event) (bool, error) {
+	// The conditions fail if another run got there first, or if the series
+	// was resized or ended after it was loaded.
+	query := `
+		UPDATE event_series
+		SET generated_until = $1, finished = $2
+		WHERE id = $3 AND generated_until = $4 AND total_seats = $5 AND until IS NOT DISTINCT FROM $6
+	`
+
+	var added bool
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query,
+			series.GeneratedUntil,
+			series.Finished,
+			series.ID,
+			previousUntil,
+			series.TotalSeats,
+			nullTime(series.Until),
+		)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			return err
+		}
+
+		added = true
+		return insertOccurrences(ctx, tx, series.ID, occurrences)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return added, nil
+}
+
+func (r *SeriesRepository) SetTotalSeats(ctx context.Context, id string, totalSeats int32, from time.Time) (int32, error) {
+	var changed int32
+	err := withTx(ctx, r.db, func(tx *sql.Tx) error {
+		// Updating the series first holds off runs adding occurrences with
+		// the old capacity.
+		result, err := tx.ExecContext(ctx, `UPDATE event_series SET total_seats = $1 WHERE id = $2`, totalSeats, id)
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return domain.ErrSeriesNotFound
+		}
+
+		var q listQuery
+		q.where("series_id = " + q.arg(id))
+		q.where("start_time >= " + q.arg(from))
+		q.where("NOT seats_overridden")
+		changed, err = resizeEvents(ctx, tx, q, totalSeats)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return changed, nil
+}
+
+func (r *SeriesRepository) End(ctx context.Context, id string, until time.Time) error {
+	query := `
+		UPDATE event_series
+		SET until = LEAST(COALESCE(until, $1), $1)
+		WHERE id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, until, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return domain.ErrSeriesNotFound
+	}
+	return nil
+}
+
+func insertOccurrences(ctx context.Context, tx *sql.Tx, seriesID string, occurrences []*domain.Event) error {
+	for _, occurrence := range occurrences {
+		occurrence.SeriesID = seriesID
+		if err := insertEvent(ctx, tx, occurrence); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanSeries(row rowScanner) (*domain.EventSeries, error) {
+	series := &domain.EventSeries{}
+	var layoutID, venueID, organizerID sql.NullString
+	var until sql.NullTime
+	var tags pq.StringArray
+	err := row.Scan(
+		&series.ID,
+		&series.Name,
+		&series.Description,
+		&series.Venue,
+		&series.Category,
+		&tags,
+		&series.RRule,
+		&series.FirstStart,
+		&series.TimeZone,
+		&series.TotalSeats,
+		&layoutID,
+		&venueID,
+		&organizerID,
+		&series.Publish,
+		&until,
+		&series.GeneratedUntil,
+		&series.Finished,
+		&series.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	series.LayoutID = layoutID.String
+	series.VenueID = venueID.String
+	series.OrganizerID = organizerID.String
+	series.Until = until.Time
+	if len(tags) > 0 {
+		series.Tags = tags
+	}
+
+	return series, nil
+}
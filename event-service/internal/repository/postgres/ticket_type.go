@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+const ticketTypeColumns = `id, event_id, name, capacity, available, price_minor, currency, sales_start, sales_end, created_at`
+
+type TicketTypeRepository struct {
+	db *sql.DB
+}
+
+func NewTicketTypeRepository(db *sql.DB) *TicketTypeRepository {
+	return &TicketTypeRepository{db: db}
+}
+
+func (r *TicketTypeRepository) Create(ctx context.Context, ticketType *domain.TicketType) error {
+	ticketType.ID = uuid.New().String()
+	ticketType.CreatedAt = time.Now()
+	ticketType.Available = ticketType.Capacity
+
+	query := `
+		INSERT INTO ticket_types (` + ticketTypeColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		ticketType.ID,
+		ticketType.EventID,
+		ticketType.Name,
+		ticketType.Capacity,
+		ticketType.Available,
+		ticketType.PriceMinor,
+		ticketType.Currency,
+		nullTime(ticketType.SalesStart),
+		nullTime(ticketType.SalesEnd),
+		ticketType.CreatedAt,
+	)
+
+	return err
+}
+
+func (r *TicketTypeRepository) GetByID(ctx context.Context, id string) (*domain.TicketType, error) {
+	query := `
+		SELECT ` + ticketTypeColumns + `
+		FROM ticket_types
+		WHERE id = $1
+	`
+
+	ticketType, err := scanTicketType(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ticketType, nil
+}
+
+func (r *TicketTypeRepository) ListByEventID(ctx context.Context, eventID string) ([]*domain.TicketType, error) {
+	query := `
+		SELECT ` + ticketTypeColumns + `
+		FROM ticket_types
+		WHERE event_id = $1
+		ORDER BY price_minor, name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ticketTypes []*domain.TicketType
+	for rows.Next() {
+		ticketType, err := scanTicketType(rows)
+		if err != nil {
+			return nil, err
+		}
+		ticketTypes = append(ticketTypes, ticketType)
+	}
+
+	return ticketTypes, rows.Err()
+}
+
+// Update changes the name, price and sale window. Capacity and currency are
+// fixed once created.
+func (r *TicketTypeRepository) Update(ctx context.Context, ticketType *domain.TicketType) error {
+	query := `
+		UPDATE ticket_types
+		SET name = $1, price_minor = $2, sales_start = $3, sales_end = $4
+		WHERE id = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		ticketType.Name,
+		ticketType.PriceMinor,
+		nullTime(ticketType.SalesStart),
+		nullTime(ticketType.SalesEnd),
+		ticketType.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanTicketType(row rowScanner) (*domain.TicketType, error) {
+	ticketType := &domain.TicketType{}
+	var salesStart, salesEnd sql.NullTime
+	err := row.Scan(
+		&ticketType.ID,
+		&ticketType.EventID,
+		&ticketType.Name,
+		&ticketType.Capacity,
+		&ticketType.Available,
+		&ticketType.PriceMinor,
+		&ticketType.Currency,
+		&salesStart,
+		&salesEnd,
+		&ticketType.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ticketType.SalesStart = salesStart.Time
+	ticketType.SalesEnd = salesEnd.Time
+
+	return ticketType, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+const venueColumns = `id, name, street, city, region, postal_code, country_code, latitude, longitude, time_zone, capacity, organizer_id, created_at`
+
+type VenueRepository struct {
+	db *sql.DB
+}
+
+func NewVenueRepository(db *sql.DB) *VenueRepository {
+	return &VenueRepository{db: db}
+}
+
+func (r *VenueRepository) Create(ctx context.Context, venue *domain.Venue) error {
+	venue.ID = uuid.New().String()
+	venue.CreatedAt = time.Now()
+
+	query := `
+		INSERT INTO venues (` + venueColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	latitude, longitude := nullLocation(venue.Location)
+	_, err := r.db.ExecContext(ctx, query,
+		venue.ID,
+		venue.Name,
+		venue.Address.Street,
+		venue.Address.City,
+		venue.Address.Region,
+		venue.Address.PostalCode,
+		venue.Address.CountryCode,
+		latitude,
+		longitude,
+		venue.TimeZone,
+		venue.Capacity,
+		nullString(venue.OrganizerID),
+		venue.CreatedAt,
+	)
+
+	return err
+}
+
+func (r *VenueRepository) GetByID(ctx context.Context, id string) (*domain.Venue, error) {
+	query := `
+		SELECT ` + venueColumns + `
+		FROM venues
+		WHERE id = $1
+	`
+
+	venue, err := scanVenue(r.db.QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
+func (r *VenueRepository) List(ctx context.Context, filter domain.VenueFilter, after *domain.PageCursor, limit int32) ([]*domain.Venue, error) {
+	order := domain.SortOrder{Column: "created_at"}
+
+	var q listQuery
+	if filter.City != "" {
+		q.where("lower(city) = lower(" + q.arg(filter.City) + ")")
+	}
+	if filter.CountryCode != "" {
+		q.where("country_code = " + q.arg(filter.CountryCode))
+	}
+	q.after(order, after)
+
+	query := `SELECT ` + venueColumns + ` FROM venues` + q.clauses(order, limit)
+	rows, err := r.db.QueryContext(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var venues []*domain.Venue
+	for rows.Next() {
+		venue, err := scanVenue(rows)
+		if err != nil {
+			return nil, err
+		}
+		venues = append(venues, venue)
+	}
+
+	return venues, rows.Err()
+}
+
+func (r *VenueRepository) Update(ctx context.Context, venue *domain.Venue) error {
+	// The capacity may not drop below the seats of a live event or an
+	// unfinished series at the venue.
+	query := `
+		UPDATE venues
+		SET name = $1, street = $2, city = $3, region = $4, postal_code = $5, country_code = $6,
+			latitude = $7, longitude = $8, time_zone = $9, capacity = $10
+		WHERE id = $11 AND $10 >= (
+			SELECT COALESCE(MAX(total_seats), 0)
+			FROM events
+			WHERE venue_id = $11 AND status NOT IN ($12, $13)
+		) AND $10 >= (
+			SELECT COALESCE(MAX(total_seats), 0)
+			FROM event_series
+			WHERE venue_id = $11 AND NOT finished
+		)
+	`
+
+	latitude, longitude := nullLocation(venue.Location)
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query,
+			venue.Name,
+			venue.Address.Street,
+			venue.Address.City,
+			venue.Address.Region,
+			venue.Address.PostalCode,
+			venue.Address.CountryCode,
+			latitude,
+			longitude,
+			venue.TimeZone,
+			venue.Capacity,
+			venue.ID,
+			domain.EventStatusCancelled,
+			domain.EventStatusCompleted,
+		)
+		if err != nil {
+			return err
+		}
+		if err := venueAffected(ctx, tx, result, venue.ID, domain.ErrVenueCapacity); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE events
+			SET venue = $1, time_zone = $2, version = version + 1
+			WHERE venue_id = $3 AND (venue, time_zone) IS DISTINCT FROM ($1, $2)
+		`, venue.Name, venue.TimeZone, venue.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE event_series
+			SET venue = $1, time_zone = $2
+			WHERE venue_id = $3 AND (venue, time_zone) IS DISTINCT FROM ($1, $2)
+		`, venue.Name, venue.TimeZone, venue.ID)
+		return err
+	})
+}
+
+func (r *VenueRepository) Delete(ctx context.Context, id string) error {
+	return withTx(ctx, r.db, func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			DELETE FROM venues
+			WHERE id = $1
+				AND NOT EXISTS (SELECT 1 FROM events WHERE venue_id = $1)
+				AND NOT EXISTS (SELECT 1 FROM event_series WHERE venue_id = $1)
+		`, id)
+		if err != nil {
+			return err
+		}
+		return venueAffected(ctx, tx, result, id, domain.ErrVenueInUse)
+	})
+}
+
+// venueAffected returns nil if the statement changed the venue. Otherwise it
+// returns ErrVenueNotFound if the venue is gone, or else refused, the error
+// of the condition that kept it unchanged.
+func venueAffected(ctx context.Context, tx *sql.Tx, result sql.Result, id string, refused error) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil || rowsAffected > 0 {
+		return err
+	}
+
+	var exists bool
+	if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM venues WHERE id = $1)`, id).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrVenueNotFound
+	}
+	return refused
+}
+
+func scanVenue(row rowScanner) (*domain.Venue, error) {
+	venue := &domain.Venue{}
+	var latitude, longitude sql.NullFloat64
+	var organizerID sql.NullString
+	err := row.Scan(
+		&venue.ID,
+		&venue.Name,
+		&venue.Address.Street,
+		&venue.Address.City,
+		&venue.Address.Region,
+		&venue.Address.PostalCode,
+		&venue.Address.CountryCode,
+		&latitude,
+		&longitude,
+		&venue.TimeZone,
+		&venue.Capacity,
+		&organizerID,
+		&venue.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if latitude.Valid && longitude.Valid {
+		venue.Location = &domain.GeoPoint{Latitude: latitude.Float64, Longitude: longitude.Float64}
+	}
+	venue.OrganizerID = organizerID.String
+
+	return venue, nil
+}
+
+func nullLocation(location *domain.GeoPoint) (sql.NullFloat64, sql.NullFloat64) {
+	if location == nil {
+		return sql.NullFloat64{}, sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: location.Latitude, Valid: true}, sql.NullFloat64{Float64: location.Longitude, Valid: true}
+}
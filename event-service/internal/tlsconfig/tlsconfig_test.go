@@ -0,0 +1,223 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/credentials"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+var serial int64
+
+func newCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	serial++
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate for dnsName, signed by the CA, to dir and
+// returns its serial number.
+func (ca *testCA) issue(t *testing.T, dir, name, dnsName string) int64 {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	serial++
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	// The key is written first, as a rotation would, so the pair briefly
+	// mismatches on disk.
+	writeFile(t, filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	writeFile(t, filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	return serial
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	tmp := path + ".tmp"
+	assert.NoError(t, os.WriteFile(tmp, data, 0o600))
+	assert.NoError(t, os.Rename(tmp, path))
+}
+
+func files(dir, name string) Config {
+	return Config{
+		CertFile: filepath.Join(dir, name+".crt"),
+		KeyFile:  filepath.Join(dir, name+".key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+}
+
+// handshake connects client to server over loopback and returns what each
+// side saw of the other.
+func handshake(t *testing.T, server, client credentials.TransportCredentials) (serverInfo, clientInfo credentials.AuthInfo, serverErr, clientErr error) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer lis.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr = err
+			return
+		}
+		defer conn.Close()
+		_, serverInfo, serverErr = server.ServerHandshake(conn)
+	}()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var tlsConn net.Conn
+	tlsConn, clientInfo, clientErr = client.ClientHandshake(ctx, "event-service:9091", conn)
+	if clientErr == nil {
+		// With TLS 1.3 the server checks the client's certificate after the
+		// client has finished; a read surfaces its verdict.
+		tlsConn.SetReadDeadline(time.Now().Add(time.Second))
+		tlsConn.Read(make([]byte, 1))
+	}
+	conn.Close()
+	<-done
+	return serverInfo, clientInfo, serverErr, clientErr
+}
+
+func serverSerial(info credentials.AuthInfo) int64 {
+	tlsInfo, ok := info.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return 0
+	}
+	return tlsInfo.State.PeerCertificates[0].SerialNumber.Int64()
+}
+
+func setup(t *testing.T, allowedSANs ...string) (dir string, ca *testCA, server *Reloader) {
+	t.Helper()
+	dir = t.TempDir()
+	ca = newCA(t)
+	writeFile(t, filepath.Join(dir, "ca.crt"), ca.pem)
+	ca.issue(t, dir, "server", "event-service")
+
+	cfg := files(dir, "server")
+	cfg.AllowedSANs = allowedSANs
+	server, err := NewReloader(cfg)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { server.Close() })
+	return dir, ca, server
+}
+
+func newClient(t *testing.T, cfg Config) *Reloader {
+	t.Helper()
+	client, err := NewReloader(cfg)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir, ca, server := setup(t)
+	ca.issue(t, dir, "client", "booking-service")
+	client := newClient(t, files(dir, "client"))
+
+	serverInfo, _, serverErr, clientErr := handshake(t, server.ServerCredentials(), client.ClientCredentials())
+
+	assert.NoError(t, serverErr)
+	assert.NoError(t, clientErr)
+	if tlsInfo, ok := serverInfo.(credentials.TLSInfo); assert.True(t, ok) && assert.NotEmpty(t, tlsInfo.State.PeerCertificates) {
+		assert.Equal(t, []string{"booking-service"}, tlsInfo.State.PeerCertificates[0].DNSNames)
+	}
+}
+
+func TestMutualTLS_RequiresClientCertificate(t *testing.T) {
+	dir, _, server := setup(t)
+	client := newClient(t, Config{CAFile: filepath.Join(dir, "ca.crt")})
+
+	_, _, serverErr, _ := handshake(t, server.ServerCredentials(), client.ClientCredentials())
+
+	assert.Error(t, serverErr)
+}
+
+func TestMutualTLS_AllowedSANs(t *testing.T) {
+	dir, ca, server := setup(t, "booking-service")
+
+	ca.issue(t, dir, "allowed", "booking-service")
+	_, _, serverErr, clientErr := handshake(t, server.ServerCredentials(), newClient(t, files(dir, "allowed")).ClientCredentials())
+	assert.NoError(t, serverErr)
+	assert.NoError(t, clientErr)
+
+	ca.issue(t, dir, "other", "payments-service")
+	_, _, serverErr, _ = handshake(t, server.ServerCredentials(), newClient(t, files(dir, "other")).ClientCredentials())
+	assert.ErrorIs(t, serverErr, ErrSANNotAllowed)
+}
+
+func TestReloader_PicksUpRotatedCertificate(t *testing.T) {
+	dir, ca, server := setup(t)
+	ca.issue(t, dir, "client", "booking-service")
+	client := newClient(t, files(dir, "client"))
+	creds := server.ServerCredentials()
+
+	_, before, _, err := handshake(t, creds, client.ClientCredentials())
+	assert.NoError(t, err)
+
+	rotated := ca.issue(t, dir, "server", "event-service")
+
+	assert.Eventually(t, func() bool {
+		_, after, _, err := handshake(t, creds, client.ClientCredentials())
+		return err == nil && serverSerial(after) == rotated
+	}, 5*time.Second, 20*time.Millisecond)
+	assert.NotEqual(t, rotated, serverSerial(before))
+}
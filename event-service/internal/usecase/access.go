@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+// authorizeEvent checks that the caller may manage the event: its organizer,
+// an admin or another service. Without an identity, as with authentication
+// disabled, anyone may.
+func authorizeEvent(ctx context.Context, event *domain.Event) error {
+	return authorizeOwner(ctx, event.OrganizerID)
+}
+
+// authorizeOwner checks that the caller is the subject owning something, an
+// admin or another service. Nothing is owned by an empty subject.
+func authorizeOwner(ctx context.Context, owner string) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok || identity.HasRole(auth.RoleAdmin, auth.RoleService) {
+		return nil
+	}
+	if owner == "" || owner != identity.Subject {
+		return domain.ErrPermissionDenied
+	}
+	return nil
+}
+
+// authorizeEventID is authorizeEvent for an event that is not loaded yet. It
+// only loads the event if the caller's roles do not settle the question.
+func (u *EventUsecase) authorizeEventID(ctx context.Context, eventID string) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok || identity.HasRole(auth.RoleAdmin, auth.RoleService) {
+		return nil
+	}
+	event, err := u.getEvent(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	return authorizeEvent(ctx, event)
+}
+
+// visibleStatus limits a listing of events to the status filter the caller
+// may use. Published events are open to everyone and are what callers get by
+// default. Admins and services may filter on any status, organizers only
+// among their own events, to which an unset organizerID is then narrowed.
+func visibleStatus(ctx context.Context, status domain.EventStatus, organizerID *string) (domain.EventStatus, error) {
+	identity, ok := auth.FromContext(ctx)
+	if ok && identity.HasRole(auth.RoleAdmin, auth.RoleService) {
+		return status, nil
+	}
+	if status == domain.EventStatusPublished {
+		return status, nil
+	}
+	if ok && identity.Subject != "" && identity.HasRole(auth.RoleOrganizer) {
+		if *organizerID == identity.Subject {
+			return status, nil
+		}
+		if *organizerID == "" && status != domain.EventStatusUnspecified {
+			*organizerID = identity.Subject
+			return status, nil
+		}
+	}
+	if status == domain.EventStatusUnspecified {
+		return domain.EventStatusPublished, nil
+	}
+	return domain.EventStatusUnspecified, domain.ErrPermissionDenied
+}
+
+// callerSubject returns the subject of the authenticated caller, if any.
+func callerSubject(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.Subject
+	}
+	return ""
+}
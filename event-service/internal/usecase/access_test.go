@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func callerContext(subject string, roles ...auth.Role) context.Context {
+	return auth.WithIdentity(context.Background(), &auth.Identity{Subject: subject, Roles: roles})
+}
+
+func organizedEvent() *domain.Event {
+	return &domain.Event{
+		ID:          "event-1",
+		Name:        "Concert",
+		Status:      domain.EventStatusPublished,
+		OrganizerID: "org-1",
+	}
+}
+
+func TestCreateEvent_RecordsOrganizer(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+	ctx := callerContext("org-1", auth.RoleOrganizer)
+
+	repo.On("Create", ctx, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.OrganizerID == "org-1"
+	})).Return(nil)
+
+	event, err := uc.CreateEvent(ctx, "Concert", domain.EventDetails{}, time.Now().Add(24*time.Hour), 100, "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "org-1", event.OrganizerID)
+}
+
+func TestGetEvent_DraftVisibility(t *testing.T) {
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		visible bool
+	}{
+		{"anonymous", context.Background(), false},
+		{"customer", callerContext("user-1", auth.RoleCustomer), false},
+		{"other organizer", callerContext("org-2", auth.RoleOrganizer), false},
+		{"organizer", callerContext("org-1", auth.RoleOrganizer), true},
+		{"admin", callerContext("admin-1", auth.RoleAdmin), true},
+		{"service", callerContext("booking-service", auth.RoleService), true},
+	}
+
+	for _, tc := range cases {
+		repo := new(mocks.MockEventRepository)
+		uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+		draft := organizedEvent()
+		draft.Status = domain.EventStatusDraft
+		repo.On("GetByID", mock.Anything, "event-1").Return(draft, nil)
+
+		event, err := uc.GetEvent(tc.ctx, "event-1")
+
+		if tc.visible {
+			assert.NoError(t, err, tc.name)
+			assert.Equal(t, "event-1", event.ID, tc.name)
+		} else {
+			assert.ErrorIs(t, err, domain.ErrEventNotFound, tc.name)
+			assert.Nil(t, event, tc.name)
+		}
+	}
+}
+
+func TestGetEvent_PublishedVisibleToAnyone(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(organizedEvent(), nil)
+
+	event, err := uc.GetEvent(callerContext("user-1", auth.RoleCustomer), "event-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "event-1", event.ID)
+}
+
+func TestUpdateEvent_OtherOrganizersEvent(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(organizedEvent(), nil)
+
+	_, err := uc.UpdateEvent(callerContext("org-2", auth.RoleOrganizer), "event-1", rename("Renamed"))
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateEvent_OwnEventAndAdmin(t *testing.T) {
+	for _, ctx := range []context.Context{
+		callerContext("org-1", auth.RoleOrganizer),
+		callerContext("admin-1", auth.RoleAdmin),
+	} {
+		repo := new(mocks.MockEventRepository)
+		uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+		repo.On("GetByID", mock.Anything, "event-1").Return(organizedEvent(), nil)
+		repo.On("Update", mock.Anything, mock.Anything).Return(true, nil)
+
+		event, err := uc.UpdateEvent(ctx, "event-1", rename("Renamed"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Renamed", event.Name)
+	}
+}
+
+func TestCancelEvent_OtherOrganizersEvent(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	bookings := new(mocks.MockBookingClient)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), bookings, testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(organizedEvent(), nil)
+
+	_, _, err := uc.CancelEvent(callerContext("org-2", auth.RoleOrganizer), "event-1")
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	bookings.AssertNotCalled(t, "CancelEventBookings", mock.Anything, mock.Anything)
+}
+
+func TestUpdateTicketType_OtherOrganizersEvent(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	ticketTypes := new(mocks.MockTicketTypeRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), ticketTypes, new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	ticketTypes.On("GetByID", mock.Anything, "tt-1").Return(&domain.TicketType{ID: "tt-1", EventID: "event-1", Name: "GA", Currency: "EUR"}, nil)
+	repo.On("GetByID", mock.Anything, "event-1").Return(organizedEvent(), nil)
+
+	_, err := uc.UpdateTicketType(callerContext("org-2", auth.RoleOrganizer), &domain.TicketType{ID: "tt-1", Name: "VIP"})
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	ticketTypes.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
@@ -2,34 +2,75 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/client"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/metrics"
 )
 
 type EventUsecase struct {
-	repo domain.EventRepository
+	repo        domain.EventRepository
+	layouts     domain.LayoutRepository
+	ticketTypes domain.TicketTypeRepository
+	venues      domain.VenueRepository
+	organizers  domain.OrganizerRepository
+	series      domain.SeriesRepository
+	bookings    client.BookingClient
+	// seriesHorizon is how far ahead the occurrences of a series are
+	// created.
+	seriesHorizon time.Duration
 }
 
-func NewEventUsecase(repo domain.EventRepository) *EventUsecase {
-	return &EventUsecase{repo: repo}
+func NewEventUsecase(repo domain.EventRepository, layouts domain.LayoutRepository, ticketTypes domain.TicketTypeRepository, venues domain.VenueRepository, organizers domain.OrganizerRepository, series domain.SeriesRepository, bookings client.BookingClient, seriesHorizon time.Duration) *EventUsecase {
+	return &EventUsecase{
+		repo:          repo,
+		layouts:       layouts,
+		ticketTypes:   ticketTypes,
+		venues:        venues,
+		organizers:    organizers,
+		series:        series,
+		bookings:      bookings,
+		seriesHorizon: seriesHorizon,
+	}
 }
 
-func (u *EventUsecase) CreateEvent(ctx context.Context, name string, startTime time.Time, totalSeats int32) (*domain.Event, error) {
-	if name == "" {
+// CreateEvent creates a draft event. An event linked to a venue takes the
+// venue's name instead of details.Venue; only the venue's owner may link it.
+func (u *EventUsecase) CreateEvent(ctx context.Context, name string, details domain.EventDetails, startTime time.Time, totalSeats int32, layoutID, venueID string) (*domain.Event, error) {
+	if name == "" || (venueID != "" && details.Venue != "") {
 		return nil, domain.ErrInvalidInput
 	}
-	if totalSeats <= 0 {
-		return nil, domain.ErrInvalidInput
+	details, err := cleanDetails(details)
+	if err != nil {
+		return nil, err
+	}
+	totalSeats, err = u.eventSeats(ctx, totalSeats, layoutID)
+	if err != nil {
+		return nil, err
 	}
 	if startTime.IsZero() {
 		return nil, domain.ErrInvalidInput
 	}
 
 	event := &domain.Event{
-		Name:       name,
-		StartTime:  startTime,
-		TotalSeats: totalSeats,
+		Name:         name,
+		EventDetails: details,
+		StartTime:    startTime,
+		TotalSeats:   totalSeats,
+		LayoutID:     layoutID,
+		OrganizerID:  callerSubject(ctx),
+	}
+	if venueID != "" {
+		venue, err := u.linkableVenue(ctx, venueID)
+		if err != nil {
+			return nil, err
+		}
+		if err := linkVenue(event, venue); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := u.repo.Create(ctx, event); err != nil {
@@ -39,7 +80,47 @@ func (u *EventUsecase) CreateEvent(ctx context.Context, name string, startTime t
 	return event, nil
 }
 
+// eventSeats checks the capacity of a new event, which for assigned seating
+// is the layout's seat count. An explicit total must agree with it.
+func (u *EventUsecase) eventSeats(ctx context.Context, totalSeats int32, layoutID string) (int32, error) {
+	if layoutID != "" {
+		layout, err := u.GetLayout(ctx, layoutID)
+		if err != nil {
+			return 0, err
+		}
+		layoutSeats := int32(len(layout.Seats))
+		if totalSeats == 0 {
+			totalSeats = layoutSeats
+		}
+		if totalSeats != layoutSeats {
+			return 0, domain.ErrInvalidInput
+		}
+	}
+	if totalSeats <= 0 {
+		return 0, domain.ErrInvalidInput
+	}
+	return totalSeats, nil
+}
+
+// GetEvent returns an event the caller may see, by the rules ListEvents
+// applies to a status filter: published events for everyone, and any event to
+// its organizer, admins and services. Other events are reported as not found.
 func (u *EventUsecase) GetEvent(ctx context.Context, eventID string) (*domain.Event, error) {
+	event, err := u.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	organizerID := event.OrganizerID
+	if _, err := visibleStatus(ctx, event.Status, &organizerID); err != nil || organizerID != event.OrganizerID {
+		return nil, domain.ErrEventNotFound
+	}
+
+	return event, nil
+}
+
+// getEvent loads an event whatever its status, for callers that authorize
+// the caller themselves.
+func (u *EventUsecase) getEvent(ctx context.Context, eventID string) (*domain.Event, error) {
 	if eventID == "" {
 		return nil, domain.ErrInvalidInput
 	}
@@ -55,8 +136,58 @@ func (u *EventUsecase) GetEvent(ctx context.Context, eventID string) (*domain.Ev
 	return event, nil
 }
 
-func (u *EventUsecase) ListEvents(ctx context.Context, limit, offset int32) ([]*domain.Event, int32, error) {
-	return u.repo.List(ctx, limit, offset)
+const defaultEventPageSize = 10
+
+// eventOrders are the orders ListEvents allows.
+var eventOrders = map[string]domain.SortOrder{
+	"":                {Column: "start_time"},
+	"start_time":      {Column: "start_time"},
+	"start_time desc": {Column: "start_time", Desc: true},
+	"created_at":      {Column: "created_at"},
+	"created_at desc": {Column: "created_at", Desc: true},
+}
+
+func (u *EventUsecase) ListEvents(ctx context.Context, filter domain.EventFilter, page domain.PageRequest) ([]*domain.Event, string, error) {
+	order, err := sortOrder(eventOrders, page.OrderBy)
+	if err != nil {
+		return nil, "", err
+	}
+	size, err := pageSize(page.Size, defaultEventPageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	if !filter.StartsFrom.IsZero() && !filter.StartsBefore.IsZero() && !filter.StartsBefore.After(filter.StartsFrom) {
+		return nil, "", domain.ErrInvalidInput
+	}
+	filter.Status, err = visibleStatus(ctx, filter.Status, &filter.OrganizerID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := fmt.Sprintf("%s|%t|%d|%d|%d|%q|%t|%q|%q|%q", order.Column, order.Desc,
+		filter.StartsFrom.UnixNano(), filter.StartsBefore.UnixNano(), filter.Status, filter.NameContains, filter.HasAvailability,
+		filter.OrganizerID, filter.VenueID, filter.SeriesID)
+	after, err := decodePageToken(page.Token, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// One more than the page tells whether there is a next one.
+	events, err := u.repo.List(ctx, filter, order, after, size+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(events) <= int(size) {
+		return events, "", nil
+	}
+
+	events = events[:size]
+	last := events[size-1]
+	key := last.StartTime
+	if order.Column == "created_at" {
+		key = last.CreatedAt
+	}
+	return events, encodePageToken(domain.PageCursor{Key: key, ID: last.ID}, query), nil
 }
 
 func (u *EventUsecase) UpdateAvailableTickets(ctx context.Context, eventID string, quantity int32) (int32, error) {
@@ -74,8 +205,13 @@ func (u *EventUsecase) UpdateAvailableTickets(ctx context.Context, eventID strin
 	if event == nil {
 		return 0, domain.ErrEventNotFound
 	}
+	// A bare count change would leave the seat map out of step.
+	if event.LayoutID != "" {
+		return 0, domain.ErrSeatingMismatch
+	}
 
 	if quantity > 0 && event.AvailableSeats < quantity {
+		metrics.InsufficientSeats.WithLabelValues("update").Inc()
 		return 0, domain.ErrInsufficientSeats
 	}
 
@@ -84,8 +220,100 @@ func (u *EventUsecase) UpdateAvailableTickets(ctx context.Context, eventID strin
 		return 0, err
 	}
 	if newAvailable == 0 && quantity > 0 {
+		metrics.InsufficientSeats.WithLabelValues("update").Inc()
 		return 0, domain.ErrInsufficientSeats
 	}
 
 	return newAvailable, nil
 }
+
+// ReserveSeats takes quantity seats of a general admission event, or the given
+// seats of an assigned-seating event. Events with ticket types are sold through
+// items, whose quantities must add up to the total. With seat IDs or items,
+// quantity may be left zero.
+func (u *EventUsecase) ReserveSeats(ctx context.Context, reservationID, eventID string, quantity int32, seatIDs []string, items []*domain.ReservationItem) (*domain.Reservation, int32, error) {
+	if reservationID == "" || eventID == "" {
+		return nil, 0, domain.ErrInvalidInput
+	}
+	if len(items) > 0 {
+		itemTotal, ok := itemQuantity(items)
+		if !ok {
+			return nil, 0, domain.ErrInvalidInput
+		}
+		if quantity == 0 {
+			quantity = itemTotal
+		}
+		if quantity != itemTotal {
+			return nil, 0, domain.ErrInvalidInput
+		}
+	}
+	if len(seatIDs) > 0 {
+		if quantity == 0 {
+			quantity = int32(len(seatIDs))
+		}
+		if quantity != int32(len(seatIDs)) || !uniqueSeats(seatIDs) {
+			return nil, 0, domain.ErrInvalidInput
+		}
+	}
+	if quantity <= 0 {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	reservation, available, err := u.repo.ReserveSeats(ctx, &domain.Reservation{
+		ID:       reservationID,
+		EventID:  eventID,
+		Quantity: quantity,
+		SeatIDs:  seatIDs,
+		Items:    items,
+	})
+	if err != nil {
+		countInsufficientSeats(err, "reserve")
+		return nil, 0, err
+	}
+
+	if reservation.Status == domain.ReservationStatusReleased {
+		return nil, 0, domain.ErrReservationReleased
+	}
+	// A retried reserve must carry the same parameters as the original.
+	if reservation.EventID != eventID ||
+		reservation.Quantity != quantity ||
+		!sameSeats(reservation.SeatIDs, seatIDs) ||
+		!sameItems(reservation.Items, items) {
+		return nil, 0, domain.ErrReservationConflict
+	}
+
+	return reservation, available, nil
+}
+
+func (u *EventUsecase) ReleaseSeats(ctx context.Context, reservationID string, legacy *domain.LegacyHold) (*domain.Reservation, int32, error) {
+	if reservationID == "" {
+		return nil, 0, domain.ErrInvalidInput
+	}
+	if legacy != nil && (legacy.EventID == "" || legacy.Quantity <= 0) {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	return u.repo.ReleaseSeats(ctx, reservationID, legacy)
+}
+
+// ResizeReservation changes how many seats a held reservation keeps. The
+// quantity is absolute, so repeating a call is a no-op.
+func (u *EventUsecase) ResizeReservation(ctx context.Context, reservationID string, quantity int32) (*domain.Reservation, int32, error) {
+	if reservationID == "" || quantity <= 0 {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	reservation, available, err := u.repo.ResizeSeats(ctx, reservationID, quantity)
+	if err != nil {
+		countInsufficientSeats(err, "resize")
+		return nil, 0, err
+	}
+
+	return reservation, available, nil
+}
+
+func countInsufficientSeats(err error, operation string) {
+	if errors.Is(err, domain.ErrInsufficientSeats) {
+		metrics.InsufficientSeats.WithLabelValues(operation).Inc()
+	}
+}
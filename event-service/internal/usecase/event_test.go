@@ -6,19 +6,22 @@ import (
 	"testing"
 	"time"
 
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
 	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 func TestCreateEvent_Success(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Event")).Return(nil)
 
-	event, err := uc.CreateEvent(context.Background(), "Concert", time.Now().Add(24*time.Hour), 100)
+	event, err := uc.CreateEvent(context.Background(), "Concert", domain.EventDetails{}, time.Now().Add(24*time.Hour), 100, "", "")
 
 	assert.NoError(t, err)
 	assert.NotNil(t, event)
@@ -29,9 +32,9 @@ func TestCreateEvent_Success(t *testing.T) {
 
 func TestCreateEvent_EmptyName(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
-	event, err := uc.CreateEvent(context.Background(), "", time.Now().Add(24*time.Hour), 100)
+	event, err := uc.CreateEvent(context.Background(), "", domain.EventDetails{}, time.Now().Add(24*time.Hour), 100, "", "")
 
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
 	assert.Nil(t, event)
@@ -39,9 +42,9 @@ func TestCreateEvent_EmptyName(t *testing.T) {
 
 func TestCreateEvent_ZeroSeats(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
-	event, err := uc.CreateEvent(context.Background(), "Concert", time.Now().Add(24*time.Hour), 0)
+	event, err := uc.CreateEvent(context.Background(), "Concert", domain.EventDetails{}, time.Now().Add(24*time.Hour), 0, "", "")
 
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
 	assert.Nil(t, event)
@@ -49,9 +52,9 @@ func TestCreateEvent_ZeroSeats(t *testing.T) {
 
 func TestCreateEvent_ZeroTime(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
-	event, err := uc.CreateEvent(context.Background(), "Concert", time.Time{}, 100)
+	event, err := uc.CreateEvent(context.Background(), "Concert", domain.EventDetails{}, time.Time{}, 100, "", "")
 
 	assert.ErrorIs(t, err, domain.ErrInvalidInput)
 	assert.Nil(t, event)
@@ -59,11 +62,11 @@ func TestCreateEvent_ZeroTime(t *testing.T) {
 
 func TestCreateEvent_RepoError(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Event")).Return(errors.New("db error"))
 
-	event, err := uc.CreateEvent(context.Background(), "Concert", time.Now().Add(24*time.Hour), 100)
+	event, err := uc.CreateEvent(context.Background(), "Concert", domain.EventDetails{}, time.Now().Add(24*time.Hour), 100, "", "")
 
 	assert.Error(t, err)
 	assert.Nil(t, event)
@@ -72,7 +75,7 @@ func TestCreateEvent_RepoError(t *testing.T) {
 
 func TestGetEvent_Success(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	expected := &domain.Event{
 		ID:             "event-1",
@@ -91,7 +94,7 @@ func TestGetEvent_Success(t *testing.T) {
 
 func TestGetEvent_EmptyID(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	event, err := uc.GetEvent(context.Background(), "")
 
@@ -101,7 +104,7 @@ func TestGetEvent_EmptyID(t *testing.T) {
 
 func TestGetEvent_NotFound(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	repo.On("GetByID", mock.Anything, "nonexistent").Return(nil, nil)
 
@@ -114,25 +117,153 @@ func TestGetEvent_NotFound(t *testing.T) {
 
 func TestListEvents_Success(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	expected := []*domain.Event{
 		{ID: "1", Name: "Concert"},
 		{ID: "2", Name: "Theater"},
 	}
-	repo.On("List", mock.Anything, int32(10), int32(0)).Return(expected, int32(2), nil)
+	repo.On("List", mock.Anything, domain.EventFilter{Status: domain.EventStatusPublished}, domain.SortOrder{Column: "start_time"}, (*domain.PageCursor)(nil), int32(11)).Return(expected, nil)
 
-	events, total, err := uc.ListEvents(context.Background(), 10, 0)
+	events, next, err := uc.ListEvents(context.Background(), domain.EventFilter{}, domain.PageRequest{})
 
 	assert.NoError(t, err)
-	assert.Equal(t, int32(2), total)
+	assert.Empty(t, next)
 	assert.Len(t, events, 2)
 	repo.AssertExpectations(t)
 }
 
+func TestListEvents_Pages(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	start := time.Date(2026, 6, 1, 20, 0, 0, 0, time.UTC)
+	filter := domain.EventFilter{Status: domain.EventStatusPublished, NameContains: "jazz", HasAvailability: true}
+	order := domain.SortOrder{Column: "start_time", Desc: true}
+	repo.On("List", mock.Anything, filter, order, (*domain.PageCursor)(nil), int32(3)).Return([]*domain.Event{
+		{ID: "1", StartTime: start.Add(2 * time.Hour)},
+		{ID: "2", StartTime: start.Add(time.Hour)},
+		{ID: "3", StartTime: start},
+	}, nil)
+	repo.On("List", mock.Anything, filter, order, &domain.PageCursor{Key: start.Add(time.Hour), ID: "2"}, int32(3)).Return([]*domain.Event{
+		{ID: "3", StartTime: start},
+	}, nil)
+
+	first, next, err := uc.ListEvents(context.Background(), filter, domain.PageRequest{Size: 2, OrderBy: "Start_Time  DESC"})
+	assert.NoError(t, err)
+	assert.Len(t, first, 2)
+	assert.NotEmpty(t, next)
+
+	second, last, err := uc.ListEvents(context.Background(), filter, domain.PageRequest{Size: 2, Token: next, OrderBy: "start_time desc"})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", second[0].ID)
+	assert.Empty(t, last)
+
+	// The token belongs to its query.
+	_, _, err = uc.ListEvents(context.Background(), domain.EventFilter{}, domain.PageRequest{Size: 2, Token: next, OrderBy: "start_time desc"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+	_, _, err = uc.ListEvents(context.Background(), filter, domain.PageRequest{Size: 2, Token: next})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+	repo.AssertExpectations(t)
+}
+
+func TestListEvents_Rejects(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+	now := time.Now()
+
+	_, _, err := uc.ListEvents(context.Background(), domain.EventFilter{}, domain.PageRequest{OrderBy: "name; DROP TABLE events"})
+	assert.ErrorIs(t, err, domain.ErrInvalidOrderBy)
+	_, _, err = uc.ListEvents(context.Background(), domain.EventFilter{}, domain.PageRequest{Token: "not-a-token"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+	_, _, err = uc.ListEvents(context.Background(), domain.EventFilter{}, domain.PageRequest{Size: -1})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, _, err = uc.ListEvents(context.Background(), domain.EventFilter{StartsFrom: now, StartsBefore: now}, domain.PageRequest{})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	repo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListEvents_StatusVisibility(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		filter  domain.EventFilter
+		want    domain.EventFilter
+		wantErr error
+	}{
+		{
+			name:   "anonymous defaults to published",
+			ctx:    context.Background(),
+			filter: domain.EventFilter{},
+			want:   domain.EventFilter{Status: domain.EventStatusPublished},
+		},
+		{
+			name:    "anonymous asks for drafts",
+			ctx:     context.Background(),
+			filter:  domain.EventFilter{Status: domain.EventStatusDraft},
+			wantErr: domain.ErrPermissionDenied,
+		},
+		{
+			name:    "customer asks for drafts",
+			ctx:     callerContext("user-1", auth.RoleCustomer),
+			filter:  domain.EventFilter{Status: domain.EventStatusDraft, OrganizerID: "user-1"},
+			wantErr: domain.ErrPermissionDenied,
+		},
+		{
+			name:   "organizer defaults to published",
+			ctx:    callerContext("org-1", auth.RoleOrganizer),
+			filter: domain.EventFilter{},
+			want:   domain.EventFilter{Status: domain.EventStatusPublished},
+		},
+		{
+			name:   "organizer lists all their own events",
+			ctx:    callerContext("org-1", auth.RoleOrganizer),
+			filter: domain.EventFilter{OrganizerID: "org-1"},
+			want:   domain.EventFilter{OrganizerID: "org-1"},
+		},
+		{
+			name:   "organizer drafts are narrowed to their own",
+			ctx:    callerContext("org-1", auth.RoleOrganizer),
+			filter: domain.EventFilter{Status: domain.EventStatusDraft},
+			want:   domain.EventFilter{Status: domain.EventStatusDraft, OrganizerID: "org-1"},
+		},
+		{
+			name:    "organizer asks for another organizer's drafts",
+			ctx:     callerContext("org-1", auth.RoleOrganizer),
+			filter:  domain.EventFilter{Status: domain.EventStatusDraft, OrganizerID: "org-2"},
+			wantErr: domain.ErrPermissionDenied,
+		},
+		{
+			name:   "admin lists everything",
+			ctx:    callerContext("admin-1", auth.RoleAdmin),
+			filter: domain.EventFilter{},
+			want:   domain.EventFilter{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mocks.MockEventRepository)
+			uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+			if tt.wantErr == nil {
+				repo.On("List", mock.Anything, tt.want, mock.Anything, (*domain.PageCursor)(nil), int32(11)).Return([]*domain.Event{}, nil)
+			}
+
+			_, _, err := uc.ListEvents(tt.ctx, tt.filter, domain.PageRequest{})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestUpdateAvailableTickets_Success(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	existing := &domain.Event{
 		ID:             "event-1",
@@ -150,7 +281,7 @@ func TestUpdateAvailableTickets_Success(t *testing.T) {
 
 func TestUpdateAvailableTickets_EmptyID(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	available, err := uc.UpdateAvailableTickets(context.Background(), "", 2)
 
@@ -160,7 +291,7 @@ func TestUpdateAvailableTickets_EmptyID(t *testing.T) {
 
 func TestUpdateAvailableTickets_ZeroQuantity(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	available, err := uc.UpdateAvailableTickets(context.Background(), "event-1", 0)
 
@@ -170,7 +301,7 @@ func TestUpdateAvailableTickets_ZeroQuantity(t *testing.T) {
 
 func TestUpdateAvailableTickets_InsufficientSeats(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	existing := &domain.Event{
 		ID:             "event-1",
@@ -187,7 +318,7 @@ func TestUpdateAvailableTickets_InsufficientSeats(t *testing.T) {
 
 func TestUpdateAvailableTickets_EventNotFound(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	repo.On("GetByID", mock.Anything, "nonexistent").Return(nil, nil)
 
@@ -200,7 +331,7 @@ func TestUpdateAvailableTickets_EventNotFound(t *testing.T) {
 
 func TestUpdateAvailableTickets_NegativeQuantity_AddsSeats(t *testing.T) {
 	repo := new(mocks.MockEventRepository)
-	uc := NewEventUsecase(repo)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
 
 	existing := &domain.Event{
 		ID:             "event-1",
@@ -215,3 +346,171 @@ func TestUpdateAvailableTickets_NegativeQuantity_AddsSeats(t *testing.T) {
 	assert.Equal(t, int32(52), newAvailable)
 	repo.AssertExpectations(t)
 }
+
+func TestReserveSeats_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReserveSeats", mock.Anything, mock.MatchedBy(func(r *domain.Reservation) bool {
+		return r.ID == "res-1" && r.EventID == "event-1" && r.Quantity == 2
+	})).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 2,
+		Status:   domain.ReservationStatusReserved,
+	}, int32(48), nil)
+
+	reservation, available, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 2, nil, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "res-1", reservation.ID)
+	assert.Equal(t, int32(48), available)
+	repo.AssertExpectations(t)
+}
+
+func TestReserveSeats_RetryIsNoOp(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	existing := &domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 2,
+		Status:   domain.ReservationStatusReserved,
+	}
+	repo.On("ReserveSeats", mock.Anything, mock.AnythingOfType("*domain.Reservation")).Return(existing, int32(48), nil).Twice()
+
+	_, first, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 2, nil, nil)
+	assert.NoError(t, err)
+	_, second, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 2, nil, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	repo.AssertExpectations(t)
+}
+
+func TestReserveSeats_ConflictingRetry(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReserveSeats", mock.Anything, mock.AnythingOfType("*domain.Reservation")).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 3,
+		Status:   domain.ReservationStatusReserved,
+	}, int32(47), nil)
+
+	reservation, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 2, nil, nil)
+
+	assert.ErrorIs(t, err, domain.ErrReservationConflict)
+	assert.Nil(t, reservation)
+}
+
+func TestReserveSeats_AlreadyReleased(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReserveSeats", mock.Anything, mock.AnythingOfType("*domain.Reservation")).Return(&domain.Reservation{
+		ID:     "res-1",
+		Status: domain.ReservationStatusReleased,
+	}, int32(0), nil)
+
+	reservation, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 2, nil, nil)
+
+	assert.ErrorIs(t, err, domain.ErrReservationReleased)
+	assert.Nil(t, reservation)
+}
+
+func TestReserveSeats_InsufficientSeats(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReserveSeats", mock.Anything, mock.AnythingOfType("*domain.Reservation")).Return(nil, int32(0), domain.ErrInsufficientSeats)
+	rejected := testutil.ToFloat64(metrics.InsufficientSeats.WithLabelValues("reserve"))
+
+	reservation, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 200, nil, nil)
+
+	assert.ErrorIs(t, err, domain.ErrInsufficientSeats)
+	assert.Nil(t, reservation)
+	assert.Equal(t, rejected+1, testutil.ToFloat64(metrics.InsufficientSeats.WithLabelValues("reserve")))
+}
+
+func TestReserveSeats_InvalidInput(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, _, err := uc.ReserveSeats(context.Background(), "", "event-1", 2, nil, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, _, err = uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, nil, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestReleaseSeats_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReleaseSeats", mock.Anything, "res-1", (*domain.LegacyHold)(nil)).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 2,
+		Status:   domain.ReservationStatusReleased,
+	}, int32(50), nil)
+
+	reservation, available, err := uc.ReleaseSeats(context.Background(), "res-1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ReservationStatusReleased, reservation.Status)
+	assert.Equal(t, int32(50), available)
+	repo.AssertExpectations(t)
+}
+
+func TestReleaseSeats_EmptyID(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, _, err := uc.ReleaseSeats(context.Background(), "", nil)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestReleaseSeats_InvalidLegacyHold(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, _, err := uc.ReleaseSeats(context.Background(), "booking-1", &domain.LegacyHold{EventID: "event-1"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, _, err = uc.ReleaseSeats(context.Background(), "booking-1", &domain.LegacyHold{Quantity: 2})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	repo.AssertNotCalled(t, "ReleaseSeats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResizeReservation_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ResizeSeats", mock.Anything, "res-1", int32(3)).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 3,
+		Status:   domain.ReservationStatusReserved,
+	}, int32(47), nil)
+
+	reservation, available, err := uc.ResizeReservation(context.Background(), "res-1", 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), reservation.Quantity)
+	assert.Equal(t, int32(47), available)
+	repo.AssertExpectations(t)
+}
+
+func TestResizeReservation_InvalidInput(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, _, err := uc.ResizeReservation(context.Background(), "res-1", 0)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	repo.AssertNotCalled(t, "ResizeSeats", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+// UpdateEvent changes the name, details and venue of an event that is not yet
+// cancelled or completed. The venue name of an event linked to a venue
+// follows the venue and cannot be set, and only the venue's owner may link it.
+func (u *EventUsecase) UpdateEvent(ctx context.Context, eventID string, update domain.EventUpdate) (*domain.Event, error) {
+	if update == (domain.EventUpdate{}) {
+		return nil, domain.ErrInvalidInput
+	}
+	if update.Name != nil && *update.Name == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	var venue *domain.Venue
+	if update.VenueID != nil && *update.VenueID != "" {
+		var err error
+		if venue, err = u.linkableVenue(ctx, *update.VenueID); err != nil {
+			return nil, err
+		}
+	}
+
+	return u.modifyEvent(ctx, eventID, func(event *domain.Event) error {
+		if event.Status.Final() {
+			return domain.ErrEventFinal
+		}
+		if update.Name != nil {
+			event.Name = *update.Name
+		}
+		if venue != nil {
+			if err := linkVenue(event, venue); err != nil {
+				return err
+			}
+		} else if update.VenueID != nil {
+			event.VenueID = ""
+			event.TimeZone = ""
+		}
+		details := event.EventDetails
+		if update.Description != nil {
+			details.Description = *update.Description
+		}
+		if update.Venue != nil {
+			if event.VenueID != "" {
+				return domain.ErrInvalidInput
+			}
+			details.Venue = *update.Venue
+		}
+		if update.Category != nil {
+			details.Category = *update.Category
+		}
+		if update.Tags != nil {
+			details.Tags = *update.Tags
+		}
+		details, err := cleanDetails(details)
+		if err != nil {
+			return err
+		}
+		event.EventDetails = details
+		return nil
+	})
+}
+
+// RescheduleEvent moves an event that is not yet cancelled or completed to a
+// new start time. Existing bookings stay valid.
+func (u *EventUsecase) RescheduleEvent(ctx context.Context, eventID string, startTime time.Time) (*domain.Event, error) {
+	if startTime.IsZero() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return u.modifyEvent(ctx, eventID, func(event *domain.Event) error {
+		if event.Status.Final() {
+			return domain.ErrEventFinal
+		}
+		event.StartTime = startTime
+		return nil
+	})
+}
+
+// SetPurchaseLimit caps the tickets one user may hold for an event that is not
+// yet cancelled or completed; 0 removes the cap. Bookings made before a lower
+// cap are kept.
+func (u *EventUsecase) SetPurchaseLimit(ctx context.Context, eventID string, maxTicketsPerUser int32) (*domain.Event, error) {
+	if maxTicketsPerUser < 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return u.modifyEvent(ctx, eventID, func(event *domain.Event) error {
+		if event.Status.Final() {
+			return domain.ErrEventFinal
+		}
+		event.MaxTicketsPerUser = maxTicketsPerUser
+		return nil
+	})
+}
+
+// SetQueueMode sends buyers of an event that is not yet cancelled or
+// completed through the waiting room, admitting admissionsPerMinute of them a
+// minute; 0 turns the waiting room off.
+func (u *EventUsecase) SetQueueMode(ctx context.Context, eventID string, admissionsPerMinute int32) (*domain.Event, error) {
+	if admissionsPerMinute < 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return u.modifyEvent(ctx, eventID, func(event *domain.Event) error {
+		if event.Status.Final() {
+			return domain.ErrEventFinal
+		}
+		event.QueueAdmissionsPerMinute = admissionsPerMinute
+		return nil
+	})
+}
+
+// SetEventCapacity changes the total seats of a general admission event that
+// is not yet cancelled or completed, moving its available seats by as much.
+// The new total must cover the seats sold and the event's ticket types, and
+// fit its venue. An occurrence of a series keeps the new total when the
+// series' capacity changes later.
+func (u *EventUsecase) SetEventCapacity(ctx context.Context, eventID string, totalSeats int32) (*domain.Event, error) {
+	if totalSeats <= 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	event, err := u.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeEvent(ctx, event); err != nil {
+		return nil, err
+	}
+	if event.Status.Final() {
+		return nil, domain.ErrEventFinal
+	}
+	// An assigned-seating event has as many seats as its seat map.
+	if event.LayoutID != "" {
+		return nil, domain.ErrSeatingMismatch
+	}
+	if event.VenueID != "" {
+		venue, err := u.GetVenue(ctx, event.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		if totalSeats > venue.Capacity {
+			return nil, domain.ErrVenueCapacity
+		}
+	}
+
+	if err := u.repo.SetTotalSeats(ctx, event.ID, totalSeats); err != nil {
+		return nil, err
+	}
+
+	return u.getEvent(ctx, eventID)
+}
+
+// UpdateEventStatus publishes, closes, reopens or completes an event. Moving
+// to the current status is a no-op. Cancelling goes through CancelEvent so
+// the event's bookings are cancelled too.
+func (u *EventUsecase) UpdateEventStatus(ctx context.Context, eventID string, status domain.EventStatus) (*domain.Event, error) {
+	switch status {
+	case domain.EventStatusDraft, domain.EventStatusPublished,
+		domain.EventStatusSalesClosed, domain.EventStatusCompleted:
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+
+	return u.modifyEvent(ctx, eventID, func(event *domain.Event) error {
+		if event.Status == status {
+			return nil
+		}
+		if !event.Status.CanTransition(status) {
+			return domain.ErrInvalidTransition
+		}
+		event.Status = status
+		return nil
+	})
+}
+
+// CancelEvent cancels an event and then all of its bookings in booking-service.
+// It is safe to retry: calling it on a cancelled event cancels any bookings
+// left over from an earlier failed attempt.
+func (u *EventUsecase) CancelEvent(ctx context.Context, eventID string) (*domain.Event, int32, error) {
+	event, err := u.modifyEvent(ctx, eventID, func(event *domain.Event) error {
+		if event.Status == domain.EventStatusCancelled {
+			return nil
+		}
+		if !event.Status.CanTransition(domain.EventStatusCancelled) {
+			return domain.ErrInvalidTransition
+		}
+		event.Status = domain.EventStatusCancelled
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	cancelled, err := u.bookings.CancelEventBookings(ctx, event.ID)
+	if err != nil {
+		return event, 0, fmt.Errorf("%w: %w", domain.ErrBookingCancellations, err)
+	}
+
+	return event, cancelled, nil
+}
+
+// modifyEvent applies change to the stored event and saves it, provided the
+// caller may manage the event and no one changed it in between.
+func (u *EventUsecase) modifyEvent(ctx context.Context, eventID string, change func(*domain.Event) error) (*domain.Event, error) {
+	event, err := u.getEvent(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeEvent(ctx, event); err != nil {
+		return nil, err
+	}
+
+	before := *event
+	if err := change(event); err != nil {
+		return nil, err
+	}
+	if reflect.DeepEqual(*event, before) {
+		return event, nil
+	}
+
+	updated, err := u.repo.Update(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, domain.ErrEventChanged
+	}
+
+	return event, nil
+}
@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func rename(name string) domain.EventUpdate {
+	return domain.EventUpdate{Name: &name}
+}
+
+func TestUpdateEvent_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:     "event-1",
+		Name:   "Concrt",
+		Status: domain.EventStatusPublished,
+	}, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.Name == "Concert"
+	})).Return(true, nil)
+
+	event, err := uc.UpdateEvent(context.Background(), "event-1", rename("Concert"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Concert", event.Name)
+	repo.AssertExpectations(t)
+}
+
+func TestUpdateEvent_FinalEvent(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:     "event-1",
+		Name:   "Concert",
+		Status: domain.EventStatusCancelled,
+	}, nil)
+
+	_, err := uc.UpdateEvent(context.Background(), "event-1", rename("Concert II"))
+
+	assert.ErrorIs(t, err, domain.ErrEventFinal)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateEvent_Details(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:   "event-1",
+		Name: "Concert",
+		EventDetails: domain.EventDetails{
+			Description: "An evening of jazz.",
+			Venue:       "Town Hall",
+			Tags:        []string{"jazz"},
+		},
+		Status: domain.EventStatusPublished,
+	}, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(true, nil)
+
+	venue, category, tags := "", " Music ", []string{"Jazz", " live ", "jazz", ""}
+	event, err := uc.UpdateEvent(context.Background(), "event-1", domain.EventUpdate{
+		Venue:    &venue,
+		Category: &category,
+		Tags:     &tags,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Concert", event.Name)
+	assert.Equal(t, domain.EventDetails{
+		Description: "An evening of jazz.",
+		Category:    "music",
+		Tags:        []string{"jazz", "live"},
+	}, event.EventDetails)
+}
+
+func TestUpdateEvent_InvalidInput(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", Status: domain.EventStatusDraft}, nil)
+
+	tooMany := make([]string, 21)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag-%d", i)
+	}
+	for _, update := range []domain.EventUpdate{{}, rename(""), {Tags: &tooMany}} {
+		_, err := uc.UpdateEvent(context.Background(), "event-1", update)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	}
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestRescheduleEvent_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	newStart := time.Date(2026, 9, 1, 20, 0, 0, 0, time.UTC)
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:        "event-1",
+		StartTime: time.Date(2026, 8, 1, 20, 0, 0, 0, time.UTC),
+		Status:    domain.EventStatusSalesClosed,
+	}, nil)
+	repo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Event")).Return(true, nil)
+
+	event, err := uc.RescheduleEvent(context.Background(), "event-1", newStart)
+
+	assert.NoError(t, err)
+	assert.Equal(t, newStart, event.StartTime)
+}
+
+func TestRescheduleEvent_ConcurrentChange(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:     "event-1",
+		Status: domain.EventStatusPublished,
+	}, nil)
+	repo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Event")).Return(false, nil)
+
+	_, err := uc.RescheduleEvent(context.Background(), "event-1", time.Now())
+
+	assert.ErrorIs(t, err, domain.ErrEventChanged)
+}
+
+func TestSetPurchaseLimit_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:     "event-1",
+		Status: domain.EventStatusPublished,
+	}, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.MaxTicketsPerUser == 4
+	})).Return(true, nil)
+
+	event, err := uc.SetPurchaseLimit(context.Background(), "event-1", 4)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), event.MaxTicketsPerUser)
+	repo.AssertExpectations(t)
+}
+
+func TestSetPurchaseLimit_Negative(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, err := uc.SetPurchaseLimit(context.Background(), "event-1", -1)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestSetQueueMode_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:     "event-1",
+		Status: domain.EventStatusPublished,
+	}, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.QueueAdmissionsPerMinute == 120
+	})).Return(true, nil)
+
+	event, err := uc.SetQueueMode(context.Background(), "event-1", 120)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(120), event.QueueAdmissionsPerMinute)
+	repo.AssertExpectations(t)
+}
+
+func TestSetQueueMode_FinalEvent(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:     "event-1",
+		Status: domain.EventStatusCancelled,
+	}, nil)
+
+	_, err := uc.SetQueueMode(context.Background(), "event-1", 60)
+
+	assert.ErrorIs(t, err, domain.ErrEventFinal)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestSetEventCapacity_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:         "event-1",
+		TotalSeats: 100,
+		Status:     domain.EventStatusPublished,
+		VenueID:    "venue-1",
+	}, nil).Once()
+	venues.On("GetByID", mock.Anything, "venue-1").Return(&domain.Venue{ID: "venue-1", Capacity: 150}, nil)
+	repo.On("SetTotalSeats", mock.Anything, "event-1", int32(120)).Return(nil)
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:         "event-1",
+		TotalSeats: 120,
+		Status:     domain.EventStatusPublished,
+		VenueID:    "venue-1",
+	}, nil).Once()
+
+	event, err := uc.SetEventCapacity(context.Background(), "event-1", 120)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(120), event.TotalSeats)
+
+	_, err = uc.SetEventCapacity(context.Background(), "event-1", 0)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	repo.AssertExpectations(t)
+}
+
+func TestSetEventCapacity_Rejected(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "seated").Return(&domain.Event{ID: "seated", LayoutID: "layout-1", Status: domain.EventStatusPublished}, nil)
+	repo.On("GetByID", mock.Anything, "completed").Return(&domain.Event{ID: "completed", Status: domain.EventStatusCompleted}, nil)
+	repo.On("GetByID", mock.Anything, "at-venue").Return(&domain.Event{ID: "at-venue", VenueID: "venue-1", Status: domain.EventStatusDraft}, nil)
+	venues.On("GetByID", mock.Anything, "venue-1").Return(&domain.Venue{ID: "venue-1", Capacity: 150}, nil)
+
+	_, err := uc.SetEventCapacity(context.Background(), "seated", 120)
+	assert.ErrorIs(t, err, domain.ErrSeatingMismatch)
+
+	_, err = uc.SetEventCapacity(context.Background(), "completed", 120)
+	assert.ErrorIs(t, err, domain.ErrEventFinal)
+
+	_, err = uc.SetEventCapacity(context.Background(), "at-venue", 200)
+	assert.ErrorIs(t, err, domain.ErrVenueCapacity)
+
+	repo.AssertNotCalled(t, "SetTotalSeats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUpdateEventStatus_Transitions(t *testing.T) {
+	cases := []struct {
+		from, to domain.EventStatus
+		err      error
+	}{
+		{domain.EventStatusDraft, domain.EventStatusPublished, nil},
+		{domain.EventStatusPublished, domain.EventStatusSalesClosed, nil},
+		{domain.EventStatusSalesClosed, domain.EventStatusPublished, nil},
+		{domain.EventStatusPublished, domain.EventStatusCompleted, nil},
+		{domain.EventStatusPublished, domain.EventStatusDraft, domain.ErrInvalidTransition},
+		{domain.EventStatusDraft, domain.EventStatusCompleted, domain.ErrInvalidTransition},
+		{domain.EventStatusCompleted, domain.EventStatusPublished, domain.ErrInvalidTransition},
+		{domain.EventStatusCancelled, domain.EventStatusPublished, domain.ErrInvalidTransition},
+	}
+
+	for _, tc := range cases {
+		repo := new(mocks.MockEventRepository)
+		uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+		repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", Status: tc.from}, nil)
+		repo.On("Update", mock.Anything, mock.AnythingOfType("*domain.Event")).Return(true, nil)
+
+		event, err := uc.UpdateEventStatus(context.Background(), "event-1", tc.to)
+
+		if tc.err != nil {
+			assert.ErrorIs(t, err, tc.err, "%v -> %v", tc.from, tc.to)
+			continue
+		}
+		assert.NoError(t, err, "%v -> %v", tc.from, tc.to)
+		assert.Equal(t, tc.to, event.Status)
+	}
+}
+
+func TestUpdateEventStatus_CancelRejected(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, err := uc.UpdateEventStatus(context.Background(), "event-1", domain.EventStatusCancelled)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	repo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestCancelEvent_CancelsBookings(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	bookings := new(mocks.MockBookingClient)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), bookings, testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", Status: domain.EventStatusPublished}, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.Status == domain.EventStatusCancelled
+	})).Return(true, nil)
+	bookings.On("CancelEventBookings", mock.Anything, "event-1").Return(int32(12), nil)
+
+	event, cancelled, err := uc.CancelEvent(context.Background(), "event-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EventStatusCancelled, event.Status)
+	assert.Equal(t, int32(12), cancelled)
+	bookings.AssertExpectations(t)
+}
+
+func TestCancelEvent_RetryAfterBookingFailure(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	bookings := new(mocks.MockBookingClient)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), bookings, testSeriesHorizon)
+
+	// The event was cancelled by an earlier attempt whose booking call failed.
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", Status: domain.EventStatusCancelled}, nil)
+	bookings.On("CancelEventBookings", mock.Anything, "event-1").Return(int32(0), client.ErrBookingService).Once()
+	bookings.On("CancelEventBookings", mock.Anything, "event-1").Return(int32(3), nil).Once()
+
+	_, _, err := uc.CancelEvent(context.Background(), "event-1")
+	assert.ErrorIs(t, err, domain.ErrBookingCancellations)
+	assert.ErrorIs(t, err, client.ErrBookingService)
+
+	_, cancelled, err := uc.CancelEvent(context.Background(), "event-1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), cancelled)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestCancelEvent_Completed(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	bookings := new(mocks.MockBookingClient)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), bookings, testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", Status: domain.EventStatusCompleted}, nil)
+
+	_, _, err := uc.CancelEvent(context.Background(), "event-1")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTransition)
+	bookings.AssertNotCalled(t, "CancelEventBookings", mock.Anything, mock.Anything)
+}
+
+func TestCancelEvent_NotFound(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(nil, nil)
+
+	_, _, err := uc.CancelEvent(context.Background(), "event-1")
+
+	assert.ErrorIs(t, err, domain.ErrEventNotFound)
+}
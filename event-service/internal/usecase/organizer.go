@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+const defaultOrganizerPageSize = 20
+
+// CreateOrganizer records the profile of the organizer whose subject is its
+// ID, the caller's own when unset. Only admins create profiles for others.
+func (u *EventUsecase) CreateOrganizer(ctx context.Context, organizer *domain.Organizer) (*domain.Organizer, error) {
+	if organizer.ID == "" {
+		organizer.ID = callerSubject(ctx)
+	}
+	if organizer.ID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if err := authorizeOwner(ctx, organizer.ID); err != nil {
+		return nil, err
+	}
+	if err := cleanOrganizer(organizer); err != nil {
+		return nil, err
+	}
+
+	if err := u.organizers.Create(ctx, organizer); err != nil {
+		return nil, err
+	}
+
+	return organizer, nil
+}
+
+func (u *EventUsecase) GetOrganizer(ctx context.Context, organizerID string) (*domain.Organizer, error) {
+	if organizerID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	organizer, err := u.organizers.GetByID(ctx, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	if organizer == nil {
+		return nil, domain.ErrOrganizerNotFound
+	}
+
+	return organizer, nil
+}
+
+func (u *EventUsecase) ListOrganizers(ctx context.Context, page domain.PageRequest) ([]*domain.Organizer, string, error) {
+	size, err := pageSize(page.Size, defaultOrganizerPageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	after, err := decodePageToken(page.Token, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	organizers, err := u.organizers.List(ctx, after, size+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(organizers) <= int(size) {
+		return organizers, "", nil
+	}
+
+	organizers = organizers[:size]
+	last := organizers[size-1]
+	return organizers, encodePageToken(domain.PageCursor{Key: last.CreatedAt, ID: last.ID}, ""), nil
+}
+
+// UpdateOrganizer replaces an organizer's name and contact details.
+func (u *EventUsecase) UpdateOrganizer(ctx context.Context, organizer *domain.Organizer) (*domain.Organizer, error) {
+	existing, err := u.GetOrganizer(ctx, organizer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeOwner(ctx, existing.ID); err != nil {
+		return nil, err
+	}
+	if err := cleanOrganizer(organizer); err != nil {
+		return nil, err
+	}
+	organizer.CreatedAt = existing.CreatedAt
+
+	if err := u.organizers.Update(ctx, organizer); err != nil {
+		return nil, err
+	}
+
+	return organizer, nil
+}
+
+// DeleteOrganizer deletes the profile of an organizer without events.
+func (u *EventUsecase) DeleteOrganizer(ctx context.Context, organizerID string) error {
+	if _, err := u.GetOrganizer(ctx, organizerID); err != nil {
+		return err
+	}
+	if err := authorizeOwner(ctx, organizerID); err != nil {
+		return err
+	}
+
+	return u.organizers.Delete(ctx, organizerID)
+}
+
+// cleanOrganizer trims the organizer's fields and checks them. Only the name
+// is required; an email must be a bare address and a website an http or
+// https URL.
+func cleanOrganizer(organizer *domain.Organizer) error {
+	organizer.Name = strings.TrimSpace(organizer.Name)
+	organizer.Email = strings.TrimSpace(organizer.Email)
+	organizer.Phone = strings.TrimSpace(organizer.Phone)
+	organizer.Website = strings.TrimSpace(organizer.Website)
+
+	if organizer.Name == "" {
+		return domain.ErrInvalidInput
+	}
+	for _, field := range []string{organizer.Name, organizer.Email, organizer.Phone, organizer.Website} {
+		if utf8.RuneCountInString(field) > maxLabelLength {
+			return domain.ErrInvalidInput
+		}
+	}
+	if organizer.Email != "" {
+		addr, err := mail.ParseAddress(organizer.Email)
+		if err != nil || addr.Address != organizer.Email {
+			return domain.ErrInvalidInput
+		}
+	}
+	if organizer.Website != "" {
+		site, err := url.Parse(organizer.Website)
+		if err != nil || (site.Scheme != "http" && site.Scheme != "https") || site.Host == "" {
+			return domain.ErrInvalidInput
+		}
+	}
+	return nil
+}
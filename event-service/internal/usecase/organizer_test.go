@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateOrganizer_OwnProfile(t *testing.T) {
+	organizers := new(mocks.MockOrganizerRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), organizers, new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	organizers.On("Create", mock.Anything, mock.AnythingOfType("*domain.Organizer")).Return(nil)
+
+	organizer, err := uc.CreateOrganizer(callerContext("org-1", auth.RoleOrganizer), &domain.Organizer{
+		Name:    "Night Owl Concerts",
+		Email:   "hello@nightowl.example",
+		Website: "https://nightowl.example",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "org-1", organizer.ID)
+}
+
+func TestCreateOrganizer_ForAnother(t *testing.T) {
+	organizers := new(mocks.MockOrganizerRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), organizers, new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	organizers.On("Create", mock.Anything, mock.AnythingOfType("*domain.Organizer")).Return(nil)
+
+	_, err := uc.CreateOrganizer(callerContext("org-1", auth.RoleOrganizer), &domain.Organizer{ID: "org-2", Name: "Other"})
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+
+	organizer, err := uc.CreateOrganizer(callerContext("admin", auth.RoleAdmin), &domain.Organizer{ID: "org-2", Name: "Other"})
+	assert.NoError(t, err)
+	assert.Equal(t, "org-2", organizer.ID)
+	organizers.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestCreateOrganizer_InvalidInput(t *testing.T) {
+	organizers := new(mocks.MockOrganizerRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), organizers, new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	for _, organizer := range []*domain.Organizer{
+		{ID: "org-1"},
+		{ID: "org-1", Name: "Night Owl", Email: "Night Owl <hello@nightowl.example>"},
+		{ID: "org-1", Name: "Night Owl", Website: "javascript:alert(1)"},
+		// No ID and no caller to take it from.
+		{Name: "Night Owl"},
+	} {
+		_, err := uc.CreateOrganizer(context.Background(), organizer)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	}
+	organizers.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestDeleteOrganizer_OtherOrganizer(t *testing.T) {
+	organizers := new(mocks.MockOrganizerRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), organizers, new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	organizers.On("GetByID", mock.Anything, "org-1").Return(&domain.Organizer{ID: "org-1", Name: "Night Owl"}, nil)
+
+	err := uc.DeleteOrganizer(callerContext("org-2", auth.RoleOrganizer), "org-1")
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	organizers.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+const maxPageSize = 100
+
+// pageToken is what an opaque page token holds: where the next page starts,
+// and a digest of the query it was issued for so it cannot be used with
+// another one. Lists start after a key and ID; ranked search results, which
+// have no stable key, at an offset.
+type pageToken struct {
+	Key    time.Time `json:"k,omitzero"`
+	ID     string    `json:"i,omitempty"`
+	Offset int32     `json:"o,omitempty"`
+	Query  string    `json:"q"`
+}
+
+func encodePageToken(cursor domain.PageCursor, query string) string {
+	data, _ := json.Marshal(pageToken{Key: cursor.Key, ID: cursor.ID, Query: queryDigest(query)})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken returns the cursor of a token issued for query, or nil for
+// the first page.
+func decodePageToken(token, query string) (*domain.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil || t.ID == "" || t.Query != queryDigest(query) {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return &domain.PageCursor{Key: t.Key, ID: t.ID}, nil
+}
+
+func encodeOffsetToken(offset int32, query string) string {
+	data, _ := json.Marshal(pageToken{Offset: offset, Query: queryDigest(query)})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeOffsetToken returns the offset of a token issued for query, or 0 for
+// the first page.
+func decodeOffsetToken(token, query string) (int32, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, domain.ErrInvalidPageToken
+	}
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil || t.Offset <= 0 || t.Query != queryDigest(query) {
+		return 0, domain.ErrInvalidPageToken
+	}
+	return t.Offset, nil
+}
+
+func queryDigest(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return base64.RawURLEncoding.EncodeToString(sum[:12])
+}
+
+// sortOrder looks orderBy up in the orders a list allows, ignoring case,
+// spacing and an explicit "asc".
+func sortOrder(orders map[string]domain.SortOrder, orderBy string) (domain.SortOrder, error) {
+	fields := strings.Fields(strings.ToLower(orderBy))
+	if len(fields) == 2 && fields[1] == "asc" {
+		fields = fields[:1]
+	}
+	order, ok := orders[strings.Join(fields, " ")]
+	if !ok {
+		return domain.SortOrder{}, domain.ErrInvalidOrderBy
+	}
+	return order, nil
+}
+
+// pageSize applies the default to an unset size and caps it.
+func pageSize(size, defaultSize int32) (int32, error) {
+	switch {
+	case size < 0:
+		return 0, domain.ErrInvalidInput
+	case size == 0:
+		return defaultSize, nil
+	case size > maxPageSize:
+		return maxPageSize, nil
+	}
+	return size, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+const (
+	maxDescriptionLength = 5000
+	// maxLabelLength bounds a venue, category or tag.
+	maxLabelLength = 200
+	maxTags        = 20
+	maxQueryLength = 256
+
+	defaultSearchPageSize = 20
+	// maxSearchResults is how deep search pages go. Later pages cost more to
+	// rank, and a query that still has not found the event should be refined.
+	maxSearchResults = 1000
+	// maxFacetValues is how many categories and venues a search counts.
+	maxFacetValues = 20
+)
+
+// cleanDetails trims the details and lowercases the category and tags,
+// dropping empty and repeated tags.
+func cleanDetails(details domain.EventDetails) (domain.EventDetails, error) {
+	details.Description = strings.TrimSpace(details.Description)
+	details.Venue = strings.TrimSpace(details.Venue)
+	details.Category = strings.ToLower(strings.TrimSpace(details.Category))
+	if utf8.RuneCountInString(details.Description) > maxDescriptionLength ||
+		utf8.RuneCountInString(details.Venue) > maxLabelLength ||
+		utf8.RuneCountInString(details.Category) > maxLabelLength {
+		return domain.EventDetails{}, domain.ErrInvalidInput
+	}
+
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tag := range details.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxLabelLength {
+			return domain.EventDetails{}, domain.ErrInvalidInput
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > maxTags {
+		return domain.EventDetails{}, domain.ErrInvalidInput
+	}
+	details.Tags = tags
+
+	return details, nil
+}
+
+func (u *EventUsecase) SearchEvents(ctx context.Context, search domain.EventSearch, page domain.PageRequest) (*domain.SearchResults, error) {
+	search.Query = strings.TrimSpace(search.Query)
+	if search.Query == "" || utf8.RuneCountInString(search.Query) > maxQueryLength {
+		return nil, domain.ErrInvalidInput
+	}
+	search.Category = strings.ToLower(strings.TrimSpace(search.Category))
+	search.Venue = strings.TrimSpace(search.Venue)
+	if !search.StartsFrom.IsZero() && !search.StartsBefore.IsZero() && !search.StartsBefore.After(search.StartsFrom) {
+		return nil, domain.ErrInvalidInput
+	}
+	size, err := pageSize(page.Size, defaultSearchPageSize)
+	if err != nil {
+		return nil, err
+	}
+	// Facets are counted over the same events, so they do not give away
+	// events the caller cannot see either.
+	search.Status, err = visibleStatus(ctx, search.Status, &search.OrganizerID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("%q|%q|%q|%d|%d|%d|%q", search.Query, search.Category, search.Venue,
+		search.StartsFrom.UnixNano(), search.StartsBefore.UnixNano(), search.Status, search.OrganizerID)
+	offset, err := decodeOffsetToken(page.Token, query)
+	if err != nil {
+		return nil, err
+	}
+	if offset >= maxSearchResults {
+		return nil, domain.ErrInvalidPageToken
+	}
+	size = min(size, maxSearchResults-offset)
+
+	// One more than the page tells whether there is a next one.
+	hits, err := u.repo.Search(ctx, search, offset, size+1)
+	if err != nil {
+		return nil, err
+	}
+	results := &domain.SearchResults{Hits: hits}
+	if len(hits) > int(size) {
+		results.Hits = hits[:size]
+		if offset+size < maxSearchResults {
+			results.NextPageToken = encodeOffsetToken(offset+size, query)
+		}
+	}
+
+	if page.Token == "" {
+		facets, err := u.repo.SearchFacets(ctx, search)
+		if err != nil {
+			return nil, err
+		}
+		facets.Categories = topFacets(facets.Categories)
+		facets.Venues = topFacets(facets.Venues)
+		results.Facets = facets
+	}
+
+	return results, nil
+}
+
+// topFacets keeps the most common values, ties in alphabetical order.
+func topFacets(counts []domain.FacetCount) []domain.FacetCount {
+	sort.SliceStable(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Value < counts[j].Value
+	})
+	if len(counts) > maxFacetValues {
+		counts = counts[:maxFacetValues]
+	}
+	return counts
+}
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func searchHits(n int) []*domain.SearchHit {
+	hits := make([]*domain.SearchHit, n)
+	for i := range hits {
+		hits[i] = &domain.SearchHit{Event: &domain.Event{ID: fmt.Sprintf("event-%d", i)}}
+	}
+	return hits
+}
+
+func TestSearchEvents_Pages(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	search := domain.EventSearch{Query: "jazz", Category: "music", Status: domain.EventStatusPublished}
+	categories := make([]domain.FacetCount, 25)
+	for i := range categories {
+		categories[i] = domain.FacetCount{Value: fmt.Sprintf("category-%02d", i), Count: int32(i)}
+	}
+	repo.On("Search", mock.Anything, search, int32(0), int32(3)).Return(searchHits(3), nil).Once()
+	repo.On("SearchFacets", mock.Anything, search).Return(&domain.SearchFacets{Categories: categories}, nil).Once()
+
+	first, err := uc.SearchEvents(context.Background(), domain.EventSearch{Query: " jazz ", Category: "Music"}, domain.PageRequest{Size: 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, first.Hits, 2)
+	assert.NotEmpty(t, first.NextPageToken)
+	if assert.NotNil(t, first.Facets) {
+		assert.Len(t, first.Facets.Categories, maxFacetValues)
+		assert.Equal(t, "category-24", first.Facets.Categories[0].Value)
+	}
+
+	repo.On("Search", mock.Anything, search, int32(2), int32(3)).Return(searchHits(1), nil).Once()
+
+	second, err := uc.SearchEvents(context.Background(), search, domain.PageRequest{Size: 2, Token: first.NextPageToken})
+
+	assert.NoError(t, err)
+	assert.Len(t, second.Hits, 1)
+	assert.Empty(t, second.NextPageToken)
+	assert.Nil(t, second.Facets, "facets come with the first page only")
+	repo.AssertExpectations(t)
+}
+
+func TestSearchEvents_TokenOfAnotherSearch(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	token := encodeOffsetToken(20, `"jazz"|""|""|0|0|2|""`)
+
+	_, err := uc.SearchEvents(context.Background(), domain.EventSearch{Query: "rock"}, domain.PageRequest{Token: token})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidPageToken)
+	repo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSearchEvents_StatusVisibility(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, err := uc.SearchEvents(callerContext("user-1", auth.RoleCustomer), domain.EventSearch{Query: "jazz", Status: domain.EventStatusDraft}, domain.PageRequest{})
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	repo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// An organizer's drafts search, facets included, stays within their own
+	// events.
+	own := domain.EventSearch{Query: "jazz", Status: domain.EventStatusDraft, OrganizerID: "org-1"}
+	repo.On("Search", mock.Anything, own, int32(0), int32(21)).Return(searchHits(1), nil).Once()
+	repo.On("SearchFacets", mock.Anything, own).Return(&domain.SearchFacets{}, nil).Once()
+
+	_, err = uc.SearchEvents(callerContext("org-1", auth.RoleOrganizer), domain.EventSearch{Query: "jazz", Status: domain.EventStatusDraft}, domain.PageRequest{})
+
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestSearchEvents_InvalidInput(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	for _, search := range []domain.EventSearch{
+		{Query: "  "},
+		{Query: strings.Repeat("a", maxQueryLength+1)},
+	} {
+		_, err := uc.SearchEvents(context.Background(), search, domain.PageRequest{})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	}
+	repo.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
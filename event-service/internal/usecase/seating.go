@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+type seatKey struct {
+	section string
+	row     string
+	number  int32
+}
+
+func (u *EventUsecase) CreateLayout(ctx context.Context, name string, seats []*domain.LayoutSeat) (*domain.Layout, error) {
+	if name == "" || len(seats) == 0 {
+		return nil, domain.ErrInvalidInput
+	}
+
+	seen := make(map[seatKey]bool, len(seats))
+	for _, seat := range seats {
+		if seat == nil || seat.Section == "" || seat.Row == "" || seat.Number <= 0 {
+			return nil, domain.ErrInvalidInput
+		}
+		key := seatKey{seat.Section, seat.Row, seat.Number}
+		if seen[key] {
+			return nil, domain.ErrInvalidInput
+		}
+		seen[key] = true
+	}
+
+	layout := &domain.Layout{
+		Name:  name,
+		Seats: seats,
+	}
+	if err := u.layouts.Create(ctx, layout); err != nil {
+		return nil, err
+	}
+
+	return layout, nil
+}
+
+func (u *EventUsecase) GetLayout(ctx context.Context, layoutID string) (*domain.Layout, error) {
+	if layoutID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	layout, err := u.layouts.GetByID(ctx, layoutID)
+	if err != nil {
+		return nil, err
+	}
+	if layout == nil {
+		return nil, domain.ErrLayoutNotFound
+	}
+
+	return layout, nil
+}
+
+// GetSeatMap returns an assigned-seating event with the live status of each of
+// its seats.
+func (u *EventUsecase) GetSeatMap(ctx context.Context, eventID string) (*domain.Event, []*domain.Seat, error) {
+	event, err := u.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if event.LayoutID == "" {
+		return nil, nil, domain.ErrNoAssignedSeating
+	}
+
+	seats, err := u.repo.ListSeats(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return event, seats, nil
+}
+
+func uniqueSeats(seatIDs []string) bool {
+	seen := make(map[string]bool, len(seatIDs))
+	for _, id := range seatIDs {
+		if id == "" || seen[id] {
+			return false
+		}
+		seen[id] = true
+	}
+	return true
+}
+
+// sameSeats reports whether a and b hold the same seat IDs in any order.
+func sameSeats(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
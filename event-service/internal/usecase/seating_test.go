@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testLayout() *domain.Layout {
+	return &domain.Layout{
+		ID:   "layout-1",
+		Name: "Main Hall",
+		Seats: []*domain.LayoutSeat{
+			{ID: "seat-a1", Section: "Stalls", Row: "A", Number: 1},
+			{ID: "seat-a2", Section: "Stalls", Row: "A", Number: 2},
+			{ID: "seat-a3", Section: "Stalls", Row: "A", Number: 3},
+		},
+	}
+}
+
+func TestCreateLayout_Success(t *testing.T) {
+	layouts := new(mocks.MockLayoutRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), layouts, new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	layouts.On("Create", mock.Anything, mock.AnythingOfType("*domain.Layout")).Return(nil)
+
+	layout, err := uc.CreateLayout(context.Background(), "Main Hall", testLayout().Seats)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Main Hall", layout.Name)
+	assert.Len(t, layout.Seats, 3)
+	layouts.AssertExpectations(t)
+}
+
+func TestCreateLayout_DuplicateSeat(t *testing.T) {
+	layouts := new(mocks.MockLayoutRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), layouts, new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	seats := []*domain.LayoutSeat{
+		{Section: "Stalls", Row: "A", Number: 1},
+		{Section: "Stalls", Row: "A", Number: 1},
+	}
+
+	layout, err := uc.CreateLayout(context.Background(), "Main Hall", seats)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Nil(t, layout)
+	layouts.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateLayout_InvalidSeat(t *testing.T) {
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, err := uc.CreateLayout(context.Background(), "Main Hall", []*domain.LayoutSeat{{Section: "Stalls", Row: "A"}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = uc.CreateLayout(context.Background(), "Main Hall", nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestGetLayout_NotFound(t *testing.T) {
+	layouts := new(mocks.MockLayoutRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), layouts, new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	layouts.On("GetByID", mock.Anything, "layout-1").Return(nil, nil)
+
+	layout, err := uc.GetLayout(context.Background(), "layout-1")
+
+	assert.ErrorIs(t, err, domain.ErrLayoutNotFound)
+	assert.Nil(t, layout)
+}
+
+func TestCreateEvent_WithLayout_UsesSeatCount(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	layouts := new(mocks.MockLayoutRepository)
+	uc := NewEventUsecase(repo, layouts, new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	layouts.On("GetByID", mock.Anything, "layout-1").Return(testLayout(), nil)
+	repo.On("Create", mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.LayoutID == "layout-1" && e.TotalSeats == 3
+	})).Return(nil)
+
+	event, err := uc.CreateEvent(context.Background(), "Play", domain.EventDetails{}, time.Now().Add(24*time.Hour), 0, "layout-1", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), event.TotalSeats)
+	repo.AssertExpectations(t)
+}
+
+func TestCreateEvent_WithLayout_SeatCountMismatch(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	layouts := new(mocks.MockLayoutRepository)
+	uc := NewEventUsecase(repo, layouts, new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	layouts.On("GetByID", mock.Anything, "layout-1").Return(testLayout(), nil)
+
+	event, err := uc.CreateEvent(context.Background(), "Play", domain.EventDetails{}, time.Now().Add(24*time.Hour), 100, "layout-1", "")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	assert.Nil(t, event)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateEvent_LayoutNotFound(t *testing.T) {
+	layouts := new(mocks.MockLayoutRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), layouts, new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	layouts.On("GetByID", mock.Anything, "layout-1").Return(nil, nil)
+
+	event, err := uc.CreateEvent(context.Background(), "Play", domain.EventDetails{}, time.Now().Add(24*time.Hour), 0, "layout-1", "")
+
+	assert.ErrorIs(t, err, domain.ErrLayoutNotFound)
+	assert.Nil(t, event)
+}
+
+func TestGetSeatMap_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", LayoutID: "layout-1"}, nil)
+	repo.On("ListSeats", mock.Anything, "event-1").Return([]*domain.Seat{
+		{ID: "seat-a1", Status: domain.SeatStatusReserved},
+		{ID: "seat-a2", Status: domain.SeatStatusAvailable},
+	}, nil)
+
+	event, seats, err := uc.GetSeatMap(context.Background(), "event-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "layout-1", event.LayoutID)
+	assert.Len(t, seats, 2)
+	repo.AssertExpectations(t)
+}
+
+func TestGetSeatMap_GeneralAdmission(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1"}, nil)
+
+	_, _, err := uc.GetSeatMap(context.Background(), "event-1")
+
+	assert.ErrorIs(t, err, domain.ErrNoAssignedSeating)
+	repo.AssertNotCalled(t, "ListSeats", mock.Anything, mock.Anything)
+}
+
+func TestReserveSeats_BySeat(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	seatIDs := []string{"seat-a1", "seat-a2"}
+	repo.On("ReserveSeats", mock.Anything, mock.MatchedBy(func(r *domain.Reservation) bool {
+		return r.Quantity == 2 && len(r.SeatIDs) == 2
+	})).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 2,
+		SeatIDs:  []string{"seat-a2", "seat-a1"},
+		Status:   domain.ReservationStatusReserved,
+	}, int32(1), nil)
+
+	reservation, available, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, seatIDs, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), reservation.Quantity)
+	assert.Equal(t, int32(1), available)
+	repo.AssertExpectations(t)
+}
+
+func TestReserveSeats_DuplicateSeats(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	_, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, []string{"seat-a1", "seat-a1"}, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, _, err = uc.ReserveSeats(context.Background(), "res-1", "event-1", 3, []string{"seat-a1"}, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	repo.AssertNotCalled(t, "ReserveSeats", mock.Anything, mock.Anything)
+}
+
+func TestReserveSeats_SeatUnavailable(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReserveSeats", mock.Anything, mock.AnythingOfType("*domain.Reservation")).Return(nil, int32(0), domain.ErrSeatUnavailable)
+
+	reservation, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, []string{"seat-a1"}, nil)
+
+	assert.ErrorIs(t, err, domain.ErrSeatUnavailable)
+	assert.Nil(t, reservation)
+}
+
+func TestReserveSeats_RetryWithDifferentSeats(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReserveSeats", mock.Anything, mock.AnythingOfType("*domain.Reservation")).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 1,
+		SeatIDs:  []string{"seat-a1"},
+		Status:   domain.ReservationStatusReserved,
+	}, int32(2), nil)
+
+	reservation, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, []string{"seat-a3"}, nil)
+
+	assert.ErrorIs(t, err, domain.ErrReservationConflict)
+	assert.Nil(t, reservation)
+}
+
+func TestUpdateAvailableTickets_AssignedSeating(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{
+		ID:             "event-1",
+		AvailableSeats: 3,
+		LayoutID:       "layout-1",
+	}, nil)
+
+	_, err := uc.UpdateAvailableTickets(context.Background(), "event-1", 1)
+
+	assert.ErrorIs(t, err, domain.ErrSeatingMismatch)
+	repo.AssertNotCalled(t, "UpdateAvailableSeats", mock.Anything, mock.Anything, mock.Anything)
+}
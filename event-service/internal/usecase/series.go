@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	// maxOccurrencesPerRun bounds the occurrences created for a series at
+	// once; the rest follow on the next run.
+	maxOccurrencesPerRun = 500
+	cancelPageSize       = 100
+)
+
+// CreateEventSeries creates a series and its occurrences up to the horizon.
+// As with CreateEvent, a series may only be held at the caller's own venue,
+// whose name it takes, and also its time zone, which the rule then runs in.
+func (u *EventUsecase) CreateEventSeries(ctx context.Context, series *domain.EventSeries) (*domain.EventSeries, error) {
+	if series.Name == "" || series.FirstStart.IsZero() || (series.VenueID != "" && series.Venue != "") {
+		return nil, domain.ErrInvalidInput
+	}
+	details, err := cleanDetails(series.EventDetails)
+	if err != nil {
+		return nil, err
+	}
+	series.EventDetails = details
+	series.TotalSeats, err = u.eventSeats(ctx, series.TotalSeats, series.LayoutID)
+	if err != nil {
+		return nil, err
+	}
+	series.TimeZone = ""
+	if series.VenueID != "" {
+		venue, err := u.linkableVenue(ctx, series.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		if series.TotalSeats > venue.Capacity {
+			return nil, domain.ErrVenueCapacity
+		}
+		series.Venue = venue.Name
+		series.TimeZone = venue.TimeZone
+	}
+
+	rule, err := seriesRule(series)
+	if err != nil {
+		return nil, err
+	}
+	series.RRule = rule.OrigOptions.RRuleString()
+	series.OrganizerID = callerSubject(ctx)
+	series.Until = time.Time{}
+	series.GeneratedUntil = time.Time{}
+	series.Finished = false
+
+	occurrences := nextOccurrences(series, rule, time.Now().Add(u.seriesHorizon))
+	// A rule that never matches would make an empty series.
+	if len(occurrences) == 0 && series.Finished {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := u.series.Create(ctx, series, occurrences); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+func (u *EventUsecase) GetEventSeries(ctx context.Context, seriesID string) (*domain.EventSeries, error) {
+	if seriesID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	series, err := u.series.GetByID(ctx, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if series == nil {
+		return nil, domain.ErrSeriesNotFound
+	}
+
+	return series, nil
+}
+
+// ExtendSeries creates the occurrences of up to limit series that have come
+// within the horizon. It returns how many series it extended, along with the
+// errors of those it could not.
+func (u *EventUsecase) ExtendSeries(ctx context.Context, limit int) (int, error) {
+	horizon := time.Now().Add(u.seriesHorizon)
+	due, err := u.series.ListDue(ctx, horizon, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	extended := 0
+	var errs []error
+	for _, series := range due {
+		rule, err := seriesRule(series)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("series %s: %w", series.ID, err))
+			continue
+		}
+		previousUntil := series.GeneratedUntil
+		occurrences := nextOccurrences(series, rule, horizon)
+
+		added, err := u.series.AddOccurrences(ctx, series, previousUntil, occurrences)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("series %s: %w", series.ID, err))
+			continue
+		}
+		// A series changed meanwhile is due again on the next run.
+		if added {
+			extended++
+		}
+	}
+
+	return extended, errors.Join(errs...)
+}
+
+// SetSeriesCapacity changes the total seats of a general admission series:
+// those of the occurrences it creates from now on, and of its upcoming ones
+// but for those whose capacity was set on their own. If one of them cannot
+// take the new total, none changes.
+func (u *EventUsecase) SetSeriesCapacity(ctx context.Context, seriesID string, totalSeats int32) (*domain.EventSeries, int32, error) {
+	if totalSeats <= 0 {
+		return nil, 0, domain.ErrInvalidInput
+	}
+
+	series, err := u.GetEventSeries(ctx, seriesID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := authorizeOwner(ctx, series.OrganizerID); err != nil {
+		return nil, 0, err
+	}
+	if series.LayoutID != "" {
+		return nil, 0, domain.ErrSeatingMismatch
+	}
+	if series.VenueID != "" {
+		venue, err := u.GetVenue(ctx, series.VenueID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if totalSeats > venue.Capacity {
+			return nil, 0, domain.ErrVenueCapacity
+		}
+	}
+
+	changed, err := u.series.SetTotalSeats(ctx, series.ID, totalSeats, time.Now())
+	if err != nil {
+		return nil, 0, err
+	}
+	series.TotalSeats = totalSeats
+
+	return series, changed, nil
+}
+
+// CancelEventSeries ends a series at from, or now when zero, and cancels its
+// occurrences starting from then on along with their bookings. Like
+// CancelEvent it is safe to retry, which finishes the bookings an earlier
+// attempt could not cancel.
+func (u *EventUsecase) CancelEventSeries(ctx context.Context, seriesID string, from time.Time) (*domain.EventSeries, int32, int32, error) {
+	if from.IsZero() {
+		from = time.Now()
+	}
+
+	series, err := u.GetEventSeries(ctx, seriesID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if err := authorizeOwner(ctx, series.OrganizerID); err != nil {
+		return nil, 0, 0, err
+	}
+
+	if err := u.series.End(ctx, series.ID, from); err != nil {
+		return nil, 0, 0, err
+	}
+	if series.Until.IsZero() || from.Before(series.Until) {
+		series.Until = from
+	}
+
+	var cancelledEvents, cancelledBookings int32
+	var failed []error
+	filter := domain.EventFilter{SeriesID: series.ID, StartsFrom: from}
+	order := domain.SortOrder{Column: "start_time"}
+	var after *domain.PageCursor
+	for {
+		occurrences, err := u.repo.List(ctx, filter, order, after, cancelPageSize)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		for _, occurrence := range occurrences {
+			if occurrence.Status == domain.EventStatusCompleted {
+				continue
+			}
+			_, cancelled, err := u.CancelEvent(ctx, occurrence.ID)
+			if err != nil && !errors.Is(err, domain.ErrBookingCancellations) {
+				return nil, 0, 0, err
+			}
+			if err != nil {
+				failed = append(failed, err)
+			}
+			if occurrence.Status != domain.EventStatusCancelled {
+				cancelledEvents++
+			}
+			cancelledBookings += cancelled
+		}
+		if len(occurrences) < cancelPageSize {
+			break
+		}
+		last := occurrences[len(occurrences)-1]
+		after = &domain.PageCursor{Key: last.StartTime, ID: last.ID}
+	}
+
+	return series, cancelledEvents, cancelledBookings, errors.Join(failed...)
+}
+
+// seriesRule parses the series' recurrence rule, starting at its first start
+// in its time zone. Rules must recur daily or less often; BYHOUR gives
+// several shows a day.
+func seriesRule(series *domain.EventSeries) (*rrule.RRule, error) {
+	loc := time.UTC
+	if series.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(series.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+
+	option, err := rrule.StrToROptionInLocation(strings.TrimSpace(series.RRule), loc)
+	if err != nil || !option.Dtstart.IsZero() || option.Freq > rrule.DAILY {
+		return nil, domain.ErrInvalidInput
+	}
+	option.Dtstart = series.FirstStart.In(loc)
+	rule, err := rrule.NewRRule(*option)
+	if err != nil {
+		return nil, domain.ErrInvalidInput
+	}
+	return rule, nil
+}
+
+// nextOccurrences returns the occurrences of the series from its
+// GeneratedUntil up to horizon or the end of the series, at most
+// maxOccurrencesPerRun of them. It moves GeneratedUntil past them, and sets
+// Finished once none are left.
+func nextOccurrences(series *domain.EventSeries, rule *rrule.RRule, horizon time.Time) []*domain.Event {
+	end, ending := horizon, false
+	if !series.Until.IsZero() && !series.Until.After(horizon) {
+		end, ending = series.Until, true
+	}
+
+	var occurrences []*domain.Event
+	next := rule.Iterator()
+	for {
+		start, ok := next()
+		if !ok {
+			series.GeneratedUntil = latest(series.GeneratedUntil, end)
+			series.Finished = true
+			return occurrences
+		}
+		if start.Before(series.GeneratedUntil) {
+			continue
+		}
+		if !start.Before(end) {
+			series.GeneratedUntil = latest(series.GeneratedUntil, end)
+			series.Finished = ending
+			return occurrences
+		}
+		if len(occurrences) == maxOccurrencesPerRun {
+			series.GeneratedUntil = start
+			return occurrences
+		}
+		occurrences = append(occurrences, occurrence(series, start))
+	}
+}
+
+func occurrence(series *domain.EventSeries, start time.Time) *domain.Event {
+	event := &domain.Event{
+		Name:         series.Name,
+		EventDetails: series.EventDetails,
+		StartTime:    start.UTC(),
+		TotalSeats:   series.TotalSeats,
+		LayoutID:     series.LayoutID,
+		Status:       domain.EventStatusDraft,
+		OrganizerID:  series.OrganizerID,
+		VenueID:      series.VenueID,
+		TimeZone:     series.TimeZone,
+		SeriesID:     series.ID,
+	}
+	event.Tags = slices.Clone(series.Tags)
+	if series.Publish {
+		event.Status = domain.EventStatusPublished
+	}
+	return event
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
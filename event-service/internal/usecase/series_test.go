@@ -0,0 +1,285 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/client"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testSeriesHorizon = 90 * 24 * time.Hour
+
+// createdOccurrences captures the occurrences a series was created with.
+func createdOccurrences(series *mocks.MockSeriesRepository) *[]*domain.Event {
+	var occurrences []*domain.Event
+	series.On("Create", mock.Anything, mock.AnythingOfType("*domain.EventSeries"), mock.Anything).
+		Run(func(args mock.Arguments) {
+			occurrences = args.Get(2).([]*domain.Event)
+		}).
+		Return(nil)
+	return &occurrences
+}
+
+func TestCreateEventSeries_Count(t *testing.T) {
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+	occurrences := createdOccurrences(series)
+
+	firstStart := time.Now().Add(24 * time.Hour).Truncate(time.Hour).UTC()
+	created, err := uc.CreateEventSeries(callerContext("org-1", auth.RoleOrganizer), &domain.EventSeries{
+		Name:       "Matinee",
+		RRule:      "FREQ=DAILY;COUNT=5",
+		FirstStart: firstStart,
+		TotalSeats: 80,
+		Publish:    true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "org-1", created.OrganizerID)
+	assert.True(t, created.Finished)
+	if assert.Len(t, *occurrences, 5) {
+		for i, occurrence := range *occurrences {
+			assert.Equal(t, firstStart.AddDate(0, 0, i), occurrence.StartTime)
+			assert.Equal(t, "Matinee", occurrence.Name)
+			assert.Equal(t, int32(80), occurrence.TotalSeats)
+			assert.Equal(t, domain.EventStatusPublished, occurrence.Status)
+			assert.Equal(t, "org-1", occurrence.OrganizerID)
+		}
+	}
+}
+
+func TestCreateEventSeries_KeepsLocalHour(t *testing.T) {
+	venues := new(mocks.MockVenueRepository)
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+	occurrences := createdOccurrences(series)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(&domain.Venue{ID: "venue-1", Name: "Town Hall", TimeZone: "Europe/Berlin", Capacity: 500}, nil)
+
+	// 20:00 in Berlin on the Friday before summer time starts.
+	created, err := uc.CreateEventSeries(context.Background(), &domain.EventSeries{
+		Name:       "Friday Jazz",
+		RRule:      "FREQ=WEEKLY;COUNT=2",
+		FirstStart: time.Date(2025, 3, 28, 19, 0, 0, 0, time.UTC),
+		TotalSeats: 200,
+		VenueID:    "venue-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Town Hall", created.Venue)
+	assert.Equal(t, "Europe/Berlin", created.TimeZone)
+	if assert.Len(t, *occurrences, 2) {
+		assert.Equal(t, time.Date(2025, 4, 4, 18, 0, 0, 0, time.UTC), (*occurrences)[1].StartTime)
+		assert.Equal(t, domain.EventStatusDraft, (*occurrences)[1].Status)
+		assert.Equal(t, "venue-1", (*occurrences)[1].VenueID)
+	}
+}
+
+func TestCreateEventSeries_StopsAtHorizon(t *testing.T) {
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+	occurrences := createdOccurrences(series)
+
+	created, err := uc.CreateEventSeries(context.Background(), &domain.EventSeries{
+		Name:       "Nightly",
+		RRule:      "FREQ=DAILY",
+		FirstStart: time.Now().Add(time.Hour),
+		TotalSeats: 50,
+	})
+
+	assert.NoError(t, err)
+	assert.False(t, created.Finished)
+	assert.Len(t, *occurrences, 90)
+	assert.WithinDuration(t, time.Now().Add(testSeriesHorizon), created.GeneratedUntil, time.Minute)
+}
+
+func TestCreateEventSeries_InvalidRule(t *testing.T) {
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+
+	firstStart := time.Now().Add(24 * time.Hour)
+	for _, rule := range []string{
+		"",
+		"every day",
+		"FREQ=HOURLY;COUNT=3",
+		"DTSTART:20260601T200000Z\nRRULE:FREQ=DAILY",
+		// Ends before it starts.
+		"FREQ=DAILY;UNTIL=20200101T000000Z",
+	} {
+		_, err := uc.CreateEventSeries(context.Background(), &domain.EventSeries{
+			Name:       "Nightly",
+			RRule:      rule,
+			FirstStart: firstStart,
+			TotalSeats: 50,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, rule)
+	}
+	series.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestExtendSeries_ContinuesWhereCreationStopped(t *testing.T) {
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+
+	firstStart := time.Now().Add(-30 * 24 * time.Hour).Truncate(time.Hour).UTC()
+	generatedUntil := firstStart.Add(100 * 24 * time.Hour)
+	due := []*domain.EventSeries{
+		{ID: "series-1", Name: "Nightly", RRule: "FREQ=DAILY", FirstStart: firstStart, TotalSeats: 50, GeneratedUntil: generatedUntil},
+		// Changed by someone else since it was read.
+		{ID: "series-2", Name: "Weekly", RRule: "FREQ=WEEKLY", FirstStart: firstStart, TotalSeats: 50, GeneratedUntil: generatedUntil},
+	}
+	series.On("ListDue", mock.Anything, mock.AnythingOfType("time.Time"), 10).Return(due, nil)
+	var added []*domain.Event
+	series.On("AddOccurrences", mock.Anything, due[0], generatedUntil, mock.Anything).
+		Run(func(args mock.Arguments) {
+			added = args.Get(3).([]*domain.Event)
+		}).
+		Return(true, nil)
+	series.On("AddOccurrences", mock.Anything, due[1], generatedUntil, mock.Anything).Return(false, nil)
+
+	extended, err := uc.ExtendSeries(context.Background(), 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, extended)
+	if assert.NotEmpty(t, added) {
+		assert.Equal(t, generatedUntil, added[0].StartTime)
+		assert.Equal(t, "series-1", added[0].SeriesID)
+	}
+	assert.True(t, due[0].GeneratedUntil.After(generatedUntil))
+}
+
+func TestNextOccurrences_CapsRun(t *testing.T) {
+	firstStart := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	series := &domain.EventSeries{RRule: "FREQ=DAILY", FirstStart: firstStart}
+	rule, err := seriesRule(series)
+	assert.NoError(t, err)
+
+	occurrences := nextOccurrences(series, rule, firstStart.AddDate(10, 0, 0))
+
+	assert.Len(t, occurrences, maxOccurrencesPerRun)
+	assert.False(t, series.Finished)
+	assert.Equal(t, firstStart.AddDate(0, 0, maxOccurrencesPerRun), series.GeneratedUntil)
+}
+
+func TestNextOccurrences_Until(t *testing.T) {
+	firstStart := time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	series := &domain.EventSeries{
+		RRule:      "FREQ=DAILY",
+		FirstStart: firstStart,
+		Until:      firstStart.AddDate(0, 0, 3),
+	}
+	rule, err := seriesRule(series)
+	assert.NoError(t, err)
+
+	occurrences := nextOccurrences(series, rule, firstStart.AddDate(1, 0, 0))
+
+	assert.Len(t, occurrences, 3)
+	assert.True(t, series.Finished)
+}
+
+func TestSetSeriesCapacity_Success(t *testing.T) {
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+
+	series.On("GetByID", mock.Anything, "series-1").Return(&domain.EventSeries{ID: "series-1", TotalSeats: 50, OrganizerID: "org-1"}, nil)
+	series.On("SetTotalSeats", mock.Anything, "series-1", int32(60), mock.AnythingOfType("time.Time")).Return(int32(4), nil)
+
+	updated, changed, err := uc.SetSeriesCapacity(callerContext("org-1", auth.RoleOrganizer), "series-1", 60)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(60), updated.TotalSeats)
+	assert.Equal(t, int32(4), changed)
+}
+
+func TestSetSeriesCapacity_Rejected(t *testing.T) {
+	venues := new(mocks.MockVenueRepository)
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+
+	series.On("GetByID", mock.Anything, "seated").Return(&domain.EventSeries{ID: "seated", TotalSeats: 50, LayoutID: "layout-1"}, nil)
+	series.On("GetByID", mock.Anything, "at-venue").Return(&domain.EventSeries{ID: "at-venue", TotalSeats: 50, VenueID: "venue-1", OrganizerID: "org-1"}, nil)
+	venues.On("GetByID", mock.Anything, "venue-1").Return(&domain.Venue{ID: "venue-1", Capacity: 100}, nil)
+
+	_, _, err := uc.SetSeriesCapacity(context.Background(), "seated", 60)
+	assert.ErrorIs(t, err, domain.ErrSeatingMismatch)
+
+	_, _, err = uc.SetSeriesCapacity(context.Background(), "at-venue", 120)
+	assert.ErrorIs(t, err, domain.ErrVenueCapacity)
+
+	_, _, err = uc.SetSeriesCapacity(callerContext("org-2", auth.RoleOrganizer), "at-venue", 60)
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+
+	_, _, err = uc.SetSeriesCapacity(context.Background(), "at-venue", 0)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	series.AssertNotCalled(t, "SetTotalSeats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCancelEventSeries_CancelsOccurrences(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	series := new(mocks.MockSeriesRepository)
+	bookings := new(mocks.MockBookingClient)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, bookings, testSeriesHorizon)
+
+	from := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	series.On("GetByID", mock.Anything, "series-1").Return(&domain.EventSeries{ID: "series-1", OrganizerID: "org-1"}, nil)
+	series.On("End", mock.Anything, "series-1", from).Return(nil)
+	occurrences := []*domain.Event{
+		{ID: "event-1", Status: domain.EventStatusPublished, OrganizerID: "org-1", SeriesID: "series-1"},
+		// Cancelled on its own earlier.
+		{ID: "event-2", Status: domain.EventStatusCancelled, OrganizerID: "org-1", SeriesID: "series-1"},
+		{ID: "event-3", Status: domain.EventStatusCompleted, OrganizerID: "org-1", SeriesID: "series-1"},
+		{ID: "event-4", Status: domain.EventStatusDraft, OrganizerID: "org-1", SeriesID: "series-1"},
+	}
+	repo.On("List", mock.Anything, domain.EventFilter{SeriesID: "series-1", StartsFrom: from}, domain.SortOrder{Column: "start_time"}, (*domain.PageCursor)(nil), int32(cancelPageSize)).Return(occurrences, nil)
+	for _, occurrence := range occurrences {
+		stored := *occurrence
+		repo.On("GetByID", mock.Anything, occurrence.ID).Return(&stored, nil)
+	}
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(e *domain.Event) bool {
+		return e.Status == domain.EventStatusCancelled
+	})).Return(true, nil)
+	bookings.On("CancelEventBookings", mock.Anything, "event-1").Return(int32(5), nil)
+	bookings.On("CancelEventBookings", mock.Anything, "event-2").Return(int32(0), nil)
+	bookings.On("CancelEventBookings", mock.Anything, "event-4").Return(int32(0), client.ErrBookingService)
+
+	cancelled, cancelledEvents, cancelledBookings, err := uc.CancelEventSeries(callerContext("org-1", auth.RoleOrganizer), "series-1", from)
+
+	// The occurrence whose bookings could not be cancelled fails the call
+	// without holding up the others.
+	assert.ErrorIs(t, err, domain.ErrBookingCancellations)
+	assert.Equal(t, from, cancelled.Until)
+	assert.Equal(t, int32(2), cancelledEvents)
+	assert.Equal(t, int32(5), cancelledBookings)
+	repo.AssertNumberOfCalls(t, "Update", 2)
+	bookings.AssertNotCalled(t, "CancelEventBookings", mock.Anything, "event-3")
+}
+
+func TestCancelEventSeries_OtherOrganizer(t *testing.T) {
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+
+	series.On("GetByID", mock.Anything, "series-1").Return(&domain.EventSeries{ID: "series-1", OrganizerID: "org-1"}, nil)
+
+	_, _, _, err := uc.CancelEventSeries(callerContext("org-2", auth.RoleOrganizer), "series-1", time.Time{})
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	series.AssertNotCalled(t, "End", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetEventSeries_NotFound(t *testing.T) {
+	series := new(mocks.MockSeriesRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), series, new(mocks.MockBookingClient), testSeriesHorizon)
+
+	series.On("GetByID", mock.Anything, "series-1").Return(nil, nil)
+
+	_, err := uc.GetEventSeries(context.Background(), "series-1")
+
+	assert.ErrorIs(t, err, domain.ErrSeriesNotFound)
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+func (u *EventUsecase) CreateTicketType(ctx context.Context, ticketType *domain.TicketType) (*domain.TicketType, error) {
+	if ticketType.EventID == "" || ticketType.Name == "" || ticketType.Capacity <= 0 {
+		return nil, domain.ErrInvalidInput
+	}
+	ticketType.Currency = strings.ToUpper(ticketType.Currency)
+	if err := validatePricing(ticketType); err != nil {
+		return nil, err
+	}
+
+	event, err := u.getEvent(ctx, ticketType.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeEvent(ctx, event); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.ticketTypes.ListByEventID(ctx, ticketType.EventID)
+	if err != nil {
+		return nil, err
+	}
+	capacity := ticketType.Capacity
+	for _, t := range existing {
+		// One currency per event keeps booking totals meaningful.
+		if t.Currency != ticketType.Currency {
+			return nil, domain.ErrInvalidInput
+		}
+		capacity += t.Capacity
+	}
+	if capacity > event.TotalSeats {
+		return nil, domain.ErrTicketCapacityExceeded
+	}
+
+	if err := u.ticketTypes.Create(ctx, ticketType); err != nil {
+		return nil, err
+	}
+
+	return ticketType, nil
+}
+
+func (u *EventUsecase) ListTicketTypes(ctx context.Context, eventID string) ([]*domain.TicketType, error) {
+	if _, err := u.GetEvent(ctx, eventID); err != nil {
+		return nil, err
+	}
+
+	return u.ticketTypes.ListByEventID(ctx, eventID)
+}
+
+// UpdateTicketType changes a ticket type's name, price and sale window. Seats
+// already reserved keep the price they were sold at.
+func (u *EventUsecase) UpdateTicketType(ctx context.Context, ticketType *domain.TicketType) (*domain.TicketType, error) {
+	if ticketType.ID == "" || ticketType.Name == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	existing, err := u.ticketTypes.GetByID(ctx, ticketType.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return nil, domain.ErrTicketTypeNotFound
+	}
+	if err := u.authorizeEventID(ctx, existing.EventID); err != nil {
+		return nil, err
+	}
+
+	existing.Name = ticketType.Name
+	existing.PriceMinor = ticketType.PriceMinor
+	existing.SalesStart = ticketType.SalesStart
+	existing.SalesEnd = ticketType.SalesEnd
+	if err := validatePricing(existing); err != nil {
+		return nil, err
+	}
+
+	if err := u.ticketTypes.Update(ctx, existing); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func validatePricing(ticketType *domain.TicketType) error {
+	if ticketType.PriceMinor < 0 || !validCurrency(ticketType.Currency) {
+		return domain.ErrInvalidInput
+	}
+	if !ticketType.SalesStart.IsZero() && !ticketType.SalesEnd.IsZero() &&
+		!ticketType.SalesEnd.After(ticketType.SalesStart) {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+// validCurrency accepts upper-case three-letter ISO 4217 style codes.
+func validCurrency(code string) bool {
+	if len(code) != 3 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// itemQuantity sums the item quantities, reporting false for an empty ticket
+// type, a non-positive quantity or a ticket type listed twice.
+func itemQuantity(items []*domain.ReservationItem) (int32, bool) {
+	var total int32
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item == nil || item.TicketTypeID == "" || item.Quantity <= 0 || seen[item.TicketTypeID] {
+			return 0, false
+		}
+		seen[item.TicketTypeID] = true
+		total += item.Quantity
+	}
+	return total, true
+}
+
+// sameItems reports whether a and b take the same quantities of the same
+// ticket types, in any order.
+func sameItems(a, b []*domain.ReservationItem) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	quantities := make(map[string]int32, len(a))
+	for _, item := range a {
+		quantities[item.TicketTypeID] = item.Quantity
+	}
+	for _, item := range b {
+		if quantity, ok := quantities[item.TicketTypeID]; !ok || quantity != item.Quantity {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateTicketType_Success(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	ticketTypes := new(mocks.MockTicketTypeRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), ticketTypes, new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", TotalSeats: 100}, nil)
+	ticketTypes.On("ListByEventID", mock.Anything, "event-1").Return([]*domain.TicketType{
+		{ID: "ga", Capacity: 80, Currency: "USD"},
+	}, nil)
+	ticketTypes.On("Create", mock.Anything, mock.MatchedBy(func(t *domain.TicketType) bool {
+		return t.Currency == "USD" && t.Capacity == 20
+	})).Return(nil)
+
+	ticketType, err := uc.CreateTicketType(context.Background(), &domain.TicketType{
+		EventID:    "event-1",
+		Name:       "VIP",
+		Capacity:   20,
+		PriceMinor: 15000,
+		Currency:   "usd",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", ticketType.Currency)
+	ticketTypes.AssertExpectations(t)
+}
+
+func TestCreateTicketType_ExceedsEventCapacity(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	ticketTypes := new(mocks.MockTicketTypeRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), ticketTypes, new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", TotalSeats: 100}, nil)
+	ticketTypes.On("ListByEventID", mock.Anything, "event-1").Return([]*domain.TicketType{
+		{ID: "ga", Capacity: 90, Currency: "USD"},
+	}, nil)
+
+	_, err := uc.CreateTicketType(context.Background(), &domain.TicketType{
+		EventID:  "event-1",
+		Name:     "VIP",
+		Capacity: 20,
+		Currency: "USD",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrTicketCapacityExceeded)
+	ticketTypes.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateTicketType_MixedCurrency(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	ticketTypes := new(mocks.MockTicketTypeRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), ticketTypes, new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", TotalSeats: 100}, nil)
+	ticketTypes.On("ListByEventID", mock.Anything, "event-1").Return([]*domain.TicketType{
+		{ID: "ga", Capacity: 10, Currency: "USD"},
+	}, nil)
+
+	_, err := uc.CreateTicketType(context.Background(), &domain.TicketType{
+		EventID:  "event-1",
+		Name:     "VIP",
+		Capacity: 10,
+		Currency: "EUR",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestCreateTicketType_InvalidInput(t *testing.T) {
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []*domain.TicketType{
+		{EventID: "event-1", Name: "", Capacity: 10, Currency: "USD"},
+		{EventID: "event-1", Name: "GA", Capacity: 0, Currency: "USD"},
+		{EventID: "event-1", Name: "GA", Capacity: 10, Currency: "DOLLARS"},
+		{EventID: "event-1", Name: "GA", Capacity: 10, Currency: "USD", PriceMinor: -1},
+		{EventID: "event-1", Name: "GA", Capacity: 10, Currency: "USD", SalesStart: start, SalesEnd: start},
+	}
+	for _, tc := range cases {
+		_, err := uc.CreateTicketType(context.Background(), tc)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	}
+}
+
+func TestUpdateTicketType_KeepsCapacityAndCurrency(t *testing.T) {
+	ticketTypes := new(mocks.MockTicketTypeRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), ticketTypes, new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	ticketTypes.On("GetByID", mock.Anything, "vip").Return(&domain.TicketType{
+		ID:         "vip",
+		Name:       "VIP",
+		Capacity:   20,
+		PriceMinor: 15000,
+		Currency:   "USD",
+	}, nil)
+	ticketTypes.On("Update", mock.Anything, mock.AnythingOfType("*domain.TicketType")).Return(nil)
+
+	updated, err := uc.UpdateTicketType(context.Background(), &domain.TicketType{
+		ID:         "vip",
+		Name:       "VIP Lounge",
+		PriceMinor: 18000,
+		Capacity:   999,
+		Currency:   "EUR",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "VIP Lounge", updated.Name)
+	assert.Equal(t, int64(18000), updated.PriceMinor)
+	assert.Equal(t, int32(20), updated.Capacity)
+	assert.Equal(t, "USD", updated.Currency)
+}
+
+func TestUpdateTicketType_NotFound(t *testing.T) {
+	ticketTypes := new(mocks.MockTicketTypeRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), ticketTypes, new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	ticketTypes.On("GetByID", mock.Anything, "vip").Return(nil, nil)
+
+	_, err := uc.UpdateTicketType(context.Background(), &domain.TicketType{ID: "vip", Name: "VIP"})
+
+	assert.ErrorIs(t, err, domain.ErrTicketTypeNotFound)
+}
+
+func TestReserveSeats_WithItems(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	items := []*domain.ReservationItem{
+		{TicketTypeID: "ga", Quantity: 2},
+		{TicketTypeID: "vip", Quantity: 1},
+	}
+	repo.On("ReserveSeats", mock.Anything, mock.MatchedBy(func(r *domain.Reservation) bool {
+		return r.Quantity == 3 && len(r.Items) == 2
+	})).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 3,
+		Items: []*domain.ReservationItem{
+			{TicketTypeID: "vip", Quantity: 1, UnitPriceMinor: 15000, Currency: "USD"},
+			{TicketTypeID: "ga", Quantity: 2, UnitPriceMinor: 5000, Currency: "USD"},
+		},
+		Status: domain.ReservationStatusReserved,
+	}, int32(97), nil)
+
+	reservation, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, nil, items)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), reservation.Quantity)
+	assert.Equal(t, int64(15000), reservation.Items[0].UnitPriceMinor)
+	repo.AssertExpectations(t)
+}
+
+func TestReserveSeats_InvalidItems(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	cases := [][]*domain.ReservationItem{
+		{{TicketTypeID: "ga", Quantity: 0}},
+		{{TicketTypeID: "", Quantity: 1}},
+		{{TicketTypeID: "ga", Quantity: 1}, {TicketTypeID: "ga", Quantity: 1}},
+	}
+	for _, items := range cases {
+		_, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, nil, items)
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	}
+
+	_, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 5, nil, []*domain.ReservationItem{{TicketTypeID: "ga", Quantity: 2}})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	repo.AssertNotCalled(t, "ReserveSeats", mock.Anything, mock.Anything)
+}
+
+func TestReserveSeats_RetryWithDifferentItems(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), new(mocks.MockVenueRepository), new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	repo.On("ReserveSeats", mock.Anything, mock.AnythingOfType("*domain.Reservation")).Return(&domain.Reservation{
+		ID:       "res-1",
+		EventID:  "event-1",
+		Quantity: 2,
+		Items:    []*domain.ReservationItem{{TicketTypeID: "ga", Quantity: 2}},
+		Status:   domain.ReservationStatusReserved,
+	}, int32(98), nil)
+
+	_, _, err := uc.ReserveSeats(context.Background(), "res-1", "event-1", 0, nil, []*domain.ReservationItem{{TicketTypeID: "vip", Quantity: 2}})
+
+	assert.ErrorIs(t, err, domain.ErrReservationConflict)
+}
+
+func TestTicketTypeOnSale(t *testing.T) {
+	start := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	ticketType := &domain.TicketType{SalesStart: start, SalesEnd: end}
+
+	assert.False(t, ticketType.OnSale(start.Add(-time.Second)))
+	assert.True(t, ticketType.OnSale(start))
+	assert.False(t, ticketType.OnSale(end))
+	assert.True(t, (&domain.TicketType{}).OnSale(start))
+}
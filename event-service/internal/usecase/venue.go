@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+)
+
+const defaultVenuePageSize = 20
+
+// CreateVenue records a venue owned by the caller.
+func (u *EventUsecase) CreateVenue(ctx context.Context, venue *domain.Venue) (*domain.Venue, error) {
+	if err := cleanVenue(venue); err != nil {
+		return nil, err
+	}
+	venue.OrganizerID = callerSubject(ctx)
+
+	if err := u.venues.Create(ctx, venue); err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
+func (u *EventUsecase) GetVenue(ctx context.Context, venueID string) (*domain.Venue, error) {
+	if venueID == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	venue, err := u.venues.GetByID(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	if venue == nil {
+		return nil, domain.ErrVenueNotFound
+	}
+
+	return venue, nil
+}
+
+func (u *EventUsecase) ListVenues(ctx context.Context, filter domain.VenueFilter, page domain.PageRequest) ([]*domain.Venue, string, error) {
+	size, err := pageSize(page.Size, defaultVenuePageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	filter.CountryCode = strings.ToUpper(filter.CountryCode)
+
+	query := fmt.Sprintf("%q|%q", filter.City, filter.CountryCode)
+	after, err := decodePageToken(page.Token, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	venues, err := u.venues.List(ctx, filter, after, size+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(venues) <= int(size) {
+		return venues, "", nil
+	}
+
+	venues = venues[:size]
+	last := venues[size-1]
+	return venues, encodePageToken(domain.PageCursor{Key: last.CreatedAt, ID: last.ID}, query), nil
+}
+
+// UpdateVenue replaces a venue's name, address, location, time zone and
+// capacity. The events at the venue take on its new name and time zone.
+func (u *EventUsecase) UpdateVenue(ctx context.Context, venue *domain.Venue) (*domain.Venue, error) {
+	existing, err := u.GetVenue(ctx, venue.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeOwner(ctx, existing.OrganizerID); err != nil {
+		return nil, err
+	}
+	if err := cleanVenue(venue); err != nil {
+		return nil, err
+	}
+	venue.OrganizerID = existing.OrganizerID
+	venue.CreatedAt = existing.CreatedAt
+
+	if err := u.venues.Update(ctx, venue); err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
+// DeleteVenue deletes a venue no event is linked to.
+func (u *EventUsecase) DeleteVenue(ctx context.Context, venueID string) error {
+	venue, err := u.GetVenue(ctx, venueID)
+	if err != nil {
+		return err
+	}
+	if err := authorizeOwner(ctx, venue.OrganizerID); err != nil {
+		return err
+	}
+
+	return u.venues.Delete(ctx, venueID)
+}
+
+// linkableVenue loads a venue the caller may hold events at: their own, or
+// any venue for admins and services.
+func (u *EventUsecase) linkableVenue(ctx context.Context, venueID string) (*domain.Venue, error) {
+	venue, err := u.GetVenue(ctx, venueID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeOwner(ctx, venue.OrganizerID); err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// linkVenue holds the event at the venue, which must have room for its seats.
+func linkVenue(event *domain.Event, venue *domain.Venue) error {
+	if event.TotalSeats > venue.Capacity {
+		return domain.ErrVenueCapacity
+	}
+	event.VenueID = venue.ID
+	event.Venue = venue.Name
+	event.TimeZone = venue.TimeZone
+	return nil
+}
+
+// cleanVenue trims the venue's fields and checks them. A city, a country and
+// a time zone are required.
+func cleanVenue(venue *domain.Venue) error {
+	venue.Name = strings.TrimSpace(venue.Name)
+	address := &venue.Address
+	address.Street = strings.TrimSpace(address.Street)
+	address.City = strings.TrimSpace(address.City)
+	address.Region = strings.TrimSpace(address.Region)
+	address.PostalCode = strings.TrimSpace(address.PostalCode)
+	address.CountryCode = strings.ToUpper(strings.TrimSpace(address.CountryCode))
+	venue.TimeZone = strings.TrimSpace(venue.TimeZone)
+
+	for _, field := range []string{venue.Name, address.Street, address.City, address.Region, address.PostalCode} {
+		if utf8.RuneCountInString(field) > maxLabelLength {
+			return domain.ErrInvalidInput
+		}
+	}
+	if venue.Name == "" || address.City == "" || !isCountryCode(address.CountryCode) || venue.Capacity <= 0 {
+		return domain.ErrInvalidInput
+	}
+	if loc := venue.Location; loc != nil {
+		if math.IsNaN(loc.Latitude) || math.IsNaN(loc.Longitude) ||
+			math.Abs(loc.Latitude) > 90 || math.Abs(loc.Longitude) > 180 {
+			return domain.ErrInvalidInput
+		}
+	}
+	// "Local" would be the server's zone, not the venue's.
+	if venue.TimeZone == "" || venue.TimeZone == "Local" {
+		return domain.ErrInvalidInput
+	}
+	if _, err := time.LoadLocation(venue.TimeZone); err != nil {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+func isCountryCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/auth"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain"
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/domain/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func townHall() *domain.Venue {
+	return &domain.Venue{
+		ID:          "venue-1",
+		Name:        "Town Hall",
+		Address:     domain.Address{City: "Berlin", CountryCode: "DE"},
+		TimeZone:    "Europe/Berlin",
+		Capacity:    500,
+		OrganizerID: "org-1",
+	}
+}
+
+func TestCreateVenue_Success(t *testing.T) {
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("Create", mock.Anything, mock.AnythingOfType("*domain.Venue")).Return(nil)
+
+	venue, err := uc.CreateVenue(callerContext("org-1", auth.RoleOrganizer), &domain.Venue{
+		Name:     " Town Hall ",
+		Address:  domain.Address{City: "Berlin", CountryCode: "de"},
+		Location: &domain.GeoPoint{Latitude: 52.52, Longitude: 13.40},
+		TimeZone: "Europe/Berlin",
+		Capacity: 500,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Town Hall", venue.Name)
+	assert.Equal(t, "DE", venue.Address.CountryCode)
+	assert.Equal(t, "org-1", venue.OrganizerID)
+}
+
+func TestCreateVenue_InvalidInput(t *testing.T) {
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	for name, change := range map[string]func(*domain.Venue){
+		"no name":       func(v *domain.Venue) { v.Name = "" },
+		"no city":       func(v *domain.Venue) { v.Address.City = "" },
+		"bad country":   func(v *domain.Venue) { v.Address.CountryCode = "DEU" },
+		"no capacity":   func(v *domain.Venue) { v.Capacity = 0 },
+		"no time zone":  func(v *domain.Venue) { v.TimeZone = "" },
+		"bad time zone": func(v *domain.Venue) { v.TimeZone = "Mars/Olympus" },
+		"local zone":    func(v *domain.Venue) { v.TimeZone = "Local" },
+		"bad latitude":  func(v *domain.Venue) { v.Location = &domain.GeoPoint{Latitude: 91} },
+	} {
+		venue := townHall()
+		change(venue)
+
+		_, err := uc.CreateVenue(context.Background(), venue)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput, name)
+	}
+	venues.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUpdateVenue_OtherOrganizer(t *testing.T) {
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(townHall(), nil)
+
+	_, err := uc.UpdateVenue(callerContext("org-2", auth.RoleOrganizer), townHall())
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	venues.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateVenue_KeepsOwner(t *testing.T) {
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(townHall(), nil)
+	venues.On("Update", mock.Anything, mock.MatchedBy(func(v *domain.Venue) bool {
+		return v.OrganizerID == "org-1" && v.Capacity == 400
+	})).Return(nil)
+
+	update := townHall()
+	update.OrganizerID = ""
+	update.Capacity = 400
+	_, err := uc.UpdateVenue(callerContext("admin", auth.RoleAdmin), update)
+
+	assert.NoError(t, err)
+	venues.AssertExpectations(t)
+}
+
+func TestListVenues_Pages(t *testing.T) {
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(new(mocks.MockEventRepository), new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	created := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	venues.On("List", mock.Anything, domain.VenueFilter{CountryCode: "DE"}, (*domain.PageCursor)(nil), int32(2)).Return([]*domain.Venue{
+		{ID: "venue-1", CreatedAt: created},
+		{ID: "venue-2", CreatedAt: created.Add(time.Hour)},
+	}, nil)
+	venues.On("List", mock.Anything, domain.VenueFilter{CountryCode: "DE"}, &domain.PageCursor{Key: created, ID: "venue-1"}, int32(2)).Return([]*domain.Venue{
+		{ID: "venue-2", CreatedAt: created.Add(time.Hour)},
+	}, nil)
+
+	first, token, err := uc.ListVenues(context.Background(), domain.VenueFilter{CountryCode: "de"}, domain.PageRequest{Size: 1})
+	assert.NoError(t, err)
+	assert.Len(t, first, 1)
+
+	second, token, err := uc.ListVenues(context.Background(), domain.VenueFilter{CountryCode: "DE"}, domain.PageRequest{Size: 1, Token: token})
+	assert.NoError(t, err)
+	assert.Equal(t, "venue-2", second[0].ID)
+	assert.Empty(t, token)
+}
+
+func TestCreateEvent_AtVenue(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(townHall(), nil)
+	repo.On("Create", mock.Anything, mock.AnythingOfType("*domain.Event")).Return(nil)
+
+	event, err := uc.CreateEvent(context.Background(), "Concert", domain.EventDetails{}, time.Now().Add(24*time.Hour), 500, "", "venue-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "venue-1", event.VenueID)
+	assert.Equal(t, "Town Hall", event.Venue)
+	assert.Equal(t, "Europe/Berlin", event.TimeZone)
+}
+
+func TestCreateEvent_ExceedsVenueCapacity(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(townHall(), nil)
+
+	_, err := uc.CreateEvent(context.Background(), "Concert", domain.EventDetails{}, time.Now().Add(24*time.Hour), 501, "", "venue-1")
+
+	assert.ErrorIs(t, err, domain.ErrVenueCapacity)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateEvent_OtherOrganizersVenue(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(townHall(), nil)
+
+	_, err := uc.CreateEvent(callerContext("org-2", auth.RoleOrganizer), "Concert", domain.EventDetails{}, time.Now().Add(24*time.Hour), 100, "", "venue-1")
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestUpdateEvent_OtherOrganizersVenue(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(townHall(), nil)
+
+	venueID := "venue-1"
+	_, err := uc.UpdateEvent(callerContext("org-2", auth.RoleOrganizer), "event-1", domain.EventUpdate{VenueID: &venueID})
+
+	assert.ErrorIs(t, err, domain.ErrPermissionDenied)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestUpdateEvent_MovesAndLeavesVenue(t *testing.T) {
+	repo := new(mocks.MockEventRepository)
+	venues := new(mocks.MockVenueRepository)
+	uc := NewEventUsecase(repo, new(mocks.MockLayoutRepository), new(mocks.MockTicketTypeRepository), venues, new(mocks.MockOrganizerRepository), new(mocks.MockSeriesRepository), new(mocks.MockBookingClient), testSeriesHorizon)
+
+	venues.On("GetByID", mock.Anything, "venue-1").Return(townHall(), nil)
+	repo.On("GetByID", mock.Anything, "event-1").Return(&domain.Event{ID: "event-1", TotalSeats: 100, Status: domain.EventStatusDraft}, nil).Once()
+	repo.On("Update", mock.Anything, mock.Anything).Return(true, nil)
+
+	venueID := "venue-1"
+	event, err := uc.UpdateEvent(context.Background(), "event-1", domain.EventUpdate{VenueID: &venueID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Town Hall", event.Venue)
+	assert.Equal(t, "Europe/Berlin", event.TimeZone)
+
+	// Its name can no longer be set, but it can leave the venue.
+	name := "Old Town Hall"
+	repo.On("GetByID", mock.Anything, "event-1").Return(event, nil)
+	_, err = uc.UpdateEvent(context.Background(), "event-1", domain.EventUpdate{Venue: &name})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	noVenue := ""
+	event, err = uc.UpdateEvent(context.Background(), "event-1", domain.EventUpdate{VenueID: &noVenue})
+	assert.NoError(t, err)
+	assert.Empty(t, event.VenueID)
+	assert.Empty(t, event.TimeZone)
+	assert.Equal(t, "Town Hall", event.Venue)
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/azatmuhammetamanov01/online-ticket-booking/event-service/internal/logger"
+	"go.uber.org/zap"
+)
+
+type SeriesExtender interface {
+	ExtendSeries(ctx context.Context, limit int) (int, error)
+}
+
+// SeriesRoller periodically creates the occurrences of event series that
+// have come within the horizon. A series is only extended if it is unchanged
+// since it was read, so every replica can run one.
+type SeriesRoller struct {
+	svc       SeriesExtender
+	interval  time.Duration
+	batchSize int
+}
+
+func NewSeriesRoller(svc SeriesExtender, interval time.Duration, batchSize int) *SeriesRoller {
+	return &SeriesRoller{
+		svc:       svc,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (r *SeriesRoller) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	logger.Info("Series roller started", zap.Duration("interval", r.interval))
+	// Catch up straight away rather than an interval after startup.
+	r.roll(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Series roller stopped")
+			return
+		case <-ticker.C:
+			r.roll(ctx)
+		}
+	}
+}
+
+func (r *SeriesRoller) roll(ctx context.Context) {
+	for {
+		extended, err := r.svc.ExtendSeries(ctx, r.batchSize)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("Series roller: extending series failed", zap.Error(err))
+		}
+		if extended > 0 {
+			logger.Info("Series roller: extended series", zap.Int("count", extended))
+		}
+		// A full batch means there is probably a backlog; keep going.
+		if extended < r.batchSize || ctx.Err() != nil {
+			return
+		}
+	}
+}
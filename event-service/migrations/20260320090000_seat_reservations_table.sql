@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS seat_reservations (
+    id VARCHAR(64) PRIMARY KEY,
+    -- NULL for releases that arrived before their reservation.
+    event_id UUID REFERENCES events(id),
+    quantity INT NOT NULL CHECK (quantity >= 0),
+    status INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    released_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_seat_reservations_event_id ON seat_reservations(event_id);
+
+ALTER TABLE events ADD CONSTRAINT events_available_seats_range
+    CHECK (available_seats >= 0 AND available_seats <= total_seats);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_available_seats_range;
+DROP TABLE seat_reservations;
+-- +goose StatementEnd
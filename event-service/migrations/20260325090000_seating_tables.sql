@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS venue_layouts (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS layout_seats (
+    id UUID PRIMARY KEY,
+    layout_id UUID NOT NULL REFERENCES venue_layouts(id) ON DELETE CASCADE,
+    section TEXT NOT NULL,
+    row_label TEXT NOT NULL,
+    number INT NOT NULL,
+    UNIQUE (layout_id, section, row_label, number)
+);
+
+ALTER TABLE events ADD COLUMN IF NOT EXISTS layout_id UUID REFERENCES venue_layouts(id);
+
+-- Seats are copied from the layout when the event is created, so later layout
+-- edits never change an event that is already on sale.
+CREATE TABLE IF NOT EXISTS event_seats (
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    id UUID NOT NULL,
+    section TEXT NOT NULL,
+    row_label TEXT NOT NULL,
+    number INT NOT NULL,
+    status INT NOT NULL,
+    reservation_id VARCHAR(64),
+    PRIMARY KEY (event_id, id)
+);
+CREATE INDEX IF NOT EXISTS idx_event_seats_reservation_id ON event_seats(reservation_id) WHERE reservation_id IS NOT NULL;
+
+ALTER TABLE seat_reservations ADD COLUMN IF NOT EXISTS seat_ids TEXT[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE seat_reservations DROP COLUMN IF EXISTS seat_ids;
+DROP TABLE event_seats;
+ALTER TABLE events DROP COLUMN IF EXISTS layout_id;
+DROP TABLE layout_seats;
+DROP TABLE venue_layouts;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS ticket_types (
+    id UUID PRIMARY KEY,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    capacity INT NOT NULL CHECK (capacity > 0),
+    available INT NOT NULL,
+    price_minor BIGINT NOT NULL CHECK (price_minor >= 0),
+    currency CHAR(3) NOT NULL,
+    sales_start TIMESTAMP,
+    sales_end TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (event_id, name),
+    CHECK (available >= 0 AND available <= capacity)
+);
+CREATE INDEX IF NOT EXISTS idx_ticket_types_event_id ON ticket_types(event_id);
+
+-- Prices are copied at reservation time so later price changes leave existing
+-- reservations untouched.
+CREATE TABLE IF NOT EXISTS reservation_items (
+    reservation_id VARCHAR(64) NOT NULL REFERENCES seat_reservations(id) ON DELETE CASCADE,
+    ticket_type_id UUID NOT NULL REFERENCES ticket_types(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    unit_price_minor BIGINT NOT NULL,
+    currency CHAR(3) NOT NULL,
+    PRIMARY KEY (reservation_id, ticket_type_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reservation_items;
+DROP TABLE ticket_types;
+-- +goose StatementEnd
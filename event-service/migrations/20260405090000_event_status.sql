@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Existing events were already on sale, so they start out published; new
+-- events start as drafts (1) and must be published explicitly.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS status INT NOT NULL DEFAULT 2;
+ALTER TABLE events ALTER COLUMN status SET DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
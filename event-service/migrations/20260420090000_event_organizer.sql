@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Events created before organizers were tracked have none and can only be
+-- managed by admins.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS organizer_id TEXT;
+CREATE INDEX IF NOT EXISTS idx_events_organizer_id ON events (organizer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_organizer_id;
+ALTER TABLE events DROP COLUMN IF EXISTS organizer_id;
+-- +goose StatementEnd
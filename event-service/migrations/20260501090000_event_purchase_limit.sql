@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN IF NOT EXISTS max_tickets_per_user INTEGER NOT NULL DEFAULT 0
+    CHECK (max_tickets_per_user >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN IF EXISTS max_tickets_per_user;
+-- +goose StatementEnd
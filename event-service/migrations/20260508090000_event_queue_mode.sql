@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events ADD COLUMN IF NOT EXISTS queue_admissions_per_minute INTEGER NOT NULL DEFAULT 0
+    CHECK (queue_admissions_per_minute >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE events DROP COLUMN IF EXISTS queue_admissions_per_minute;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every committed change to an event's available seats is announced on the
+-- event_availability channel as "<event_id>:<available_seats>", whichever
+-- query made it.
+CREATE FUNCTION notify_event_availability() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('event_availability', NEW.id || ':' || NEW.available_seats);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_availability_notify
+    AFTER UPDATE OF available_seats ON events
+    FOR EACH ROW
+    WHEN (OLD.available_seats IS DISTINCT FROM NEW.available_seats)
+    EXECUTE FUNCTION notify_event_availability();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS events_availability_notify ON events;
+DROP FUNCTION IF EXISTS notify_event_availability();
+-- +goose StatementEnd
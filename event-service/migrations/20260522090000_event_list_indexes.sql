@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyset pagination of ListEvents: one index per sort order, ties broken by id.
+CREATE INDEX IF NOT EXISTS idx_events_start_time_id ON events (start_time, id);
+CREATE INDEX IF NOT EXISTS idx_events_created_at_id ON events (created_at, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_created_at_id;
+DROP INDEX IF EXISTS idx_events_start_time_id;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE events
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN venue TEXT NOT NULL DEFAULT '',
+    ADD COLUMN category TEXT NOT NULL DEFAULT '',
+    ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN search_vector TSVECTOR;
+
+-- SearchEvents matches search_vector, weighted so the name counts most, then
+-- the tags and venue, then the description. A trigger keeps it rather than a
+-- generated column, which cannot use array_to_string.
+CREATE FUNCTION events_search_vector() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector :=
+        setweight(to_tsvector('english', NEW.name), 'A') ||
+        setweight(to_tsvector('english', array_to_string(NEW.tags, ' ')), 'B') ||
+        setweight(to_tsvector('english', NEW.venue), 'B') ||
+        setweight(to_tsvector('english', NEW.description), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER events_search_vector_update
+    BEFORE INSERT OR UPDATE OF name, description, venue, tags ON events
+    FOR EACH ROW
+    EXECUTE FUNCTION events_search_vector();
+
+-- Runs the trigger for the events already there.
+UPDATE events SET name = name;
+
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_search_vector;
+DROP TRIGGER IF EXISTS events_search_vector_update ON events;
+DROP FUNCTION IF EXISTS events_search_vector();
+ALTER TABLE events
+    DROP COLUMN search_vector,
+    DROP COLUMN tags,
+    DROP COLUMN category,
+    DROP COLUMN venue,
+    DROP COLUMN description;
+-- +goose StatementEnd
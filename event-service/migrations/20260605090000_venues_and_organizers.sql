@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS venues (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    street TEXT NOT NULL DEFAULT '',
+    city TEXT NOT NULL,
+    region TEXT NOT NULL DEFAULT '',
+    postal_code TEXT NOT NULL DEFAULT '',
+    country_code CHAR(2) NOT NULL,
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION,
+    time_zone TEXT NOT NULL,
+    capacity INT NOT NULL CHECK (capacity > 0),
+    organizer_id TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK ((latitude IS NULL) = (longitude IS NULL))
+);
+CREATE INDEX IF NOT EXISTS idx_venues_created_at_id ON venues (created_at, id);
+
+-- An organizer's ID is the subject of its account, which events already
+-- record as their organizer_id. Not every organizer has a profile, so there
+-- is no foreign key from events.
+CREATE TABLE IF NOT EXISTS organizers (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL DEFAULT '',
+    phone TEXT NOT NULL DEFAULT '',
+    website TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_organizers_created_at_id ON organizers (created_at, id);
+
+-- The venue's name and time zone are copied to its events, so lists and
+-- search need no join.
+ALTER TABLE events
+    ADD COLUMN venue_id UUID REFERENCES venues (id),
+    ADD COLUMN time_zone TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS idx_events_venue_id ON events (venue_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_venue_id;
+ALTER TABLE events
+    DROP COLUMN time_zone,
+    DROP COLUMN venue_id;
+DROP TABLE IF EXISTS organizers;
+DROP TABLE IF EXISTS venues;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS event_series (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    venue TEXT NOT NULL DEFAULT '',
+    category TEXT NOT NULL DEFAULT '',
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    rrule TEXT NOT NULL,
+    first_start TIMESTAMP NOT NULL,
+    time_zone TEXT NOT NULL DEFAULT '',
+    total_seats INT NOT NULL,
+    layout_id UUID REFERENCES venue_layouts (id),
+    venue_id UUID REFERENCES venues (id),
+    organizer_id TEXT,
+    publish BOOLEAN NOT NULL DEFAULT FALSE,
+    until TIMESTAMP,
+    generated_until TIMESTAMP NOT NULL,
+    finished BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- The series still to be extended, furthest behind first.
+CREATE INDEX IF NOT EXISTS idx_event_series_due ON event_series (generated_until) WHERE NOT finished;
+
+ALTER TABLE events
+    ADD COLUMN series_id UUID REFERENCES event_series (id),
+    ADD COLUMN seats_overridden BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS idx_events_series_id_start_time ON events (series_id, start_time) WHERE series_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_events_series_id_start_time;
+ALTER TABLE events
+    DROP COLUMN seats_overridden,
+    DROP COLUMN series_id;
+DROP TABLE IF EXISTS event_series;
+-- +goose StatementEnd
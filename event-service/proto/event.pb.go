@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,6 +24,163 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Events start as drafts and are only bookable while published.
+type EventStatus int32
+
+const (
+	EventStatus_EVENT_STATUS_UNSPECIFIED  EventStatus = 0
+	EventStatus_EVENT_STATUS_DRAFT        EventStatus = 1
+	EventStatus_EVENT_STATUS_PUBLISHED    EventStatus = 2
+	EventStatus_EVENT_STATUS_SALES_CLOSED EventStatus = 3
+	EventStatus_EVENT_STATUS_CANCELLED    EventStatus = 4
+	EventStatus_EVENT_STATUS_COMPLETED    EventStatus = 5
+)
+
+// Enum value maps for EventStatus.
+var (
+	EventStatus_name = map[int32]string{
+		0: "EVENT_STATUS_UNSPECIFIED",
+		1: "EVENT_STATUS_DRAFT",
+		2: "EVENT_STATUS_PUBLISHED",
+		3: "EVENT_STATUS_SALES_CLOSED",
+		4: "EVENT_STATUS_CANCELLED",
+		5: "EVENT_STATUS_COMPLETED",
+	}
+	EventStatus_value = map[string]int32{
+		"EVENT_STATUS_UNSPECIFIED":  0,
+		"EVENT_STATUS_DRAFT":        1,
+		"EVENT_STATUS_PUBLISHED":    2,
+		"EVENT_STATUS_SALES_CLOSED": 3,
+		"EVENT_STATUS_CANCELLED":    4,
+		"EVENT_STATUS_COMPLETED":    5,
+	}
+)
+
+func (x EventStatus) Enum() *EventStatus {
+	p := new(EventStatus)
+	*p = x
+	return p
+}
+
+func (x EventStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[0].Descriptor()
+}
+
+func (EventStatus) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[0]
+}
+
+func (x EventStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventStatus.Descriptor instead.
+func (EventStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{0}
+}
+
+type ReservationStatus int32
+
+const (
+	ReservationStatus_RESERVATION_STATUS_UNSPECIFIED ReservationStatus = 0
+	ReservationStatus_RESERVATION_STATUS_RESERVED    ReservationStatus = 1
+	ReservationStatus_RESERVATION_STATUS_RELEASED    ReservationStatus = 2
+)
+
+// Enum value maps for ReservationStatus.
+var (
+	ReservationStatus_name = map[int32]string{
+		0: "RESERVATION_STATUS_UNSPECIFIED",
+		1: "RESERVATION_STATUS_RESERVED",
+		2: "RESERVATION_STATUS_RELEASED",
+	}
+	ReservationStatus_value = map[string]int32{
+		"RESERVATION_STATUS_UNSPECIFIED": 0,
+		"RESERVATION_STATUS_RESERVED":    1,
+		"RESERVATION_STATUS_RELEASED":    2,
+	}
+)
+
+func (x ReservationStatus) Enum() *ReservationStatus {
+	p := new(ReservationStatus)
+	*p = x
+	return p
+}
+
+func (x ReservationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[1].Descriptor()
+}
+
+func (ReservationStatus) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[1]
+}
+
+func (x ReservationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationStatus.Descriptor instead.
+func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{1}
+}
+
+type SeatStatus int32
+
+const (
+	SeatStatus_SEAT_STATUS_UNSPECIFIED SeatStatus = 0
+	SeatStatus_SEAT_STATUS_AVAILABLE   SeatStatus = 1
+	SeatStatus_SEAT_STATUS_RESERVED    SeatStatus = 2
+)
+
+// Enum value maps for SeatStatus.
+var (
+	SeatStatus_name = map[int32]string{
+		0: "SEAT_STATUS_UNSPECIFIED",
+		1: "SEAT_STATUS_AVAILABLE",
+		2: "SEAT_STATUS_RESERVED",
+	}
+	SeatStatus_value = map[string]int32{
+		"SEAT_STATUS_UNSPECIFIED": 0,
+		"SEAT_STATUS_AVAILABLE":   1,
+		"SEAT_STATUS_RESERVED":    2,
+	}
+)
+
+func (x SeatStatus) Enum() *SeatStatus {
+	p := new(SeatStatus)
+	*p = x
+	return p
+}
+
+func (x SeatStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeatStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_proto_enumTypes[2].Descriptor()
+}
+
+func (SeatStatus) Type() protoreflect.EnumType {
+	return &file_event_proto_enumTypes[2]
+}
+
+func (x SeatStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeatStatus.Descriptor instead.
+func (SeatStatus) EnumDescriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{2}
+}
+
 type Event struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -31,8 +189,38 @@ type Event struct {
 	TotalSeats     int32                  `protobuf:"varint,4,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
 	AvailableSeats int32                  `protobuf:"varint,5,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Set for assigned-seating events.
+	LayoutId string      `protobuf:"bytes,7,opt,name=layout_id,json=layoutId,proto3" json:"layout_id,omitempty"`
+	Status   EventStatus `protobuf:"varint,8,opt,name=status,proto3,enum=event.EventStatus" json:"status,omitempty"`
+	// Subject of the organizer who created the event; empty for events created
+	// before organizers were tracked.
+	OrganizerId string `protobuf:"bytes,9,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	// Most tickets one user may hold for the event across their live bookings;
+	// 0 means no limit. Enforced by booking-service.
+	MaxTicketsPerUser int32 `protobuf:"varint,10,opt,name=max_tickets_per_user,json=maxTicketsPerUser,proto3" json:"max_tickets_per_user,omitempty"`
+	// While above 0, buyers go through booking-service's waiting room, which
+	// admits this many of them per minute.
+	QueueAdmissionsPerMinute int32  `protobuf:"varint,11,opt,name=queue_admissions_per_minute,json=queueAdmissionsPerMinute,proto3" json:"queue_admissions_per_minute,omitempty"`
+	Description              string `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`
+	// Name of the place the event is held at.
+	Venue string `protobuf:"bytes,13,opt,name=venue,proto3" json:"venue,omitempty"`
+	// Lowercase, like the tags.
+	Category string   `protobuf:"bytes,14,opt,name=category,proto3" json:"category,omitempty"`
+	Tags     []string `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Set for events held at a venue, whose name is then in venue.
+	VenueId string `protobuf:"bytes,16,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	// IANA time zone of the venue, such as "Europe/Berlin"; empty without one.
+	TimeZone string `protobuf:"bytes,17,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// start_time in RFC 3339 with the venue's offset, such as
+	// "2026-06-01T20:00:00+02:00"; in UTC for events without a venue.
+	LocalStartTime string `protobuf:"bytes,18,opt,name=local_start_time,json=localStartTime,proto3" json:"local_start_time,omitempty"`
+	// Set for occurrences of an event series.
+	SeriesId string `protobuf:"bytes,19,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Set once the occurrence's total seats were changed on their own; the
+	// series' capacity changes then leave it alone.
+	SeatsOverridden bool `protobuf:"varint,20,opt,name=seats_overridden,json=seatsOverridden,proto3" json:"seats_overridden,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Event) Reset() {
@@ -107,11 +295,121 @@ func (x *Event) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Event) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+func (x *Event) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *Event) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *Event) GetMaxTicketsPerUser() int32 {
+	if x != nil {
+		return x.MaxTicketsPerUser
+	}
+	return 0
+}
+
+func (x *Event) GetQueueAdmissionsPerMinute() int32 {
+	if x != nil {
+		return x.QueueAdmissionsPerMinute
+	}
+	return 0
+}
+
+func (x *Event) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Event) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *Event) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Event) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Event) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *Event) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Event) GetLocalStartTime() string {
+	if x != nil {
+		return x.LocalStartTime
+	}
+	return ""
+}
+
+func (x *Event) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Event) GetSeatsOverridden() bool {
+	if x != nil {
+		return x.SeatsOverridden
+	}
+	return false
+}
+
 type CreateEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TotalSeats    int32                  `protobuf:"varint,3,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// May be left zero when layout_id is set; the layout's seat count is used.
+	TotalSeats int32  `protobuf:"varint,3,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	LayoutId   string `protobuf:"bytes,4,opt,name=layout_id,json=layoutId,proto3" json:"layout_id,omitempty"`
+	// At most 5000 characters.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// At most 200 characters, like the category and each tag.
+	Venue    string `protobuf:"bytes,6,opt,name=venue,proto3" json:"venue,omitempty"`
+	Category string `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	// At most 20.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Holds the event at the venue, which must have room for total_seats. The
+	// event takes the venue's name, so venue must be left empty.
+	VenueId       string `protobuf:"bytes,9,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -167,6 +465,48 @@ func (x *CreateEventRequest) GetTotalSeats() int32 {
 	return 0
 }
 
+func (x *CreateEventRequest) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateEventRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
 type CreateEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
@@ -299,10 +639,33 @@ func (x *GetEventResponse) GetEvent() *Event {
 	return nil
 }
 
+// Lists events a page at a time. Pages follow each other by page token, so
+// events added or removed meanwhile do not shift them.
 type ListEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Page size: 10 when unset, at most 100.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// next_page_token of the previous page. The other fields must be the same
+	// as for that page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only events starting at or after starts_from and before starts_before.
+	StartsFrom   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_from,json=startsFrom,proto3" json:"starts_from,omitempty"`
+	StartsBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_before,json=startsBefore,proto3" json:"starts_before,omitempty"`
+	// Defaults to published. Other statuses need the organizer, admin or
+	// service role, and organizers only see their own events.
+	Status EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=event.EventStatus" json:"status,omitempty"`
+	// Only events whose name contains this, ignoring case.
+	Name string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	// Only events with seats left.
+	HasAvailability bool `protobuf:"varint,8,opt,name=has_availability,json=hasAvailability,proto3" json:"has_availability,omitempty"`
+	// "start_time" (the default) or "created_at", optionally followed by
+	// " desc".
+	OrderBy string `protobuf:"bytes,9,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Only events of this organizer, and at this venue.
+	OrganizerId string `protobuf:"bytes,10,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	VenueId     string `protobuf:"bytes,11,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	// Only occurrences of this event series.
+	SeriesId      string `protobuf:"bytes,12,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -344,17 +707,81 @@ func (x *ListEventsRequest) GetLimit() int32 {
 	return 0
 }
 
-func (x *ListEventsRequest) GetOffset() int32 {
+func (x *ListEventsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Offset
+		return x.PageToken
 	}
-	return 0
+	return ""
+}
+
+func (x *ListEventsRequest) GetStartsFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsFrom
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetStartsBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsBefore
+	}
+	return nil
+}
+
+func (x *ListEventsRequest) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *ListEventsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetHasAvailability() bool {
+	if x != nil {
+		return x.HasAvailability
+	}
+	return false
+}
+
+func (x *ListEventsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
 }
 
 type ListEventsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Events []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -396,35 +823,55 @@ func (x *ListEventsResponse) GetEvents() []*Event {
 	return nil
 }
 
-func (x *ListEventsResponse) GetTotalCount() int32 {
+func (x *ListEventsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.TotalCount
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
-type UpdateTicketsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+// Searches the name, description, venue and tags of events. Results come
+// best match first; the name counts most, then the tags and venue, then the
+// description.
+type SearchEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required. Words, "quoted phrases", "or" between alternatives and -word to
+	// leave events with it out. At most 256 characters.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Only events of this category, and at this venue.
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Venue    string `protobuf:"bytes,3,opt,name=venue,proto3" json:"venue,omitempty"`
+	// Only events starting at or after starts_from and before starts_before.
+	StartsFrom   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=starts_from,json=startsFrom,proto3" json:"starts_from,omitempty"`
+	StartsBefore *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_before,json=startsBefore,proto3" json:"starts_before,omitempty"`
+	// As for ListEvents: published by default, other statuses only for
+	// organizers, among their own events, admins and services.
+	Status EventStatus `protobuf:"varint,6,opt,name=status,proto3,enum=event.EventStatus" json:"status,omitempty"`
+	// Only events of this organizer.
+	OrganizerId string `protobuf:"bytes,9,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	// 20 when unset, at most 100. Results end after the first 1000.
+	PageSize int32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page. The other fields must be the same
+	// as for that page.
+	PageToken     string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTicketsRequest) Reset() {
-	*x = UpdateTicketsRequest{}
+func (x *SearchEventsRequest) Reset() {
+	*x = SearchEventsRequest{}
 	mi := &file_event_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTicketsRequest) String() string {
+func (x *SearchEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTicketsRequest) ProtoMessage() {}
+func (*SearchEventsRequest) ProtoMessage() {}
 
-func (x *UpdateTicketsRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_event_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -436,48 +883,4510 @@ func (x *UpdateTicketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTicketsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTicketsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchEventsRequest.ProtoReflect.Descriptor instead.
+func (*SearchEventsRequest) Descriptor() ([]byte, []int) {
 	return file_event_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UpdateTicketsRequest) GetEventId() string {
+func (x *SearchEventsRequest) GetQuery() string {
 	if x != nil {
-		return x.EventId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *UpdateTicketsRequest) GetQuantity() int32 {
+func (x *SearchEventsRequest) GetCategory() string {
 	if x != nil {
-		return x.Quantity
+		return x.Category
 	}
-	return 0
+	return ""
 }
 
-type UpdateTicketsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	AvailableSeats int32                  `protobuf:"varint,1,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
-	Success        bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *SearchEventsRequest) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
 }
 
-func (x *UpdateTicketsResponse) Reset() {
-	*x = UpdateTicketsResponse{}
-	mi := &file_event_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchEventsRequest) GetStartsFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsFrom
+	}
+	return nil
 }
 
-func (x *UpdateTicketsResponse) String() string {
+func (x *SearchEventsRequest) GetStartsBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsBefore
+	}
+	return nil
+}
+
+func (x *SearchEventsRequest) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *SearchEventsRequest) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *SearchEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchEventsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Counts of all the matching events, not just this page. Only set on the
+	// first page.
+	Facets        *SearchFacets `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchEventsResponse) Reset() {
+	*x = SearchEventsResponse{}
+	mi := &file_event_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchEventsResponse) ProtoMessage() {}
+
+func (x *SearchEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchEventsResponse.ProtoReflect.Descriptor instead.
+func (*SearchEventsResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchEventsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *SearchEventsResponse) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Between 0 and 1; higher is a better match.
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// The name and up to two fragments of the description, HTML-escaped, with
+	// the matching words between <mark> and </mark>.
+	NameHighlight        string `protobuf:"bytes,3,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	DescriptionHighlight string `protobuf:"bytes,4,opt,name=description_highlight,json=descriptionHighlight,proto3" json:"description_highlight,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_event_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchResult) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *SearchResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchResult) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *SearchResult) GetDescriptionHighlight() string {
+	if x != nil {
+		return x.DescriptionHighlight
+	}
+	return ""
+}
+
+type SearchFacets struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The 20 most common categories and venues, most common first.
+	Categories []*FacetCount `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Venues     []*FacetCount `protobuf:"bytes,2,rep,name=venues,proto3" json:"venues,omitempty"`
+	// Start times by month, as "2026-06", in order.
+	Months        []*FacetCount `protobuf:"bytes,3,rep,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	mi := &file_event_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchFacets) ProtoMessage() {}
+
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchFacets.ProtoReflect.Descriptor instead.
+func (*SearchFacets) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchFacets) GetCategories() []*FacetCount {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetVenues() []*FacetCount {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetMonths() []*FacetCount {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+type FacetCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetCount) Reset() {
+	*x = FacetCount{}
+	mi := &file_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetCount) ProtoMessage() {}
+
+func (x *FacetCount) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetCount.ProtoReflect.Descriptor instead.
+func (*FacetCount) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FacetCount) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type UpdateTicketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTicketsRequest) Reset() {
+	*x = UpdateTicketsRequest{}
+	mi := &file_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketsRequest) ProtoMessage() {}
+
+func (x *UpdateTicketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTicketsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTicketsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UpdateTicketsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type UpdateTicketsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AvailableSeats int32                  `protobuf:"varint,1,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	Success        bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTicketsResponse) Reset() {
+	*x = UpdateTicketsResponse{}
+	mi := &file_event_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketsResponse) ProtoMessage() {}
+
+func (x *UpdateTicketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTicketsResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateTicketsResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *UpdateTicketsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Reservation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        ReservationStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=event.ReservationStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReleasedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	SeatIds       []string               `protobuf:"bytes,7,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	Items         []*ReservationItem     `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_event_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Reservation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reservation) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *Reservation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *Reservation) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ReservationItem takes seats from one ticket type. The price fields are set by
+// the server from the ticket type at reservation time.
+type ReservationItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypeId   string                 `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Quantity       int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPriceMinor int64                  `protobuf:"varint,3,opt,name=unit_price_minor,json=unitPriceMinor,proto3" json:"unit_price_minor,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_event_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReservationItem) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReservationItem) GetUnitPriceMinor() int64 {
+	if x != nil {
+		return x.UnitPriceMinor
+	}
+	return 0
+}
+
+func (x *ReservationItem) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ReserveSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// May be left zero when seat_ids is set.
+	Quantity int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Required for assigned-seating events, rejected for general admission.
+	SeatIds []string `protobuf:"bytes,4,rep,name=seat_ids,json=seatIds,proto3" json:"seat_ids,omitempty"`
+	// Required for events with ticket types; quantities add up to quantity.
+	Items         []*ReservationItem `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsRequest) Reset() {
+	*x = ReserveSeatsRequest{}
+	mi := &file_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsRequest) ProtoMessage() {}
+
+func (x *ReserveSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReserveSeatsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReserveSeatsRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveSeatsRequest) GetSeatIds() []string {
+	if x != nil {
+		return x.SeatIds
+	}
+	return nil
+}
+
+func (x *ReserveSeatsRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ReserveSeatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reservation    *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReserveSeatsResponse) Reset() {
+	*x = ReserveSeatsResponse{}
+	mi := &file_event_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveSeatsResponse) ProtoMessage() {}
+
+func (x *ReserveSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReserveSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReserveSeatsResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReserveSeatsResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+type ReleaseSeatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// Set only for bookings made before reservations existed, which took their
+	// seats straight off the event. If nothing was ever recorded under
+	// reservation_id, the release gives these seats back once.
+	Legacy        *LegacyHold `protobuf:"bytes,2,opt,name=legacy,proto3" json:"legacy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsRequest) Reset() {
+	*x = ReleaseSeatsRequest{}
+	mi := &file_event_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsRequest) ProtoMessage() {}
+
+func (x *ReleaseSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReleaseSeatsRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ReleaseSeatsRequest) GetLegacy() *LegacyHold {
+	if x != nil {
+		return x.Legacy
+	}
+	return nil
+}
+
+type LegacyHold struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LegacyHold) Reset() {
+	*x = LegacyHold{}
+	mi := &file_event_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LegacyHold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LegacyHold) ProtoMessage() {}
+
+func (x *LegacyHold) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LegacyHold.ProtoReflect.Descriptor instead.
+func (*LegacyHold) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LegacyHold) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *LegacyHold) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReleaseSeatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reservation    *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReleaseSeatsResponse) Reset() {
+	*x = ReleaseSeatsResponse{}
+	mi := &file_event_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseSeatsResponse) ProtoMessage() {}
+
+func (x *ReleaseSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseSeatsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseSeatsResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReleaseSeatsResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+// Changes the number of seats a held general admission reservation keeps.
+// Growing fails as a whole if seats are short.
+type ResizeReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeReservationRequest) Reset() {
+	*x = ResizeReservationRequest{}
+	mi := &file_event_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeReservationRequest) ProtoMessage() {}
+
+func (x *ResizeReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeReservationRequest.ProtoReflect.Descriptor instead.
+func (*ResizeReservationRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ResizeReservationRequest) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *ResizeReservationRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ResizeReservationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reservation    *Reservation           `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResizeReservationResponse) Reset() {
+	*x = ResizeReservationResponse{}
+	mi := &file_event_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeReservationResponse) ProtoMessage() {}
+
+func (x *ResizeReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeReservationResponse.ProtoReflect.Descriptor instead.
+func (*ResizeReservationResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResizeReservationResponse) GetReservation() *Reservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ResizeReservationResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+type LayoutSeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Section       string                 `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Row           string                 `protobuf:"bytes,3,opt,name=row,proto3" json:"row,omitempty"`
+	Number        int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LayoutSeat) Reset() {
+	*x = LayoutSeat{}
+	mi := &file_event_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LayoutSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutSeat) ProtoMessage() {}
+
+func (x *LayoutSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutSeat.ProtoReflect.Descriptor instead.
+func (*LayoutSeat) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LayoutSeat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LayoutSeat) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *LayoutSeat) GetRow() string {
+	if x != nil {
+		return x.Row
+	}
+	return ""
+}
+
+func (x *LayoutSeat) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+type VenueLayout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Seats         []*LayoutSeat          `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VenueLayout) Reset() {
+	*x = VenueLayout{}
+	mi := &file_event_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VenueLayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueLayout) ProtoMessage() {}
+
+func (x *VenueLayout) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueLayout.ProtoReflect.Descriptor instead.
+func (*VenueLayout) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VenueLayout) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VenueLayout) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VenueLayout) GetSeats() []*LayoutSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *VenueLayout) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateVenueLayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Seats         []*LayoutSeat          `protobuf:"bytes,2,rep,name=seats,proto3" json:"seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVenueLayoutRequest) Reset() {
+	*x = CreateVenueLayoutRequest{}
+	mi := &file_event_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVenueLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVenueLayoutRequest) ProtoMessage() {}
+
+func (x *CreateVenueLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVenueLayoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateVenueLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateVenueLayoutRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVenueLayoutRequest) GetSeats() []*LayoutSeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type CreateVenueLayoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Layout        *VenueLayout           `protobuf:"bytes,1,opt,name=layout,proto3" json:"layout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVenueLayoutResponse) Reset() {
+	*x = CreateVenueLayoutResponse{}
+	mi := &file_event_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVenueLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVenueLayoutResponse) ProtoMessage() {}
+
+func (x *CreateVenueLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVenueLayoutResponse.ProtoReflect.Descriptor instead.
+func (*CreateVenueLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateVenueLayoutResponse) GetLayout() *VenueLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+type GetVenueLayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LayoutId      string                 `protobuf:"bytes,1,opt,name=layout_id,json=layoutId,proto3" json:"layout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenueLayoutRequest) Reset() {
+	*x = GetVenueLayoutRequest{}
+	mi := &file_event_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenueLayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenueLayoutRequest) ProtoMessage() {}
+
+func (x *GetVenueLayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenueLayoutRequest.ProtoReflect.Descriptor instead.
+func (*GetVenueLayoutRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetVenueLayoutRequest) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+type GetVenueLayoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Layout        *VenueLayout           `protobuf:"bytes,1,opt,name=layout,proto3" json:"layout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenueLayoutResponse) Reset() {
+	*x = GetVenueLayoutResponse{}
+	mi := &file_event_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenueLayoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenueLayoutResponse) ProtoMessage() {}
+
+func (x *GetVenueLayoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenueLayoutResponse.ProtoReflect.Descriptor instead.
+func (*GetVenueLayoutResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetVenueLayoutResponse) GetLayout() *VenueLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+type Seat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Section       string                 `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Row           string                 `protobuf:"bytes,3,opt,name=row,proto3" json:"row,omitempty"`
+	Number        int32                  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Status        SeatStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=event.SeatStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Seat) Reset() {
+	*x = Seat{}
+	mi := &file_event_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Seat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seat) ProtoMessage() {}
+
+func (x *Seat) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seat.ProtoReflect.Descriptor instead.
+func (*Seat) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Seat) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Seat) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Seat) GetRow() string {
+	if x != nil {
+		return x.Row
+	}
+	return ""
+}
+
+func (x *Seat) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Seat) GetStatus() SeatStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeatStatus_SEAT_STATUS_UNSPECIFIED
+}
+
+type GetSeatMapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeatMapRequest) Reset() {
+	*x = GetSeatMapRequest{}
+	mi := &file_event_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapRequest) ProtoMessage() {}
+
+func (x *GetSeatMapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapRequest.ProtoReflect.Descriptor instead.
+func (*GetSeatMapRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSeatMapRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetSeatMapResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	LayoutId       string                 `protobuf:"bytes,2,opt,name=layout_id,json=layoutId,proto3" json:"layout_id,omitempty"`
+	Seats          []*Seat                `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,4,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSeatMapResponse) Reset() {
+	*x = GetSeatMapResponse{}
+	mi := &file_event_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeatMapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeatMapResponse) ProtoMessage() {}
+
+func (x *GetSeatMapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeatMapResponse.ProtoReflect.Descriptor instead.
+func (*GetSeatMapResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSeatMapResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *GetSeatMapResponse) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+func (x *GetSeatMapResponse) GetSeats() []*Seat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *GetSeatMapResponse) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+// TicketType is a priced tier of an event. Prices are in minor currency units,
+// e.g. cents.
+type TicketType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Available     int32                  `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	PriceMinor    int64                  `protobuf:"varint,6,opt,name=price_minor,json=priceMinor,proto3" json:"price_minor,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	SalesStart    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketType) Reset() {
+	*x = TicketType{}
+	mi := &file_event_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketType) ProtoMessage() {}
+
+func (x *TicketType) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketType.ProtoReflect.Descriptor instead.
+func (*TicketType) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TicketType) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketType) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *TicketType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TicketType) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *TicketType) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *TicketType) GetPriceMinor() int64 {
+	if x != nil {
+		return x.PriceMinor
+	}
+	return 0
+}
+
+func (x *TicketType) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TicketType) GetSalesStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesStart
+	}
+	return nil
+}
+
+func (x *TicketType) GetSalesEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return nil
+}
+
+func (x *TicketType) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateTicketTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	PriceMinor    int64                  `protobuf:"varint,4,opt,name=price_minor,json=priceMinor,proto3" json:"price_minor,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	SalesStart    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeRequest) Reset() {
+	*x = CreateTicketTypeRequest{}
+	mi := &file_event_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeRequest) ProtoMessage() {}
+
+func (x *CreateTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateTicketTypeRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateTicketTypeRequest) GetPriceMinor() int64 {
+	if x != nil {
+		return x.PriceMinor
+	}
+	return 0
+}
+
+func (x *CreateTicketTypeRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateTicketTypeRequest) GetSalesStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesStart
+	}
+	return nil
+}
+
+func (x *CreateTicketTypeRequest) GetSalesEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return nil
+}
+
+type CreateTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketType    *TicketType            `protobuf:"bytes,1,opt,name=ticket_type,json=ticketType,proto3" json:"ticket_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTicketTypeResponse) Reset() {
+	*x = CreateTicketTypeResponse{}
+	mi := &file_event_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTicketTypeResponse) ProtoMessage() {}
+
+func (x *CreateTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateTicketTypeResponse) GetTicketType() *TicketType {
+	if x != nil {
+		return x.TicketType
+	}
+	return nil
+}
+
+type ListTicketTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTypesRequest) Reset() {
+	*x = ListTicketTypesRequest{}
+	mi := &file_event_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTypesRequest) ProtoMessage() {}
+
+func (x *ListTicketTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListTicketTypesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTicketTypesRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type ListTicketTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypes   []*TicketType          `protobuf:"bytes,1,rep,name=ticket_types,json=ticketTypes,proto3" json:"ticket_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTicketTypesResponse) Reset() {
+	*x = ListTicketTypesResponse{}
+	mi := &file_event_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTicketTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTicketTypesResponse) ProtoMessage() {}
+
+func (x *ListTicketTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTicketTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListTicketTypesResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListTicketTypesResponse) GetTicketTypes() []*TicketType {
+	if x != nil {
+		return x.TicketTypes
+	}
+	return nil
+}
+
+// UpdateTicketTypeRequest replaces the name, price and sale window. Capacity
+// and currency cannot be changed.
+type UpdateTicketTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketTypeId  string                 `protobuf:"bytes,1,opt,name=ticket_type_id,json=ticketTypeId,proto3" json:"ticket_type_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PriceMinor    int64                  `protobuf:"varint,3,opt,name=price_minor,json=priceMinor,proto3" json:"price_minor,omitempty"`
+	SalesStart    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sales_start,json=salesStart,proto3" json:"sales_start,omitempty"`
+	SalesEnd      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sales_end,json=salesEnd,proto3" json:"sales_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTicketTypeRequest) Reset() {
+	*x = UpdateTicketTypeRequest{}
+	mi := &file_event_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketTypeRequest) ProtoMessage() {}
+
+func (x *UpdateTicketTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTicketTypeRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateTicketTypeRequest) GetTicketTypeId() string {
+	if x != nil {
+		return x.TicketTypeId
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTicketTypeRequest) GetPriceMinor() int64 {
+	if x != nil {
+		return x.PriceMinor
+	}
+	return 0
+}
+
+func (x *UpdateTicketTypeRequest) GetSalesStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesStart
+	}
+	return nil
+}
+
+func (x *UpdateTicketTypeRequest) GetSalesEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SalesEnd
+	}
+	return nil
+}
+
+type UpdateTicketTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketType    *TicketType            `protobuf:"bytes,1,opt,name=ticket_type,json=ticketType,proto3" json:"ticket_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTicketTypeResponse) Reset() {
+	*x = UpdateTicketTypeResponse{}
+	mi := &file_event_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTicketTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTicketTypeResponse) ProtoMessage() {}
+
+func (x *UpdateTicketTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTicketTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTicketTypeResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UpdateTicketTypeResponse) GetTicketType() *TicketType {
+	if x != nil {
+		return x.TicketType
+	}
+	return nil
+}
+
+// Changes the fields listed in update_mask, which may clear them. Without a
+// mask, the fields that are set are changed.
+type UpdateEventRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	EventId     string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Venue       string                 `protobuf:"bytes,4,opt,name=venue,proto3" json:"venue,omitempty"`
+	Category    string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Paths among name, description, venue, category, tags and venue_id.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Moves the event to another venue, or away from its venue if cleared.
+	// venue cannot be set while the event is at a venue.
+	VenueId       string `protobuf:"bytes,8,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventRequest) Reset() {
+	*x = UpdateEventRequest{}
+	mi := &file_event_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventRequest) ProtoMessage() {}
+
+func (x *UpdateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateEventRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateEventRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type UpdateEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventResponse) Reset() {
+	*x = UpdateEventResponse{}
+	mi := &file_event_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventResponse) ProtoMessage() {}
+
+func (x *UpdateEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type RescheduleEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleEventRequest) Reset() {
+	*x = RescheduleEventRequest{}
+	mi := &file_event_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleEventRequest) ProtoMessage() {}
+
+func (x *RescheduleEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleEventRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RescheduleEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *RescheduleEventRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+type RescheduleEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleEventResponse) Reset() {
+	*x = RescheduleEventResponse{}
+	mi := &file_event_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleEventResponse) ProtoMessage() {}
+
+func (x *RescheduleEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleEventResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RescheduleEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type SetPurchaseLimitRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// 0 removes the limit.
+	MaxTicketsPerUser int32 `protobuf:"varint,2,opt,name=max_tickets_per_user,json=maxTicketsPerUser,proto3" json:"max_tickets_per_user,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitRequest) Reset() {
+	*x = SetPurchaseLimitRequest{}
+	mi := &file_event_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitRequest) ProtoMessage() {}
+
+func (x *SetPurchaseLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetPurchaseLimitRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetPurchaseLimitRequest) GetMaxTicketsPerUser() int32 {
+	if x != nil {
+		return x.MaxTicketsPerUser
+	}
+	return 0
+}
+
+type SetPurchaseLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitResponse) Reset() {
+	*x = SetPurchaseLimitResponse{}
+	mi := &file_event_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitResponse) ProtoMessage() {}
+
+func (x *SetPurchaseLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitResponse.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetPurchaseLimitResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type SetQueueModeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// 0 turns the waiting room off.
+	QueueAdmissionsPerMinute int32 `protobuf:"varint,2,opt,name=queue_admissions_per_minute,json=queueAdmissionsPerMinute,proto3" json:"queue_admissions_per_minute,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SetQueueModeRequest) Reset() {
+	*x = SetQueueModeRequest{}
+	mi := &file_event_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQueueModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQueueModeRequest) ProtoMessage() {}
+
+func (x *SetQueueModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQueueModeRequest.ProtoReflect.Descriptor instead.
+func (*SetQueueModeRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetQueueModeRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetQueueModeRequest) GetQueueAdmissionsPerMinute() int32 {
+	if x != nil {
+		return x.QueueAdmissionsPerMinute
+	}
+	return 0
+}
+
+type SetQueueModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQueueModeResponse) Reset() {
+	*x = SetQueueModeResponse{}
+	mi := &file_event_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQueueModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQueueModeResponse) ProtoMessage() {}
+
+func (x *SetQueueModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQueueModeResponse.ProtoReflect.Descriptor instead.
+func (*SetQueueModeResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetQueueModeResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// Changes the total seats of a general admission event. They cannot drop
+// below the seats sold or the capacity of its ticket types, nor exceed the
+// venue's capacity.
+type SetEventCapacityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TotalSeats    int32                  `protobuf:"varint,2,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventCapacityRequest) Reset() {
+	*x = SetEventCapacityRequest{}
+	mi := &file_event_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventCapacityRequest) ProtoMessage() {}
+
+func (x *SetEventCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventCapacityRequest.ProtoReflect.Descriptor instead.
+func (*SetEventCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetEventCapacityRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *SetEventCapacityRequest) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+type SetEventCapacityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventCapacityResponse) Reset() {
+	*x = SetEventCapacityResponse{}
+	mi := &file_event_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventCapacityResponse) ProtoMessage() {}
+
+func (x *SetEventCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventCapacityResponse.ProtoReflect.Descriptor instead.
+func (*SetEventCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetEventCapacityResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// Moves an event between draft, published, sales_closed and completed.
+// Cancelling goes through CancelEvent.
+type UpdateEventStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Status        EventStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=event.EventStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventStatusRequest) Reset() {
+	*x = UpdateEventStatusRequest{}
+	mi := &file_event_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventStatusRequest) ProtoMessage() {}
+
+func (x *UpdateEventStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEventStatusRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateEventStatusRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *UpdateEventStatusRequest) GetStatus() EventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EventStatus_EVENT_STATUS_UNSPECIFIED
+}
+
+type UpdateEventStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEventStatusResponse) Reset() {
+	*x = UpdateEventStatusResponse{}
+	mi := &file_event_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEventStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEventStatusResponse) ProtoMessage() {}
+
+func (x *UpdateEventStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEventStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEventStatusResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateEventStatusResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type CancelEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventRequest) Reset() {
+	*x = CancelEventRequest{}
+	mi := &file_event_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventRequest) ProtoMessage() {}
+
+func (x *CancelEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CancelEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type CancelEventResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Event *Event                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// Bookings cancelled in booking-service by this call.
+	CancelledBookings int32 `protobuf:"varint,2,opt,name=cancelled_bookings,json=cancelledBookings,proto3" json:"cancelled_bookings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelEventResponse) Reset() {
+	*x = CancelEventResponse{}
+	mi := &file_event_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventResponse) ProtoMessage() {}
+
+func (x *CancelEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventResponse.ProtoReflect.Descriptor instead.
+func (*CancelEventResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CancelEventResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CancelEventResponse) GetCancelledBookings() int32 {
+	if x != nil {
+		return x.CancelledBookings
+	}
+	return 0
+}
+
+// A place events are held at. Events at a venue take its name and time zone
+// and cannot have more seats than its capacity.
+type Venue struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Unset when the coordinates are unknown.
+	Location *GeoPoint `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// IANA time zone, such as "Europe/Berlin".
+	TimeZone string `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Capacity int32  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Subject of the organizer who created the venue.
+	OrganizerId   string                 `protobuf:"bytes,7,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Venue) Reset() {
+	*x = Venue{}
+	mi := &file_event_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Venue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Venue) ProtoMessage() {}
+
+func (x *Venue) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Venue.ProtoReflect.Descriptor instead.
+func (*Venue) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Venue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Venue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Venue) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Venue) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Venue) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Venue) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Venue) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *Venue) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Address struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Street string                 `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"`
+	// Required.
+	City       string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Region     string `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	PostalCode string `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// Required; ISO 3166-1 alpha-2, such as "DE".
+	CountryCode   string `protobuf:"bytes,5,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_event_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+// WGS 84 degrees.
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_event_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type CreateVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       *Address               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Location      *GeoPoint              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Capacity      int32                  `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVenueRequest) Reset() {
+	*x = CreateVenueRequest{}
+	mi := &file_event_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVenueRequest) ProtoMessage() {}
+
+func (x *CreateVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVenueRequest.ProtoReflect.Descriptor instead.
+func (*CreateVenueRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateVenueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *CreateVenueRequest) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateVenueRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type CreateVenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venue         *Venue                 `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVenueResponse) Reset() {
+	*x = CreateVenueResponse{}
+	mi := &file_event_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVenueResponse) ProtoMessage() {}
+
+func (x *CreateVenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVenueResponse.ProtoReflect.Descriptor instead.
+func (*CreateVenueResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateVenueResponse) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+type GetVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenueRequest) Reset() {
+	*x = GetVenueRequest{}
+	mi := &file_event_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenueRequest) ProtoMessage() {}
+
+func (x *GetVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenueRequest.ProtoReflect.Descriptor instead.
+func (*GetVenueRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetVenueRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type GetVenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venue         *Venue                 `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenueResponse) Reset() {
+	*x = GetVenueResponse{}
+	mi := &file_event_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenueResponse) ProtoMessage() {}
+
+func (x *GetVenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenueResponse.ProtoReflect.Descriptor instead.
+func (*GetVenueResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetVenueResponse) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+// Lists venues oldest first, a page at a time, like ListEvents.
+type ListVenuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 20 when unset, at most 100.
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Only venues in this city, ignoring case, and country.
+	City          string `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	CountryCode   string `protobuf:"bytes,4,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVenuesRequest) Reset() {
+	*x = ListVenuesRequest{}
+	mi := &file_event_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesRequest) ProtoMessage() {}
+
+func (x *ListVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesRequest.ProtoReflect.Descriptor instead.
+func (*ListVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListVenuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListVenuesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListVenuesRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ListVenuesRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+type ListVenuesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Venues []*Venue               `protobuf:"bytes,1,rep,name=venues,proto3" json:"venues,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVenuesResponse) Reset() {
+	*x = ListVenuesResponse{}
+	mi := &file_event_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVenuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVenuesResponse) ProtoMessage() {}
+
+func (x *ListVenuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVenuesResponse.ProtoReflect.Descriptor instead.
+func (*ListVenuesResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListVenuesResponse) GetVenues() []*Venue {
+	if x != nil {
+		return x.Venues
+	}
+	return nil
+}
+
+func (x *ListVenuesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Replaces the venue's name, address, location, time zone and capacity. The
+// capacity cannot drop below the seats of an event at the venue that is not
+// cancelled or completed.
+type UpdateVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       *Address               `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Location      *GeoPoint              `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVenueRequest) Reset() {
+	*x = UpdateVenueRequest{}
+	mi := &file_event_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVenueRequest) ProtoMessage() {}
+
+func (x *UpdateVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVenueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVenueRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateVenueRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateVenueRequest) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateVenueRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type UpdateVenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venue         *Venue                 `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVenueResponse) Reset() {
+	*x = UpdateVenueResponse{}
+	mi := &file_event_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVenueResponse) ProtoMessage() {}
+
+func (x *UpdateVenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVenueResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVenueResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateVenueResponse) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+// Fails while events are held at the venue.
+type DeleteVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VenueId       string                 `protobuf:"bytes,1,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVenueRequest) Reset() {
+	*x = DeleteVenueRequest{}
+	mi := &file_event_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVenueRequest) ProtoMessage() {}
+
+func (x *DeleteVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVenueRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVenueRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteVenueRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+type DeleteVenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVenueResponse) Reset() {
+	*x = DeleteVenueResponse{}
+	mi := &file_event_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVenueResponse) ProtoMessage() {}
+
+func (x *DeleteVenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVenueResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVenueResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{65}
+}
+
+// The profile of an account that runs events. Its ID is the subject of that
+// account, which events record as their organizer_id.
+type Organizer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organizer) Reset() {
+	*x = Organizer{}
+	mi := &file_event_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organizer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organizer) ProtoMessage() {}
+
+func (x *Organizer) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organizer.ProtoReflect.Descriptor instead.
+func (*Organizer) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Organizer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organizer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organizer) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Organizer) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Organizer) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+func (x *Organizer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateOrganizerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The caller's own subject when unset; only admins may give another.
+	OrganizerId string `protobuf:"bytes,1,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email       string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone       string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	// An http or https URL.
+	Website       string `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizerRequest) Reset() {
+	*x = CreateOrganizerRequest{}
+	mi := &file_event_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizerRequest) ProtoMessage() {}
+
+func (x *CreateOrganizerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizerRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizerRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateOrganizerRequest) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *CreateOrganizerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateOrganizerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateOrganizerRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type CreateOrganizerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizer     *Organizer             `protobuf:"bytes,1,opt,name=organizer,proto3" json:"organizer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizerResponse) Reset() {
+	*x = CreateOrganizerResponse{}
+	mi := &file_event_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizerResponse) ProtoMessage() {}
+
+func (x *CreateOrganizerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizerResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizerResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateOrganizerResponse) GetOrganizer() *Organizer {
+	if x != nil {
+		return x.Organizer
+	}
+	return nil
+}
+
+type GetOrganizerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrganizerId   string                 `protobuf:"bytes,1,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizerRequest) Reset() {
+	*x = GetOrganizerRequest{}
+	mi := &file_event_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizerRequest) ProtoMessage() {}
+
+func (x *GetOrganizerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizerRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizerRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetOrganizerRequest) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+type GetOrganizerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizer     *Organizer             `protobuf:"bytes,1,opt,name=organizer,proto3" json:"organizer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrganizerResponse) Reset() {
+	*x = GetOrganizerResponse{}
+	mi := &file_event_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizerResponse) ProtoMessage() {}
+
+func (x *GetOrganizerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizerResponse.ProtoReflect.Descriptor instead.
+func (*GetOrganizerResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetOrganizerResponse) GetOrganizer() *Organizer {
+	if x != nil {
+		return x.Organizer
+	}
+	return nil
+}
+
+// Lists organizers oldest first, a page at a time, like ListEvents.
+type ListOrganizersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 20 when unset, at most 100.
+	PageSize      int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizersRequest) Reset() {
+	*x = ListOrganizersRequest{}
+	mi := &file_event_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizersRequest) ProtoMessage() {}
+
+func (x *ListOrganizersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizersRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListOrganizersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListOrganizersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListOrganizersResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Organizers []*Organizer           `protobuf:"bytes,1,rep,name=organizers,proto3" json:"organizers,omitempty"`
+	// Empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizersResponse) Reset() {
+	*x = ListOrganizersResponse{}
+	mi := &file_event_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizersResponse) ProtoMessage() {}
+
+func (x *ListOrganizersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizersResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListOrganizersResponse) GetOrganizers() []*Organizer {
+	if x != nil {
+		return x.Organizers
+	}
+	return nil
+}
+
+func (x *ListOrganizersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Replaces the organizer's name and contact details.
+type UpdateOrganizerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrganizerId   string                 `protobuf:"bytes,1,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Website       string                 `protobuf:"bytes,5,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizerRequest) Reset() {
+	*x = UpdateOrganizerRequest{}
+	mi := &file_event_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizerRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizerRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateOrganizerRequest) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOrganizerRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateOrganizerRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateOrganizerRequest) GetWebsite() string {
+	if x != nil {
+		return x.Website
+	}
+	return ""
+}
+
+type UpdateOrganizerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizer     *Organizer             `protobuf:"bytes,1,opt,name=organizer,proto3" json:"organizer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizerResponse) Reset() {
+	*x = UpdateOrganizerResponse{}
+	mi := &file_event_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizerResponse) ProtoMessage() {}
+
+func (x *UpdateOrganizerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizerResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateOrganizerResponse) GetOrganizer() *Organizer {
+	if x != nil {
+		return x.Organizer
+	}
+	return nil
+}
+
+// Fails while the organizer has events.
+type DeleteOrganizerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrganizerId   string                 `protobuf:"bytes,1,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizerRequest) Reset() {
+	*x = DeleteOrganizerRequest{}
+	mi := &file_event_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizerRequest) ProtoMessage() {}
+
+func (x *DeleteOrganizerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizerRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteOrganizerRequest) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+type DeleteOrganizerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrganizerResponse) Reset() {
+	*x = DeleteOrganizerResponse{}
+	mi := &file_event_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrganizerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrganizerResponse) ProtoMessage() {}
+
+func (x *DeleteOrganizerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrganizerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrganizerResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{76}
+}
+
+// A show that recurs. Its occurrences are ordinary events, created for the
+// starts of its rule up to a horizon ahead, which then moves forward with
+// time. Each can be rescheduled, updated or cancelled on its own.
+type EventSeries struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Venue       string                 `protobuf:"bytes,4,opt,name=venue,proto3" json:"venue,omitempty"`
+	Category    string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Tags        []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	// RFC 5545 recurrence rule without DTSTART, such as
+	// "FREQ=WEEKLY;BYDAY=FR,SA;COUNT=20". It recurs daily at most; BYHOUR
+	// gives several shows a day.
+	Rrule string `protobuf:"bytes,7,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	// The first start, which the rule recurs from in time_zone; occurrences
+	// keep its local hour across daylight saving changes.
+	FirstStart *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_start,json=firstStart,proto3" json:"first_start,omitempty"`
+	// The venue's; empty without a venue, when the rule runs in UTC.
+	TimeZone    string `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	TotalSeats  int32  `protobuf:"varint,10,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	LayoutId    string `protobuf:"bytes,11,opt,name=layout_id,json=layoutId,proto3" json:"layout_id,omitempty"`
+	VenueId     string `protobuf:"bytes,12,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	OrganizerId string `protobuf:"bytes,13,opt,name=organizer_id,json=organizerId,proto3" json:"organizer_id,omitempty"`
+	// Occurrences go on sale as they are created instead of being drafts.
+	Publish bool `protobuf:"varint,14,opt,name=publish,proto3" json:"publish,omitempty"`
+	// Set once the series was cancelled; nothing starts at or after it.
+	Until *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=until,proto3" json:"until,omitempty"`
+	// Occurrences starting before this have been created.
+	GeneratedUntil *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=generated_until,json=generatedUntil,proto3" json:"generated_until,omitempty"`
+	// Set once the rule has no occurrences left to create.
+	Finished      bool                   `protobuf:"varint,17,opt,name=finished,proto3" json:"finished,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventSeries) Reset() {
+	*x = EventSeries{}
+	mi := &file_event_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSeries) ProtoMessage() {}
+
+func (x *EventSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventSeries.ProtoReflect.Descriptor instead.
+func (*EventSeries) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *EventSeries) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventSeries) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EventSeries) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EventSeries) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *EventSeries) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EventSeries) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EventSeries) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *EventSeries) GetFirstStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstStart
+	}
+	return nil
+}
+
+func (x *EventSeries) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *EventSeries) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *EventSeries) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+func (x *EventSeries) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *EventSeries) GetOrganizerId() string {
+	if x != nil {
+		return x.OrganizerId
+	}
+	return ""
+}
+
+func (x *EventSeries) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+func (x *EventSeries) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *EventSeries) GetGeneratedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedUntil
+	}
+	return nil
+}
+
+func (x *EventSeries) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *EventSeries) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Takes the same details as CreateEventRequest, which every occurrence gets.
+type CreateEventSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rrule         string                 `protobuf:"bytes,2,opt,name=rrule,proto3" json:"rrule,omitempty"`
+	FirstStart    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_start,json=firstStart,proto3" json:"first_start,omitempty"`
+	TotalSeats    int32                  `protobuf:"varint,4,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	LayoutId      string                 `protobuf:"bytes,5,opt,name=layout_id,json=layoutId,proto3" json:"layout_id,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Venue         string                 `protobuf:"bytes,7,opt,name=venue,proto3" json:"venue,omitempty"`
+	Category      string                 `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Tags          []string               `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty"`
+	VenueId       string                 `protobuf:"bytes,10,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	Publish       bool                   `protobuf:"varint,11,opt,name=publish,proto3" json:"publish,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventSeriesRequest) Reset() {
+	*x = CreateEventSeriesRequest{}
+	mi := &file_event_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventSeriesRequest) ProtoMessage() {}
+
+func (x *CreateEventSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateEventSeriesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventSeriesRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *CreateEventSeriesRequest) GetFirstStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstStart
+	}
+	return nil
+}
+
+func (x *CreateEventSeriesRequest) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *CreateEventSeriesRequest) GetLayoutId() string {
+	if x != nil {
+		return x.LayoutId
+	}
+	return ""
+}
+
+func (x *CreateEventSeriesRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateEventSeriesRequest) GetVenue() string {
+	if x != nil {
+		return x.Venue
+	}
+	return ""
+}
+
+func (x *CreateEventSeriesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreateEventSeriesRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CreateEventSeriesRequest) GetVenueId() string {
+	if x != nil {
+		return x.VenueId
+	}
+	return ""
+}
+
+func (x *CreateEventSeriesRequest) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+type CreateEventSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *EventSeries           `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventSeriesResponse) Reset() {
+	*x = CreateEventSeriesResponse{}
+	mi := &file_event_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventSeriesResponse) ProtoMessage() {}
+
+func (x *CreateEventSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateEventSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateEventSeriesResponse) GetSeries() *EventSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type GetEventSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventSeriesRequest) Reset() {
+	*x = GetEventSeriesRequest{}
+	mi := &file_event_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventSeriesRequest) ProtoMessage() {}
+
+func (x *GetEventSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetEventSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetEventSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+type GetEventSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *EventSeries           `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventSeriesResponse) Reset() {
+	*x = GetEventSeriesResponse{}
+	mi := &file_event_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventSeriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTicketsResponse) ProtoMessage() {}
+func (*GetEventSeriesResponse) ProtoMessage() {}
 
-func (x *UpdateTicketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_event_proto_msgTypes[8]
+func (x *GetEventSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -488,30 +5397,369 @@ func (x *UpdateTicketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTicketsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTicketsResponse) Descriptor() ([]byte, []int) {
-	return file_event_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetEventSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetEventSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *UpdateTicketsResponse) GetAvailableSeats() int32 {
+func (x *GetEventSeriesResponse) GetSeries() *EventSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// Changes the total seats of a general admission series: of the occurrences
+// it creates from now on, and of its upcoming ones but those set on their
+// own with SetEventCapacity. If any of them cannot take the new total, none
+// changes.
+type SetEventSeriesCapacityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId      string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	TotalSeats    int32                  `protobuf:"varint,2,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventSeriesCapacityRequest) Reset() {
+	*x = SetEventSeriesCapacityRequest{}
+	mi := &file_event_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventSeriesCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventSeriesCapacityRequest) ProtoMessage() {}
+
+func (x *SetEventSeriesCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventSeriesCapacityRequest.ProtoReflect.Descriptor instead.
+func (*SetEventSeriesCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SetEventSeriesCapacityRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *SetEventSeriesCapacityRequest) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+type SetEventSeriesCapacityResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Series *EventSeries           `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	// Occurrences whose total seats changed.
+	UpdatedEvents int32 `protobuf:"varint,2,opt,name=updated_events,json=updatedEvents,proto3" json:"updated_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEventSeriesCapacityResponse) Reset() {
+	*x = SetEventSeriesCapacityResponse{}
+	mi := &file_event_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEventSeriesCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEventSeriesCapacityResponse) ProtoMessage() {}
+
+func (x *SetEventSeriesCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEventSeriesCapacityResponse.ProtoReflect.Descriptor instead.
+func (*SetEventSeriesCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetEventSeriesCapacityResponse) GetSeries() *EventSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *SetEventSeriesCapacityResponse) GetUpdatedEvents() int32 {
+	if x != nil {
+		return x.UpdatedEvents
+	}
+	return 0
+}
+
+// Ends the series and cancels its occurrences starting from then on, with
+// their bookings. Safe to retry, like CancelEvent.
+type CancelEventSeriesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeriesId string                 `protobuf:"bytes,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Now when unset.
+	From          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventSeriesRequest) Reset() {
+	*x = CancelEventSeriesRequest{}
+	mi := &file_event_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventSeriesRequest) ProtoMessage() {}
+
+func (x *CancelEventSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventSeriesRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CancelEventSeriesRequest) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *CancelEventSeriesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+type CancelEventSeriesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Series *EventSeries           `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	// Occurrences and bookings cancelled by this call.
+	CancelledEvents   int32 `protobuf:"varint,2,opt,name=cancelled_events,json=cancelledEvents,proto3" json:"cancelled_events,omitempty"`
+	CancelledBookings int32 `protobuf:"varint,3,opt,name=cancelled_bookings,json=cancelledBookings,proto3" json:"cancelled_bookings,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CancelEventSeriesResponse) Reset() {
+	*x = CancelEventSeriesResponse{}
+	mi := &file_event_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventSeriesResponse) ProtoMessage() {}
+
+func (x *CancelEventSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventSeriesResponse.ProtoReflect.Descriptor instead.
+func (*CancelEventSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CancelEventSeriesResponse) GetSeries() *EventSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *CancelEventSeriesResponse) GetCancelledEvents() int32 {
+	if x != nil {
+		return x.CancelledEvents
+	}
+	return 0
+}
+
+func (x *CancelEventSeriesResponse) GetCancelledBookings() int32 {
+	if x != nil {
+		return x.CancelledBookings
+	}
+	return 0
+}
+
+type WatchEventAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventAvailabilityRequest) Reset() {
+	*x = WatchEventAvailabilityRequest{}
+	mi := &file_event_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventAvailabilityRequest) ProtoMessage() {}
+
+func (x *WatchEventAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *WatchEventAvailabilityRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type EventAvailability struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	EventId        string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	AvailableSeats int32                  `protobuf:"varint,2,opt,name=available_seats,json=availableSeats,proto3" json:"available_seats,omitempty"`
+	TotalSeats     int32                  `protobuf:"varint,3,opt,name=total_seats,json=totalSeats,proto3" json:"total_seats,omitempty"`
+	// Set on messages repeating the last count because nothing changed.
+	Heartbeat     bool                   `protobuf:"varint,4,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventAvailability) Reset() {
+	*x = EventAvailability{}
+	mi := &file_event_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAvailability) ProtoMessage() {}
+
+func (x *EventAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_event_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventAvailability.ProtoReflect.Descriptor instead.
+func (*EventAvailability) Descriptor() ([]byte, []int) {
+	return file_event_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *EventAvailability) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventAvailability) GetAvailableSeats() int32 {
 	if x != nil {
 		return x.AvailableSeats
 	}
 	return 0
 }
 
-func (x *UpdateTicketsResponse) GetSuccess() bool {
+func (x *EventAvailability) GetTotalSeats() int32 {
 	if x != nil {
-		return x.Success
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *EventAvailability) GetHeartbeat() bool {
+	if x != nil {
+		return x.Heartbeat
 	}
 	return false
 }
 
+func (x *EventAvailability) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
 var File_event_proto protoreflect.FileDescriptor
 
 const file_event_proto_rawDesc = "" +
 	"\n" +
-	"\vevent.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xeb\x01\n" +
+	"\vevent.proto\x12\x05event\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd9\x05\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
@@ -521,38 +5769,477 @@ const file_event_proto_rawDesc = "" +
 	"totalSeats\x12'\n" +
 	"\x0favailable_seats\x18\x05 \x01(\x05R\x0eavailableSeats\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x84\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tlayout_id\x18\a \x01(\tR\blayoutId\x12*\n" +
+	"\x06status\x18\b \x01(\x0e2\x12.event.EventStatusR\x06status\x12!\n" +
+	"\forganizer_id\x18\t \x01(\tR\vorganizerId\x12/\n" +
+	"\x14max_tickets_per_user\x18\n" +
+	" \x01(\x05R\x11maxTicketsPerUser\x12=\n" +
+	"\x1bqueue_admissions_per_minute\x18\v \x01(\x05R\x18queueAdmissionsPerMinute\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12\x14\n" +
+	"\x05venue\x18\r \x01(\tR\x05venue\x12\x1a\n" +
+	"\bcategory\x18\x0e \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x12\x19\n" +
+	"\bvenue_id\x18\x10 \x01(\tR\avenueId\x12\x1b\n" +
+	"\ttime_zone\x18\x11 \x01(\tR\btimeZone\x12(\n" +
+	"\x10local_start_time\x18\x12 \x01(\tR\x0elocalStartTime\x12\x1b\n" +
+	"\tseries_id\x18\x13 \x01(\tR\bseriesId\x12)\n" +
+	"\x10seats_overridden\x18\x14 \x01(\bR\x0fseatsOverridden\"\xa4\x02\n" +
 	"\x12CreateEventRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1f\n" +
 	"\vtotal_seats\x18\x03 \x01(\x05R\n" +
-	"totalSeats\"0\n" +
+	"totalSeats\x12\x1b\n" +
+	"\tlayout_id\x18\x04 \x01(\tR\blayoutId\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05venue\x18\x06 \x01(\tR\x05venue\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12\x19\n" +
+	"\bvenue_id\x18\t \x01(\tR\avenueId\"0\n" +
 	"\x13CreateEventResponse\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\",\n" +
 	"\x0fGetEventRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\"6\n" +
 	"\x10GetEventResponse\x12\"\n" +
-	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"A\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"\xb5\x03\n" +
 	"\x11ListEventsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"[\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12;\n" +
+	"\vstarts_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"startsFrom\x12?\n" +
+	"\rstarts_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fstartsBefore\x12*\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x12.event.EventStatusR\x06status\x12\x12\n" +
+	"\x04name\x18\a \x01(\tR\x04name\x12)\n" +
+	"\x10has_availability\x18\b \x01(\bR\x0fhasAvailability\x12\x19\n" +
+	"\border_by\x18\t \x01(\tR\aorderBy\x12!\n" +
+	"\forganizer_id\x18\n" +
+	" \x01(\tR\vorganizerId\x12\x19\n" +
+	"\bvenue_id\x18\v \x01(\tR\avenueId\x12\x1b\n" +
+	"\tseries_id\x18\f \x01(\tR\bseriesIdJ\x04\b\x02\x10\x03R\x06offset\"u\n" +
 	"\x12ListEventsResponse\x12$\n" +
-	"\x06events\x18\x01 \x03(\v2\f.event.EventR\x06events\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"M\n" +
+	"\x06events\x18\x01 \x03(\v2\f.event.EventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageTokenJ\x04\b\x02\x10\x03R\vtotal_count\"\xe6\x02\n" +
+	"\x13SearchEventsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05venue\x18\x03 \x01(\tR\x05venue\x12;\n" +
+	"\vstarts_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"startsFrom\x12?\n" +
+	"\rstarts_before\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fstartsBefore\x12*\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x12.event.EventStatusR\x06status\x12!\n" +
+	"\forganizer_id\x18\t \x01(\tR\vorganizerId\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\b \x01(\tR\tpageToken\"\x9a\x01\n" +
+	"\x14SearchEventsResponse\x12-\n" +
+	"\aresults\x18\x01 \x03(\v2\x13.event.SearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12+\n" +
+	"\x06facets\x18\x03 \x01(\v2\x13.event.SearchFacetsR\x06facets\"\xa2\x01\n" +
+	"\fSearchResult\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12%\n" +
+	"\x0ename_highlight\x18\x03 \x01(\tR\rnameHighlight\x123\n" +
+	"\x15description_highlight\x18\x04 \x01(\tR\x14descriptionHighlight\"\x97\x01\n" +
+	"\fSearchFacets\x121\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x11.event.FacetCountR\n" +
+	"categories\x12)\n" +
+	"\x06venues\x18\x02 \x03(\v2\x11.event.FacetCountR\x06venues\x12)\n" +
+	"\x06months\x18\x03 \x03(\v2\x11.event.FacetCountR\x06months\"8\n" +
+	"\n" +
+	"FacetCount\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"M\n" +
 	"\x14UpdateTicketsRequest\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\"Z\n" +
 	"\x15UpdateTicketsResponse\x12'\n" +
 	"\x0favailable_seats\x18\x01 \x01(\x05R\x0eavailableSeats\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess2\x98\x03\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\"\xc7\x02\n" +
+	"\vReservation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x120\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x18.event.ReservationStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vreleased_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\x12\x19\n" +
+	"\bseat_ids\x18\a \x03(\tR\aseatIds\x12,\n" +
+	"\x05items\x18\b \x03(\v2\x16.event.ReservationItemR\x05items\"\x99\x01\n" +
+	"\x0fReservationItem\x12$\n" +
+	"\x0eticket_type_id\x18\x01 \x01(\tR\fticketTypeId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12(\n" +
+	"\x10unit_price_minor\x18\x03 \x01(\x03R\x0eunitPriceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xbc\x01\n" +
+	"\x13ReserveSeatsRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\bseat_ids\x18\x04 \x03(\tR\aseatIds\x12,\n" +
+	"\x05items\x18\x05 \x03(\v2\x16.event.ReservationItemR\x05items\"u\n" +
+	"\x14ReserveSeatsResponse\x124\n" +
+	"\vreservation\x18\x01 \x01(\v2\x12.event.ReservationR\vreservation\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\"g\n" +
+	"\x13ReleaseSeatsRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12)\n" +
+	"\x06legacy\x18\x02 \x01(\v2\x11.event.LegacyHoldR\x06legacy\"C\n" +
+	"\n" +
+	"LegacyHold\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"u\n" +
+	"\x14ReleaseSeatsResponse\x124\n" +
+	"\vreservation\x18\x01 \x01(\v2\x12.event.ReservationR\vreservation\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\"]\n" +
+	"\x18ResizeReservationRequest\x12%\n" +
+	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"z\n" +
+	"\x19ResizeReservationResponse\x124\n" +
+	"\vreservation\x18\x01 \x01(\v2\x12.event.ReservationR\vreservation\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\"`\n" +
+	"\n" +
+	"LayoutSeat\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asection\x18\x02 \x01(\tR\asection\x12\x10\n" +
+	"\x03row\x18\x03 \x01(\tR\x03row\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\"\x95\x01\n" +
+	"\vVenueLayout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12'\n" +
+	"\x05seats\x18\x03 \x03(\v2\x11.event.LayoutSeatR\x05seats\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"W\n" +
+	"\x18CreateVenueLayoutRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12'\n" +
+	"\x05seats\x18\x02 \x03(\v2\x11.event.LayoutSeatR\x05seats\"G\n" +
+	"\x19CreateVenueLayoutResponse\x12*\n" +
+	"\x06layout\x18\x01 \x01(\v2\x12.event.VenueLayoutR\x06layout\"4\n" +
+	"\x15GetVenueLayoutRequest\x12\x1b\n" +
+	"\tlayout_id\x18\x01 \x01(\tR\blayoutId\"D\n" +
+	"\x16GetVenueLayoutResponse\x12*\n" +
+	"\x06layout\x18\x01 \x01(\v2\x12.event.VenueLayoutR\x06layout\"\x85\x01\n" +
+	"\x04Seat\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\asection\x18\x02 \x01(\tR\asection\x12\x10\n" +
+	"\x03row\x18\x03 \x01(\tR\x03row\x12\x16\n" +
+	"\x06number\x18\x04 \x01(\x05R\x06number\x12)\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x11.event.SeatStatusR\x06status\".\n" +
+	"\x11GetSeatMapRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\x98\x01\n" +
+	"\x12GetSeatMapResponse\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1b\n" +
+	"\tlayout_id\x18\x02 \x01(\tR\blayoutId\x12!\n" +
+	"\x05seats\x18\x03 \x03(\v2\v.event.SeatR\x05seats\x12'\n" +
+	"\x0favailable_seats\x18\x04 \x01(\x05R\x0eavailableSeats\"\xf3\x02\n" +
+	"\n" +
+	"TicketType\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcapacity\x18\x04 \x01(\x05R\bcapacity\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\x05R\tavailable\x12\x1f\n" +
+	"\vprice_minor\x18\x06 \x01(\x03R\n" +
+	"priceMinor\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12;\n" +
+	"\vsales_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"salesStart\x127\n" +
+	"\tsales_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bsalesEnd\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x97\x02\n" +
+	"\x17CreateTicketTypeRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcapacity\x18\x03 \x01(\x05R\bcapacity\x12\x1f\n" +
+	"\vprice_minor\x18\x04 \x01(\x03R\n" +
+	"priceMinor\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12;\n" +
+	"\vsales_start\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"salesStart\x127\n" +
+	"\tsales_end\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bsalesEnd\"N\n" +
+	"\x18CreateTicketTypeResponse\x122\n" +
+	"\vticket_type\x18\x01 \x01(\v2\x11.event.TicketTypeR\n" +
+	"ticketType\"3\n" +
+	"\x16ListTicketTypesRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"O\n" +
+	"\x17ListTicketTypesResponse\x124\n" +
+	"\fticket_types\x18\x01 \x03(\v2\x11.event.TicketTypeR\vticketTypes\"\xea\x01\n" +
+	"\x17UpdateTicketTypeRequest\x12$\n" +
+	"\x0eticket_type_id\x18\x01 \x01(\tR\fticketTypeId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vprice_minor\x18\x03 \x01(\x03R\n" +
+	"priceMinor\x12;\n" +
+	"\vsales_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"salesStart\x127\n" +
+	"\tsales_end\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bsalesEnd\"N\n" +
+	"\x18UpdateTicketTypeResponse\x122\n" +
+	"\vticket_type\x18\x01 \x01(\v2\x11.event.TicketTypeR\n" +
+	"ticketType\"\x83\x02\n" +
+	"\x12UpdateEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05venue\x18\x04 \x01(\tR\x05venue\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x19\n" +
+	"\bvenue_id\x18\b \x01(\tR\avenueId\"9\n" +
+	"\x13UpdateEventResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"n\n" +
+	"\x16RescheduleEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\"=\n" +
+	"\x17RescheduleEventResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"e\n" +
+	"\x17SetPurchaseLimitRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12/\n" +
+	"\x14max_tickets_per_user\x18\x02 \x01(\x05R\x11maxTicketsPerUser\">\n" +
+	"\x18SetPurchaseLimitResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"o\n" +
+	"\x13SetQueueModeRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12=\n" +
+	"\x1bqueue_admissions_per_minute\x18\x02 \x01(\x05R\x18queueAdmissionsPerMinute\":\n" +
+	"\x14SetQueueModeResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"U\n" +
+	"\x17SetEventCapacityRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1f\n" +
+	"\vtotal_seats\x18\x02 \x01(\x05R\n" +
+	"totalSeats\">\n" +
+	"\x18SetEventCapacityResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"a\n" +
+	"\x18UpdateEventStatusRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.event.EventStatusR\x06status\"?\n" +
+	"\x19UpdateEventStatusResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\"/\n" +
+	"\x12CancelEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"h\n" +
+	"\x13CancelEventResponse\x12\"\n" +
+	"\x05event\x18\x01 \x01(\v2\f.event.EventR\x05event\x12-\n" +
+	"\x12cancelled_bookings\x18\x02 \x01(\x05R\x11cancelledBookings\"\x99\x02\n" +
+	"\x05Venue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\aaddress\x18\x03 \x01(\v2\x0e.event.AddressR\aaddress\x12+\n" +
+	"\blocation\x18\x04 \x01(\v2\x0f.event.GeoPointR\blocation\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12!\n" +
+	"\forganizer_id\x18\a \x01(\tR\vorganizerId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x91\x01\n" +
+	"\aAddress\x12\x16\n" +
+	"\x06street\x18\x01 \x01(\tR\x06street\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12!\n" +
+	"\fcountry_code\x18\x05 \x01(\tR\vcountryCode\"D\n" +
+	"\bGeoPoint\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xb8\x01\n" +
+	"\x12CreateVenueRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12(\n" +
+	"\aaddress\x18\x02 \x01(\v2\x0e.event.AddressR\aaddress\x12+\n" +
+	"\blocation\x18\x03 \x01(\v2\x0f.event.GeoPointR\blocation\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x05R\bcapacity\"9\n" +
+	"\x13CreateVenueResponse\x12\"\n" +
+	"\x05venue\x18\x01 \x01(\v2\f.event.VenueR\x05venue\",\n" +
+	"\x0fGetVenueRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\"6\n" +
+	"\x10GetVenueResponse\x12\"\n" +
+	"\x05venue\x18\x01 \x01(\v2\f.event.VenueR\x05venue\"\x86\x01\n" +
+	"\x11ListVenuesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12!\n" +
+	"\fcountry_code\x18\x04 \x01(\tR\vcountryCode\"b\n" +
+	"\x12ListVenuesResponse\x12$\n" +
+	"\x06venues\x18\x01 \x03(\v2\f.event.VenueR\x06venues\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xd3\x01\n" +
+	"\x12UpdateVenueRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12(\n" +
+	"\aaddress\x18\x03 \x01(\v2\x0e.event.AddressR\aaddress\x12+\n" +
+	"\blocation\x18\x04 \x01(\v2\x0f.event.GeoPointR\blocation\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\"9\n" +
+	"\x13UpdateVenueResponse\x12\"\n" +
+	"\x05venue\x18\x01 \x01(\v2\f.event.VenueR\x05venue\"/\n" +
+	"\x12DeleteVenueRequest\x12\x19\n" +
+	"\bvenue_id\x18\x01 \x01(\tR\avenueId\"\x15\n" +
+	"\x13DeleteVenueResponse\"\xb0\x01\n" +
+	"\tOrganizer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x95\x01\n" +
+	"\x16CreateOrganizerRequest\x12!\n" +
+	"\forganizer_id\x18\x01 \x01(\tR\vorganizerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\"I\n" +
+	"\x17CreateOrganizerResponse\x12.\n" +
+	"\torganizer\x18\x01 \x01(\v2\x10.event.OrganizerR\torganizer\"8\n" +
+	"\x13GetOrganizerRequest\x12!\n" +
+	"\forganizer_id\x18\x01 \x01(\tR\vorganizerId\"F\n" +
+	"\x14GetOrganizerResponse\x12.\n" +
+	"\torganizer\x18\x01 \x01(\v2\x10.event.OrganizerR\torganizer\"S\n" +
+	"\x15ListOrganizersRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"r\n" +
+	"\x16ListOrganizersResponse\x120\n" +
+	"\n" +
+	"organizers\x18\x01 \x03(\v2\x10.event.OrganizerR\n" +
+	"organizers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x95\x01\n" +
+	"\x16UpdateOrganizerRequest\x12!\n" +
+	"\forganizer_id\x18\x01 \x01(\tR\vorganizerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x18\n" +
+	"\awebsite\x18\x05 \x01(\tR\awebsite\"I\n" +
+	"\x17UpdateOrganizerResponse\x12.\n" +
+	"\torganizer\x18\x01 \x01(\v2\x10.event.OrganizerR\torganizer\";\n" +
+	"\x16DeleteOrganizerRequest\x12!\n" +
+	"\forganizer_id\x18\x01 \x01(\tR\vorganizerId\"\x19\n" +
+	"\x17DeleteOrganizerResponse\"\xed\x04\n" +
+	"\vEventSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05venue\x18\x04 \x01(\tR\x05venue\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x14\n" +
+	"\x05rrule\x18\a \x01(\tR\x05rrule\x12;\n" +
+	"\vfirst_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"firstStart\x12\x1b\n" +
+	"\ttime_zone\x18\t \x01(\tR\btimeZone\x12\x1f\n" +
+	"\vtotal_seats\x18\n" +
+	" \x01(\x05R\n" +
+	"totalSeats\x12\x1b\n" +
+	"\tlayout_id\x18\v \x01(\tR\blayoutId\x12\x19\n" +
+	"\bvenue_id\x18\f \x01(\tR\avenueId\x12!\n" +
+	"\forganizer_id\x18\r \x01(\tR\vorganizerId\x12\x18\n" +
+	"\apublish\x18\x0e \x01(\bR\apublish\x120\n" +
+	"\x05until\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12C\n" +
+	"\x0fgenerated_until\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x0egeneratedUntil\x12\x1a\n" +
+	"\bfinished\x18\x11 \x01(\bR\bfinished\x129\n" +
+	"\n" +
+	"created_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xdc\x02\n" +
+	"\x18CreateEventSeriesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05rrule\x18\x02 \x01(\tR\x05rrule\x12;\n" +
+	"\vfirst_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"firstStart\x12\x1f\n" +
+	"\vtotal_seats\x18\x04 \x01(\x05R\n" +
+	"totalSeats\x12\x1b\n" +
+	"\tlayout_id\x18\x05 \x01(\tR\blayoutId\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05venue\x18\a \x01(\tR\x05venue\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x19\n" +
+	"\bvenue_id\x18\n" +
+	" \x01(\tR\avenueId\x12\x18\n" +
+	"\apublish\x18\v \x01(\bR\apublish\"G\n" +
+	"\x19CreateEventSeriesResponse\x12*\n" +
+	"\x06series\x18\x01 \x01(\v2\x12.event.EventSeriesR\x06series\"4\n" +
+	"\x15GetEventSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\"D\n" +
+	"\x16GetEventSeriesResponse\x12*\n" +
+	"\x06series\x18\x01 \x01(\v2\x12.event.EventSeriesR\x06series\"]\n" +
+	"\x1dSetEventSeriesCapacityRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12\x1f\n" +
+	"\vtotal_seats\x18\x02 \x01(\x05R\n" +
+	"totalSeats\"s\n" +
+	"\x1eSetEventSeriesCapacityResponse\x12*\n" +
+	"\x06series\x18\x01 \x01(\v2\x12.event.EventSeriesR\x06series\x12%\n" +
+	"\x0eupdated_events\x18\x02 \x01(\x05R\rupdatedEvents\"g\n" +
+	"\x18CancelEventSeriesRequest\x12\x1b\n" +
+	"\tseries_id\x18\x01 \x01(\tR\bseriesId\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\"\xa1\x01\n" +
+	"\x19CancelEventSeriesResponse\x12*\n" +
+	"\x06series\x18\x01 \x01(\v2\x12.event.EventSeriesR\x06series\x12)\n" +
+	"\x10cancelled_events\x18\x02 \x01(\x05R\x0fcancelledEvents\x12-\n" +
+	"\x12cancelled_bookings\x18\x03 \x01(\x05R\x11cancelledBookings\":\n" +
+	"\x1dWatchEventAvailabilityRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"\xcb\x01\n" +
+	"\x11EventAvailability\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12'\n" +
+	"\x0favailable_seats\x18\x02 \x01(\x05R\x0eavailableSeats\x12\x1f\n" +
+	"\vtotal_seats\x18\x03 \x01(\x05R\n" +
+	"totalSeats\x12\x1c\n" +
+	"\theartbeat\x18\x04 \x01(\bR\theartbeat\x123\n" +
+	"\asent_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt*\xb6\x01\n" +
+	"\vEventStatus\x12\x1c\n" +
+	"\x18EVENT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12EVENT_STATUS_DRAFT\x10\x01\x12\x1a\n" +
+	"\x16EVENT_STATUS_PUBLISHED\x10\x02\x12\x1d\n" +
+	"\x19EVENT_STATUS_SALES_CLOSED\x10\x03\x12\x1a\n" +
+	"\x16EVENT_STATUS_CANCELLED\x10\x04\x12\x1a\n" +
+	"\x16EVENT_STATUS_COMPLETED\x10\x05*y\n" +
+	"\x11ReservationStatus\x12\"\n" +
+	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RESERVED\x10\x01\x12\x1f\n" +
+	"\x1bRESERVATION_STATUS_RELEASED\x10\x02*^\n" +
+	"\n" +
+	"SeatStatus\x12\x1b\n" +
+	"\x17SEAT_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SEAT_STATUS_AVAILABLE\x10\x01\x12\x18\n" +
+	"\x14SEAT_STATUS_RESERVED\x10\x022\xf9\x1f\n" +
 	"\fEventService\x12Z\n" +
 	"\vCreateEvent\x12\x19.event.CreateEventRequest\x1a\x1a.event.CreateEventResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/event\x12Z\n" +
 	"\bGetEvent\x12\x16.event.GetEventRequest\x1a\x17.event.GetEventResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/events/{event_id}\x12Z\n" +
 	"\n" +
-	"ListEvents\x12\x18.event.ListEventsRequest\x1a\x19.event.ListEventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/list/events\x12t\n" +
-	"\x16UpdateAvailableTickets\x12\x1b.event.UpdateTicketsRequest\x1a\x1c.event.UpdateTicketsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/event/{event_id}B\tZ\a./protob\x06proto3"
+	"ListEvents\x12\x18.event.ListEventsRequest\x1a\x19.event.ListEventsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/list/events\x12b\n" +
+	"\fSearchEvents\x12\x1a.event.SearchEventsRequest\x1a\x1b.event.SearchEventsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/search/events\x12t\n" +
+	"\x16UpdateAvailableTickets\x12\x1b.event.UpdateTicketsRequest\x1a\x1c.event.UpdateTicketsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/v1/event/{event_id}\x12v\n" +
+	"\fReserveSeats\x12\x1a.event.ReserveSeatsRequest\x1a\x1b.event.ReserveSeatsResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/events/{event_id}/reservations\x12r\n" +
+	"\fReleaseSeats\x12\x1a.event.ReleaseSeatsRequest\x1a\x1b.event.ReleaseSeatsResponse\")\x82\xd3\xe4\x93\x02#*!/v1/reservations/{reservation_id}\x12\x84\x01\n" +
+	"\x11ResizeReservation\x12\x1f.event.ResizeReservationRequest\x1a .event.ResizeReservationResponse\",\x82\xd3\xe4\x93\x02&:\x01*2!/v1/reservations/{reservation_id}\x12f\n" +
+	"\n" +
+	"GetSeatMap\x12\x18.event.GetSeatMapRequest\x1a\x19.event.GetSeatMapResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/events/{event_id}/seats\x12n\n" +
+	"\x11CreateVenueLayout\x12\x1f.event.CreateVenueLayoutRequest\x1a .event.CreateVenueLayoutResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/layouts\x12n\n" +
+	"\x0eGetVenueLayout\x12\x1c.event.GetVenueLayoutRequest\x1a\x1d.event.GetVenueLayoutResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/layouts/{layout_id}\x12\x82\x01\n" +
+	"\x10CreateTicketType\x12\x1e.event.CreateTicketTypeRequest\x1a\x1f.event.CreateTicketTypeResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/events/{event_id}/ticket-types\x12|\n" +
+	"\x0fListTicketTypes\x12\x1d.event.ListTicketTypesRequest\x1a\x1e.event.ListTicketTypesResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/events/{event_id}/ticket-types\x12\x81\x01\n" +
+	"\x10UpdateTicketType\x12\x1e.event.UpdateTicketTypeRequest\x1a\x1f.event.UpdateTicketTypeResponse\",\x82\xd3\xe4\x93\x02&:\x01*2!/v1/ticket-types/{ticket_type_id}\x12f\n" +
+	"\vUpdateEvent\x12\x19.event.UpdateEventRequest\x1a\x1a.event.UpdateEventResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/events/{event_id}\x12}\n" +
+	"\x0fRescheduleEvent\x12\x1d.event.RescheduleEventRequest\x1a\x1e.event.RescheduleEventResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/events/{event_id}/reschedule\x12\x84\x01\n" +
+	"\x10SetPurchaseLimit\x12\x1e.event.SetPurchaseLimitRequest\x1a\x1f.event.SetPurchaseLimitResponse\"/\x82\xd3\xe4\x93\x02):\x01*\x1a$/v1/events/{event_id}/purchase-limit\x12t\n" +
+	"\fSetQueueMode\x12\x1a.event.SetQueueModeRequest\x1a\x1b.event.SetQueueModeResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\x1a /v1/events/{event_id}/queue-mode\x12~\n" +
+	"\x10SetEventCapacity\x12\x1e.event.SetEventCapacityRequest\x1a\x1f.event.SetEventCapacityResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/events/{event_id}/capacity\x12\x7f\n" +
+	"\x11UpdateEventStatus\x12\x1f.event.UpdateEventStatusRequest\x1a .event.UpdateEventStatusResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/events/{event_id}/status\x12m\n" +
+	"\vCancelEvent\x12\x19.event.CancelEventRequest\x1a\x1a.event.CancelEventResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/events/{event_id}/cancel\x12[\n" +
+	"\vCreateVenue\x12\x19.event.CreateVenueRequest\x1a\x1a.event.CreateVenueResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/venues\x12Z\n" +
+	"\bGetVenue\x12\x16.event.GetVenueRequest\x1a\x17.event.GetVenueResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/venues/{venue_id}\x12U\n" +
+	"\n" +
+	"ListVenues\x12\x18.event.ListVenuesRequest\x1a\x19.event.ListVenuesResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/venues\x12f\n" +
+	"\vUpdateVenue\x12\x19.event.UpdateVenueRequest\x1a\x1a.event.UpdateVenueResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/venues/{venue_id}\x12c\n" +
+	"\vDeleteVenue\x12\x19.event.DeleteVenueRequest\x1a\x1a.event.DeleteVenueResponse\"\x1d\x82\xd3\xe4\x93\x02\x17*\x15/v1/venues/{venue_id}\x12k\n" +
+	"\x0fCreateOrganizer\x12\x1d.event.CreateOrganizerRequest\x1a\x1e.event.CreateOrganizerResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/organizers\x12n\n" +
+	"\fGetOrganizer\x12\x1a.event.GetOrganizerRequest\x1a\x1b.event.GetOrganizerResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/organizers/{organizer_id}\x12e\n" +
+	"\x0eListOrganizers\x12\x1c.event.ListOrganizersRequest\x1a\x1d.event.ListOrganizersResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/organizers\x12z\n" +
+	"\x0fUpdateOrganizer\x12\x1d.event.UpdateOrganizerRequest\x1a\x1e.event.UpdateOrganizerResponse\"(\x82\xd3\xe4\x93\x02\":\x01*2\x1d/v1/organizers/{organizer_id}\x12w\n" +
+	"\x0fDeleteOrganizer\x12\x1d.event.DeleteOrganizerRequest\x1a\x1e.event.DeleteOrganizerResponse\"%\x82\xd3\xe4\x93\x02\x1f*\x1d/v1/organizers/{organizer_id}\x12m\n" +
+	"\x11CreateEventSeries\x12\x1f.event.CreateEventSeriesRequest\x1a .event.CreateEventSeriesResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/series\x12m\n" +
+	"\x0eGetEventSeries\x12\x1c.event.GetEventSeriesRequest\x1a\x1d.event.GetEventSeriesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/series/{series_id}\x12\x91\x01\n" +
+	"\x16SetEventSeriesCapacity\x12$.event.SetEventSeriesCapacityRequest\x1a%.event.SetEventSeriesCapacityResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\x1a\x1f/v1/series/{series_id}/capacity\x12\x80\x01\n" +
+	"\x11CancelEventSeries\x12\x1f.event.CancelEventSeriesRequest\x1a .event.CancelEventSeriesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/series/{series_id}/cancel\x12Z\n" +
+	"\x16WatchEventAvailability\x12$.event.WatchEventAvailabilityRequest\x1a\x18.event.EventAvailability0\x01B\tZ\a./protob\x06proto3"
 
 var (
 	file_event_proto_rawDescOnce sync.Once
@@ -566,38 +6253,262 @@ func file_event_proto_rawDescGZIP() []byte {
 	return file_event_proto_rawDescData
 }
 
-var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_event_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_event_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_event_proto_goTypes = []any{
-	(*Event)(nil),                 // 0: event.Event
-	(*CreateEventRequest)(nil),    // 1: event.CreateEventRequest
-	(*CreateEventResponse)(nil),   // 2: event.CreateEventResponse
-	(*GetEventRequest)(nil),       // 3: event.GetEventRequest
-	(*GetEventResponse)(nil),      // 4: event.GetEventResponse
-	(*ListEventsRequest)(nil),     // 5: event.ListEventsRequest
-	(*ListEventsResponse)(nil),    // 6: event.ListEventsResponse
-	(*UpdateTicketsRequest)(nil),  // 7: event.UpdateTicketsRequest
-	(*UpdateTicketsResponse)(nil), // 8: event.UpdateTicketsResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(EventStatus)(0),                       // 0: event.EventStatus
+	(ReservationStatus)(0),                 // 1: event.ReservationStatus
+	(SeatStatus)(0),                        // 2: event.SeatStatus
+	(*Event)(nil),                          // 3: event.Event
+	(*CreateEventRequest)(nil),             // 4: event.CreateEventRequest
+	(*CreateEventResponse)(nil),            // 5: event.CreateEventResponse
+	(*GetEventRequest)(nil),                // 6: event.GetEventRequest
+	(*GetEventResponse)(nil),               // 7: event.GetEventResponse
+	(*ListEventsRequest)(nil),              // 8: event.ListEventsRequest
+	(*ListEventsResponse)(nil),             // 9: event.ListEventsResponse
+	(*SearchEventsRequest)(nil),            // 10: event.SearchEventsRequest
+	(*SearchEventsResponse)(nil),           // 11: event.SearchEventsResponse
+	(*SearchResult)(nil),                   // 12: event.SearchResult
+	(*SearchFacets)(nil),                   // 13: event.SearchFacets
+	(*FacetCount)(nil),                     // 14: event.FacetCount
+	(*UpdateTicketsRequest)(nil),           // 15: event.UpdateTicketsRequest
+	(*UpdateTicketsResponse)(nil),          // 16: event.UpdateTicketsResponse
+	(*Reservation)(nil),                    // 17: event.Reservation
+	(*ReservationItem)(nil),                // 18: event.ReservationItem
+	(*ReserveSeatsRequest)(nil),            // 19: event.ReserveSeatsRequest
+	(*ReserveSeatsResponse)(nil),           // 20: event.ReserveSeatsResponse
+	(*ReleaseSeatsRequest)(nil),            // 21: event.ReleaseSeatsRequest
+	(*LegacyHold)(nil),                     // 22: event.LegacyHold
+	(*ReleaseSeatsResponse)(nil),           // 23: event.ReleaseSeatsResponse
+	(*ResizeReservationRequest)(nil),       // 24: event.ResizeReservationRequest
+	(*ResizeReservationResponse)(nil),      // 25: event.ResizeReservationResponse
+	(*LayoutSeat)(nil),                     // 26: event.LayoutSeat
+	(*VenueLayout)(nil),                    // 27: event.VenueLayout
+	(*CreateVenueLayoutRequest)(nil),       // 28: event.CreateVenueLayoutRequest
+	(*CreateVenueLayoutResponse)(nil),      // 29: event.CreateVenueLayoutResponse
+	(*GetVenueLayoutRequest)(nil),          // 30: event.GetVenueLayoutRequest
+	(*GetVenueLayoutResponse)(nil),         // 31: event.GetVenueLayoutResponse
+	(*Seat)(nil),                           // 32: event.Seat
+	(*GetSeatMapRequest)(nil),              // 33: event.GetSeatMapRequest
+	(*GetSeatMapResponse)(nil),             // 34: event.GetSeatMapResponse
+	(*TicketType)(nil),                     // 35: event.TicketType
+	(*CreateTicketTypeRequest)(nil),        // 36: event.CreateTicketTypeRequest
+	(*CreateTicketTypeResponse)(nil),       // 37: event.CreateTicketTypeResponse
+	(*ListTicketTypesRequest)(nil),         // 38: event.ListTicketTypesRequest
+	(*ListTicketTypesResponse)(nil),        // 39: event.ListTicketTypesResponse
+	(*UpdateTicketTypeRequest)(nil),        // 40: event.UpdateTicketTypeRequest
+	(*UpdateTicketTypeResponse)(nil),       // 41: event.UpdateTicketTypeResponse
+	(*UpdateEventRequest)(nil),             // 42: event.UpdateEventRequest
+	(*UpdateEventResponse)(nil),            // 43: event.UpdateEventResponse
+	(*RescheduleEventRequest)(nil),         // 44: event.RescheduleEventRequest
+	(*RescheduleEventResponse)(nil),        // 45: event.RescheduleEventResponse
+	(*SetPurchaseLimitRequest)(nil),        // 46: event.SetPurchaseLimitRequest
+	(*SetPurchaseLimitResponse)(nil),       // 47: event.SetPurchaseLimitResponse
+	(*SetQueueModeRequest)(nil),            // 48: event.SetQueueModeRequest
+	(*SetQueueModeResponse)(nil),           // 49: event.SetQueueModeResponse
+	(*SetEventCapacityRequest)(nil),        // 50: event.SetEventCapacityRequest
+	(*SetEventCapacityResponse)(nil),       // 51: event.SetEventCapacityResponse
+	(*UpdateEventStatusRequest)(nil),       // 52: event.UpdateEventStatusRequest
+	(*UpdateEventStatusResponse)(nil),      // 53: event.UpdateEventStatusResponse
+	(*CancelEventRequest)(nil),             // 54: event.CancelEventRequest
+	(*CancelEventResponse)(nil),            // 55: event.CancelEventResponse
+	(*Venue)(nil),                          // 56: event.Venue
+	(*Address)(nil),                        // 57: event.Address
+	(*GeoPoint)(nil),                       // 58: event.GeoPoint
+	(*CreateVenueRequest)(nil),             // 59: event.CreateVenueRequest
+	(*CreateVenueResponse)(nil),            // 60: event.CreateVenueResponse
+	(*GetVenueRequest)(nil),                // 61: event.GetVenueRequest
+	(*GetVenueResponse)(nil),               // 62: event.GetVenueResponse
+	(*ListVenuesRequest)(nil),              // 63: event.ListVenuesRequest
+	(*ListVenuesResponse)(nil),             // 64: event.ListVenuesResponse
+	(*UpdateVenueRequest)(nil),             // 65: event.UpdateVenueRequest
+	(*UpdateVenueResponse)(nil),            // 66: event.UpdateVenueResponse
+	(*DeleteVenueRequest)(nil),             // 67: event.DeleteVenueRequest
+	(*DeleteVenueResponse)(nil),            // 68: event.DeleteVenueResponse
+	(*Organizer)(nil),                      // 69: event.Organizer
+	(*CreateOrganizerRequest)(nil),         // 70: event.CreateOrganizerRequest
+	(*CreateOrganizerResponse)(nil),        // 71: event.CreateOrganizerResponse
+	(*GetOrganizerRequest)(nil),            // 72: event.GetOrganizerRequest
+	(*GetOrganizerResponse)(nil),           // 73: event.GetOrganizerResponse
+	(*ListOrganizersRequest)(nil),          // 74: event.ListOrganizersRequest
+	(*ListOrganizersResponse)(nil),         // 75: event.ListOrganizersResponse
+	(*UpdateOrganizerRequest)(nil),         // 76: event.UpdateOrganizerRequest
+	(*UpdateOrganizerResponse)(nil),        // 77: event.UpdateOrganizerResponse
+	(*DeleteOrganizerRequest)(nil),         // 78: event.DeleteOrganizerRequest
+	(*DeleteOrganizerResponse)(nil),        // 79: event.DeleteOrganizerResponse
+	(*EventSeries)(nil),                    // 80: event.EventSeries
+	(*CreateEventSeriesRequest)(nil),       // 81: event.CreateEventSeriesRequest
+	(*CreateEventSeriesResponse)(nil),      // 82: event.CreateEventSeriesResponse
+	(*GetEventSeriesRequest)(nil),          // 83: event.GetEventSeriesRequest
+	(*GetEventSeriesResponse)(nil),         // 84: event.GetEventSeriesResponse
+	(*SetEventSeriesCapacityRequest)(nil),  // 85: event.SetEventSeriesCapacityRequest
+	(*SetEventSeriesCapacityResponse)(nil), // 86: event.SetEventSeriesCapacityResponse
+	(*CancelEventSeriesRequest)(nil),       // 87: event.CancelEventSeriesRequest
+	(*CancelEventSeriesResponse)(nil),      // 88: event.CancelEventSeriesResponse
+	(*WatchEventAvailabilityRequest)(nil),  // 89: event.WatchEventAvailabilityRequest
+	(*EventAvailability)(nil),              // 90: event.EventAvailability
+	(*timestamppb.Timestamp)(nil),          // 91: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),          // 92: google.protobuf.FieldMask
 }
 var file_event_proto_depIdxs = []int32{
-	9, // 0: event.Event.start_time:type_name -> google.protobuf.Timestamp
-	9, // 1: event.Event.created_at:type_name -> google.protobuf.Timestamp
-	9, // 2: event.CreateEventRequest.start_time:type_name -> google.protobuf.Timestamp
-	0, // 3: event.GetEventResponse.event:type_name -> event.Event
-	0, // 4: event.ListEventsResponse.events:type_name -> event.Event
-	1, // 5: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
-	3, // 6: event.EventService.GetEvent:input_type -> event.GetEventRequest
-	5, // 7: event.EventService.ListEvents:input_type -> event.ListEventsRequest
-	7, // 8: event.EventService.UpdateAvailableTickets:input_type -> event.UpdateTicketsRequest
-	2, // 9: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
-	4, // 10: event.EventService.GetEvent:output_type -> event.GetEventResponse
-	6, // 11: event.EventService.ListEvents:output_type -> event.ListEventsResponse
-	8, // 12: event.EventService.UpdateAvailableTickets:output_type -> event.UpdateTicketsResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	91,  // 0: event.Event.start_time:type_name -> google.protobuf.Timestamp
+	91,  // 1: event.Event.created_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: event.Event.status:type_name -> event.EventStatus
+	91,  // 3: event.CreateEventRequest.start_time:type_name -> google.protobuf.Timestamp
+	3,   // 4: event.GetEventResponse.event:type_name -> event.Event
+	91,  // 5: event.ListEventsRequest.starts_from:type_name -> google.protobuf.Timestamp
+	91,  // 6: event.ListEventsRequest.starts_before:type_name -> google.protobuf.Timestamp
+	0,   // 7: event.ListEventsRequest.status:type_name -> event.EventStatus
+	3,   // 8: event.ListEventsResponse.events:type_name -> event.Event
+	91,  // 9: event.SearchEventsRequest.starts_from:type_name -> google.protobuf.Timestamp
+	91,  // 10: event.SearchEventsRequest.starts_before:type_name -> google.protobuf.Timestamp
+	0,   // 11: event.SearchEventsRequest.status:type_name -> event.EventStatus
+	12,  // 12: event.SearchEventsResponse.results:type_name -> event.SearchResult
+	13,  // 13: event.SearchEventsResponse.facets:type_name -> event.SearchFacets
+	3,   // 14: event.SearchResult.event:type_name -> event.Event
+	14,  // 15: event.SearchFacets.categories:type_name -> event.FacetCount
+	14,  // 16: event.SearchFacets.venues:type_name -> event.FacetCount
+	14,  // 17: event.SearchFacets.months:type_name -> event.FacetCount
+	1,   // 18: event.Reservation.status:type_name -> event.ReservationStatus
+	91,  // 19: event.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 20: event.Reservation.released_at:type_name -> google.protobuf.Timestamp
+	18,  // 21: event.Reservation.items:type_name -> event.ReservationItem
+	18,  // 22: event.ReserveSeatsRequest.items:type_name -> event.ReservationItem
+	17,  // 23: event.ReserveSeatsResponse.reservation:type_name -> event.Reservation
+	22,  // 24: event.ReleaseSeatsRequest.legacy:type_name -> event.LegacyHold
+	17,  // 25: event.ReleaseSeatsResponse.reservation:type_name -> event.Reservation
+	17,  // 26: event.ResizeReservationResponse.reservation:type_name -> event.Reservation
+	26,  // 27: event.VenueLayout.seats:type_name -> event.LayoutSeat
+	91,  // 28: event.VenueLayout.created_at:type_name -> google.protobuf.Timestamp
+	26,  // 29: event.CreateVenueLayoutRequest.seats:type_name -> event.LayoutSeat
+	27,  // 30: event.CreateVenueLayoutResponse.layout:type_name -> event.VenueLayout
+	27,  // 31: event.GetVenueLayoutResponse.layout:type_name -> event.VenueLayout
+	2,   // 32: event.Seat.status:type_name -> event.SeatStatus
+	32,  // 33: event.GetSeatMapResponse.seats:type_name -> event.Seat
+	91,  // 34: event.TicketType.sales_start:type_name -> google.protobuf.Timestamp
+	91,  // 35: event.TicketType.sales_end:type_name -> google.protobuf.Timestamp
+	91,  // 36: event.TicketType.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 37: event.CreateTicketTypeRequest.sales_start:type_name -> google.protobuf.Timestamp
+	91,  // 38: event.CreateTicketTypeRequest.sales_end:type_name -> google.protobuf.Timestamp
+	35,  // 39: event.CreateTicketTypeResponse.ticket_type:type_name -> event.TicketType
+	35,  // 40: event.ListTicketTypesResponse.ticket_types:type_name -> event.TicketType
+	91,  // 41: event.UpdateTicketTypeRequest.sales_start:type_name -> google.protobuf.Timestamp
+	91,  // 42: event.UpdateTicketTypeRequest.sales_end:type_name -> google.protobuf.Timestamp
+	35,  // 43: event.UpdateTicketTypeResponse.ticket_type:type_name -> event.TicketType
+	92,  // 44: event.UpdateEventRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,   // 45: event.UpdateEventResponse.event:type_name -> event.Event
+	91,  // 46: event.RescheduleEventRequest.start_time:type_name -> google.protobuf.Timestamp
+	3,   // 47: event.RescheduleEventResponse.event:type_name -> event.Event
+	3,   // 48: event.SetPurchaseLimitResponse.event:type_name -> event.Event
+	3,   // 49: event.SetQueueModeResponse.event:type_name -> event.Event
+	3,   // 50: event.SetEventCapacityResponse.event:type_name -> event.Event
+	0,   // 51: event.UpdateEventStatusRequest.status:type_name -> event.EventStatus
+	3,   // 52: event.UpdateEventStatusResponse.event:type_name -> event.Event
+	3,   // 53: event.CancelEventResponse.event:type_name -> event.Event
+	57,  // 54: event.Venue.address:type_name -> event.Address
+	58,  // 55: event.Venue.location:type_name -> event.GeoPoint
+	91,  // 56: event.Venue.created_at:type_name -> google.protobuf.Timestamp
+	57,  // 57: event.CreateVenueRequest.address:type_name -> event.Address
+	58,  // 58: event.CreateVenueRequest.location:type_name -> event.GeoPoint
+	56,  // 59: event.CreateVenueResponse.venue:type_name -> event.Venue
+	56,  // 60: event.GetVenueResponse.venue:type_name -> event.Venue
+	56,  // 61: event.ListVenuesResponse.venues:type_name -> event.Venue
+	57,  // 62: event.UpdateVenueRequest.address:type_name -> event.Address
+	58,  // 63: event.UpdateVenueRequest.location:type_name -> event.GeoPoint
+	56,  // 64: event.UpdateVenueResponse.venue:type_name -> event.Venue
+	91,  // 65: event.Organizer.created_at:type_name -> google.protobuf.Timestamp
+	69,  // 66: event.CreateOrganizerResponse.organizer:type_name -> event.Organizer
+	69,  // 67: event.GetOrganizerResponse.organizer:type_name -> event.Organizer
+	69,  // 68: event.ListOrganizersResponse.organizers:type_name -> event.Organizer
+	69,  // 69: event.UpdateOrganizerResponse.organizer:type_name -> event.Organizer
+	91,  // 70: event.EventSeries.first_start:type_name -> google.protobuf.Timestamp
+	91,  // 71: event.EventSeries.until:type_name -> google.protobuf.Timestamp
+	91,  // 72: event.EventSeries.generated_until:type_name -> google.protobuf.Timestamp
+	91,  // 73: event.EventSeries.created_at:type_name -> google.protobuf.Timestamp
+	91,  // 74: event.CreateEventSeriesRequest.first_start:type_name -> google.protobuf.Timestamp
+	80,  // 75: event.CreateEventSeriesResponse.series:type_name -> event.EventSeries
+	80,  // 76: event.GetEventSeriesResponse.series:type_name -> event.EventSeries
+	80,  // 77: event.SetEventSeriesCapacityResponse.series:type_name -> event.EventSeries
+	91,  // 78: event.CancelEventSeriesRequest.from:type_name -> google.protobuf.Timestamp
+	80,  // 79: event.CancelEventSeriesResponse.series:type_name -> event.EventSeries
+	91,  // 80: event.EventAvailability.sent_at:type_name -> google.protobuf.Timestamp
+	4,   // 81: event.EventService.CreateEvent:input_type -> event.CreateEventRequest
+	6,   // 82: event.EventService.GetEvent:input_type -> event.GetEventRequest
+	8,   // 83: event.EventService.ListEvents:input_type -> event.ListEventsRequest
+	10,  // 84: event.EventService.SearchEvents:input_type -> event.SearchEventsRequest
+	15,  // 85: event.EventService.UpdateAvailableTickets:input_type -> event.UpdateTicketsRequest
+	19,  // 86: event.EventService.ReserveSeats:input_type -> event.ReserveSeatsRequest
+	21,  // 87: event.EventService.ReleaseSeats:input_type -> event.ReleaseSeatsRequest
+	24,  // 88: event.EventService.ResizeReservation:input_type -> event.ResizeReservationRequest
+	33,  // 89: event.EventService.GetSeatMap:input_type -> event.GetSeatMapRequest
+	28,  // 90: event.EventService.CreateVenueLayout:input_type -> event.CreateVenueLayoutRequest
+	30,  // 91: event.EventService.GetVenueLayout:input_type -> event.GetVenueLayoutRequest
+	36,  // 92: event.EventService.CreateTicketType:input_type -> event.CreateTicketTypeRequest
+	38,  // 93: event.EventService.ListTicketTypes:input_type -> event.ListTicketTypesRequest
+	40,  // 94: event.EventService.UpdateTicketType:input_type -> event.UpdateTicketTypeRequest
+	42,  // 95: event.EventService.UpdateEvent:input_type -> event.UpdateEventRequest
+	44,  // 96: event.EventService.RescheduleEvent:input_type -> event.RescheduleEventRequest
+	46,  // 97: event.EventService.SetPurchaseLimit:input_type -> event.SetPurchaseLimitRequest
+	48,  // 98: event.EventService.SetQueueMode:input_type -> event.SetQueueModeRequest
+	50,  // 99: event.EventService.SetEventCapacity:input_type -> event.SetEventCapacityRequest
+	52,  // 100: event.EventService.UpdateEventStatus:input_type -> event.UpdateEventStatusRequest
+	54,  // 101: event.EventService.CancelEvent:input_type -> event.CancelEventRequest
+	59,  // 102: event.EventService.CreateVenue:input_type -> event.CreateVenueRequest
+	61,  // 103: event.EventService.GetVenue:input_type -> event.GetVenueRequest
+	63,  // 104: event.EventService.ListVenues:input_type -> event.ListVenuesRequest
+	65,  // 105: event.EventService.UpdateVenue:input_type -> event.UpdateVenueRequest
+	67,  // 106: event.EventService.DeleteVenue:input_type -> event.DeleteVenueRequest
+	70,  // 107: event.EventService.CreateOrganizer:input_type -> event.CreateOrganizerRequest
+	72,  // 108: event.EventService.GetOrganizer:input_type -> event.GetOrganizerRequest
+	74,  // 109: event.EventService.ListOrganizers:input_type -> event.ListOrganizersRequest
+	76,  // 110: event.EventService.UpdateOrganizer:input_type -> event.UpdateOrganizerRequest
+	78,  // 111: event.EventService.DeleteOrganizer:input_type -> event.DeleteOrganizerRequest
+	81,  // 112: event.EventService.CreateEventSeries:input_type -> event.CreateEventSeriesRequest
+	83,  // 113: event.EventService.GetEventSeries:input_type -> event.GetEventSeriesRequest
+	85,  // 114: event.EventService.SetEventSeriesCapacity:input_type -> event.SetEventSeriesCapacityRequest
+	87,  // 115: event.EventService.CancelEventSeries:input_type -> event.CancelEventSeriesRequest
+	89,  // 116: event.EventService.WatchEventAvailability:input_type -> event.WatchEventAvailabilityRequest
+	5,   // 117: event.EventService.CreateEvent:output_type -> event.CreateEventResponse
+	7,   // 118: event.EventService.GetEvent:output_type -> event.GetEventResponse
+	9,   // 119: event.EventService.ListEvents:output_type -> event.ListEventsResponse
+	11,  // 120: event.EventService.SearchEvents:output_type -> event.SearchEventsResponse
+	16,  // 121: event.EventService.UpdateAvailableTickets:output_type -> event.UpdateTicketsResponse
+	20,  // 122: event.EventService.ReserveSeats:output_type -> event.ReserveSeatsResponse
+	23,  // 123: event.EventService.ReleaseSeats:output_type -> event.ReleaseSeatsResponse
+	25,  // 124: event.EventService.ResizeReservation:output_type -> event.ResizeReservationResponse
+	34,  // 125: event.EventService.GetSeatMap:output_type -> event.GetSeatMapResponse
+	29,  // 126: event.EventService.CreateVenueLayout:output_type -> event.CreateVenueLayoutResponse
+	31,  // 127: event.EventService.GetVenueLayout:output_type -> event.GetVenueLayoutResponse
+	37,  // 128: event.EventService.CreateTicketType:output_type -> event.CreateTicketTypeResponse
+	39,  // 129: event.EventService.ListTicketTypes:output_type -> event.ListTicketTypesResponse
+	41,  // 130: event.EventService.UpdateTicketType:output_type -> event.UpdateTicketTypeResponse
+	43,  // 131: event.EventService.UpdateEvent:output_type -> event.UpdateEventResponse
+	45,  // 132: event.EventService.RescheduleEvent:output_type -> event.RescheduleEventResponse
+	47,  // 133: event.EventService.SetPurchaseLimit:output_type -> event.SetPurchaseLimitResponse
+	49,  // 134: event.EventService.SetQueueMode:output_type -> event.SetQueueModeResponse
+	51,  // 135: event.EventService.SetEventCapacity:output_type -> event.SetEventCapacityResponse
+	53,  // 136: event.EventService.UpdateEventStatus:output_type -> event.UpdateEventStatusResponse
+	55,  // 137: event.EventService.CancelEvent:output_type -> event.CancelEventResponse
+	60,  // 138: event.EventService.CreateVenue:output_type -> event.CreateVenueResponse
+	62,  // 139: event.EventService.GetVenue:output_type -> event.GetVenueResponse
+	64,  // 140: event.EventService.ListVenues:output_type -> event.ListVenuesResponse
+	66,  // 141: event.EventService.UpdateVenue:output_type -> event.UpdateVenueResponse
+	68,  // 142: event.EventService.DeleteVenue:output_type -> event.DeleteVenueResponse
+	71,  // 143: event.EventService.CreateOrganizer:output_type -> event.CreateOrganizerResponse
+	73,  // 144: event.EventService.GetOrganizer:output_type -> event.GetOrganizerResponse
+	75,  // 145: event.EventService.ListOrganizers:output_type -> event.ListOrganizersResponse
+	77,  // 146: event.EventService.UpdateOrganizer:output_type -> event.UpdateOrganizerResponse
+	79,  // 147: event.EventService.DeleteOrganizer:output_type -> event.DeleteOrganizerResponse
+	82,  // 148: event.EventService.CreateEventSeries:output_type -> event.CreateEventSeriesResponse
+	84,  // 149: event.EventService.GetEventSeries:output_type -> event.GetEventSeriesResponse
+	86,  // 150: event.EventService.SetEventSeriesCapacity:output_type -> event.SetEventSeriesCapacityResponse
+	88,  // 151: event.EventService.CancelEventSeries:output_type -> event.CancelEventSeriesResponse
+	90,  // 152: event.EventService.WatchEventAvailability:output_type -> event.EventAvailability
+	117, // [117:153] is the sub-list for method output_type
+	81,  // [81:117] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_event_proto_init() }
@@ -610,13 +6521,14 @@ func file_event_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_event_proto_rawDesc), len(file_event_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_event_proto_goTypes,
 		DependencyIndexes: file_event_proto_depIdxs,
+		EnumInfos:         file_event_proto_enumTypes,
 		MessageInfos:      file_event_proto_msgTypes,
 	}.Build()
 	File_event_proto = out.File
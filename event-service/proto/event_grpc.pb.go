@@ -22,7 +22,39 @@ const (
 	EventService_CreateEvent_FullMethodName            = "/event.EventService/CreateEvent"
 	EventService_GetEvent_FullMethodName               = "/event.EventService/GetEvent"
 	EventService_ListEvents_FullMethodName             = "/event.EventService/ListEvents"
+	EventService_SearchEvents_FullMethodName           = "/event.EventService/SearchEvents"
 	EventService_UpdateAvailableTickets_FullMethodName = "/event.EventService/UpdateAvailableTickets"
+	EventService_ReserveSeats_FullMethodName           = "/event.EventService/ReserveSeats"
+	EventService_ReleaseSeats_FullMethodName           = "/event.EventService/ReleaseSeats"
+	EventService_ResizeReservation_FullMethodName      = "/event.EventService/ResizeReservation"
+	EventService_GetSeatMap_FullMethodName             = "/event.EventService/GetSeatMap"
+	EventService_CreateVenueLayout_FullMethodName      = "/event.EventService/CreateVenueLayout"
+	EventService_GetVenueLayout_FullMethodName         = "/event.EventService/GetVenueLayout"
+	EventService_CreateTicketType_FullMethodName       = "/event.EventService/CreateTicketType"
+	EventService_ListTicketTypes_FullMethodName        = "/event.EventService/ListTicketTypes"
+	EventService_UpdateTicketType_FullMethodName       = "/event.EventService/UpdateTicketType"
+	EventService_UpdateEvent_FullMethodName            = "/event.EventService/UpdateEvent"
+	EventService_RescheduleEvent_FullMethodName        = "/event.EventService/RescheduleEvent"
+	EventService_SetPurchaseLimit_FullMethodName       = "/event.EventService/SetPurchaseLimit"
+	EventService_SetQueueMode_FullMethodName           = "/event.EventService/SetQueueMode"
+	EventService_SetEventCapacity_FullMethodName       = "/event.EventService/SetEventCapacity"
+	EventService_UpdateEventStatus_FullMethodName      = "/event.EventService/UpdateEventStatus"
+	EventService_CancelEvent_FullMethodName            = "/event.EventService/CancelEvent"
+	EventService_CreateVenue_FullMethodName            = "/event.EventService/CreateVenue"
+	EventService_GetVenue_FullMethodName               = "/event.EventService/GetVenue"
+	EventService_ListVenues_FullMethodName             = "/event.EventService/ListVenues"
+	EventService_UpdateVenue_FullMethodName            = "/event.EventService/UpdateVenue"
+	EventService_DeleteVenue_FullMethodName            = "/event.EventService/DeleteVenue"
+	EventService_CreateOrganizer_FullMethodName        = "/event.EventService/CreateOrganizer"
+	EventService_GetOrganizer_FullMethodName           = "/event.EventService/GetOrganizer"
+	EventService_ListOrganizers_FullMethodName         = "/event.EventService/ListOrganizers"
+	EventService_UpdateOrganizer_FullMethodName        = "/event.EventService/UpdateOrganizer"
+	EventService_DeleteOrganizer_FullMethodName        = "/event.EventService/DeleteOrganizer"
+	EventService_CreateEventSeries_FullMethodName      = "/event.EventService/CreateEventSeries"
+	EventService_GetEventSeries_FullMethodName         = "/event.EventService/GetEventSeries"
+	EventService_SetEventSeriesCapacity_FullMethodName = "/event.EventService/SetEventSeriesCapacity"
+	EventService_CancelEventSeries_FullMethodName      = "/event.EventService/CancelEventSeries"
+	EventService_WatchEventAvailability_FullMethodName = "/event.EventService/WatchEventAvailability"
 )
 
 // EventServiceClient is the client API for EventService service.
@@ -32,7 +64,42 @@ type EventServiceClient interface {
 	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error)
 	UpdateAvailableTickets(ctx context.Context, in *UpdateTicketsRequest, opts ...grpc.CallOption) (*UpdateTicketsResponse, error)
+	ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error)
+	ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...grpc.CallOption) (*ReleaseSeatsResponse, error)
+	ResizeReservation(ctx context.Context, in *ResizeReservationRequest, opts ...grpc.CallOption) (*ResizeReservationResponse, error)
+	GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error)
+	CreateVenueLayout(ctx context.Context, in *CreateVenueLayoutRequest, opts ...grpc.CallOption) (*CreateVenueLayoutResponse, error)
+	GetVenueLayout(ctx context.Context, in *GetVenueLayoutRequest, opts ...grpc.CallOption) (*GetVenueLayoutResponse, error)
+	CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error)
+	ListTicketTypes(ctx context.Context, in *ListTicketTypesRequest, opts ...grpc.CallOption) (*ListTicketTypesResponse, error)
+	UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error)
+	UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error)
+	RescheduleEvent(ctx context.Context, in *RescheduleEventRequest, opts ...grpc.CallOption) (*RescheduleEventResponse, error)
+	SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitRequest, opts ...grpc.CallOption) (*SetPurchaseLimitResponse, error)
+	SetQueueMode(ctx context.Context, in *SetQueueModeRequest, opts ...grpc.CallOption) (*SetQueueModeResponse, error)
+	SetEventCapacity(ctx context.Context, in *SetEventCapacityRequest, opts ...grpc.CallOption) (*SetEventCapacityResponse, error)
+	UpdateEventStatus(ctx context.Context, in *UpdateEventStatusRequest, opts ...grpc.CallOption) (*UpdateEventStatusResponse, error)
+	CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error)
+	CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*CreateVenueResponse, error)
+	GetVenue(ctx context.Context, in *GetVenueRequest, opts ...grpc.CallOption) (*GetVenueResponse, error)
+	ListVenues(ctx context.Context, in *ListVenuesRequest, opts ...grpc.CallOption) (*ListVenuesResponse, error)
+	UpdateVenue(ctx context.Context, in *UpdateVenueRequest, opts ...grpc.CallOption) (*UpdateVenueResponse, error)
+	DeleteVenue(ctx context.Context, in *DeleteVenueRequest, opts ...grpc.CallOption) (*DeleteVenueResponse, error)
+	CreateOrganizer(ctx context.Context, in *CreateOrganizerRequest, opts ...grpc.CallOption) (*CreateOrganizerResponse, error)
+	GetOrganizer(ctx context.Context, in *GetOrganizerRequest, opts ...grpc.CallOption) (*GetOrganizerResponse, error)
+	ListOrganizers(ctx context.Context, in *ListOrganizersRequest, opts ...grpc.CallOption) (*ListOrganizersResponse, error)
+	UpdateOrganizer(ctx context.Context, in *UpdateOrganizerRequest, opts ...grpc.CallOption) (*UpdateOrganizerResponse, error)
+	DeleteOrganizer(ctx context.Context, in *DeleteOrganizerRequest, opts ...grpc.CallOption) (*DeleteOrganizerResponse, error)
+	CreateEventSeries(ctx context.Context, in *CreateEventSeriesRequest, opts ...grpc.CallOption) (*CreateEventSeriesResponse, error)
+	GetEventSeries(ctx context.Context, in *GetEventSeriesRequest, opts ...grpc.CallOption) (*GetEventSeriesResponse, error)
+	SetEventSeriesCapacity(ctx context.Context, in *SetEventSeriesCapacityRequest, opts ...grpc.CallOption) (*SetEventSeriesCapacityResponse, error)
+	CancelEventSeries(ctx context.Context, in *CancelEventSeriesRequest, opts ...grpc.CallOption) (*CancelEventSeriesResponse, error)
+	// Streams the event's available seats: once on subscribing, after every
+	// committed change, and as a heartbeat while nothing changes. Over HTTP it
+	// is served as Server-Sent Events at /v1/events/{event_id}/availability.
+	WatchEventAvailability(ctx context.Context, in *WatchEventAvailabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventAvailability], error)
 }
 
 type eventServiceClient struct {
@@ -73,6 +140,16 @@ func (c *eventServiceClient) ListEvents(ctx context.Context, in *ListEventsReque
 	return out, nil
 }
 
+func (c *eventServiceClient) SearchEvents(ctx context.Context, in *SearchEventsRequest, opts ...grpc.CallOption) (*SearchEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchEventsResponse)
+	err := c.cc.Invoke(ctx, EventService_SearchEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *eventServiceClient) UpdateAvailableTickets(ctx context.Context, in *UpdateTicketsRequest, opts ...grpc.CallOption) (*UpdateTicketsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateTicketsResponse)
@@ -80,132 +157,1151 @@ func (c *eventServiceClient) UpdateAvailableTickets(ctx context.Context, in *Upd
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *eventServiceClient) ReserveSeats(ctx context.Context, in *ReserveSeatsRequest, opts ...grpc.CallOption) (*ReserveSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveSeatsResponse)
+	err := c.cc.Invoke(ctx, EventService_ReserveSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ReleaseSeats(ctx context.Context, in *ReleaseSeatsRequest, opts ...grpc.CallOption) (*ReleaseSeatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseSeatsResponse)
+	err := c.cc.Invoke(ctx, EventService_ReleaseSeats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ResizeReservation(ctx context.Context, in *ResizeReservationRequest, opts ...grpc.CallOption) (*ResizeReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResizeReservationResponse)
+	err := c.cc.Invoke(ctx, EventService_ResizeReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetSeatMap(ctx context.Context, in *GetSeatMapRequest, opts ...grpc.CallOption) (*GetSeatMapResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeatMapResponse)
+	err := c.cc.Invoke(ctx, EventService_GetSeatMap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateVenueLayout(ctx context.Context, in *CreateVenueLayoutRequest, opts ...grpc.CallOption) (*CreateVenueLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVenueLayoutResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateVenueLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetVenueLayout(ctx context.Context, in *GetVenueLayoutRequest, opts ...grpc.CallOption) (*GetVenueLayoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVenueLayoutResponse)
+	err := c.cc.Invoke(ctx, EventService_GetVenueLayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateTicketType(ctx context.Context, in *CreateTicketTypeRequest, opts ...grpc.CallOption) (*CreateTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListTicketTypes(ctx context.Context, in *ListTicketTypesRequest, opts ...grpc.CallOption) (*ListTicketTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTicketTypesResponse)
+	err := c.cc.Invoke(ctx, EventService_ListTicketTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateTicketType(ctx context.Context, in *UpdateTicketTypeRequest, opts ...grpc.CallOption) (*UpdateTicketTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTicketTypeResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateTicketType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateEvent(ctx context.Context, in *UpdateEventRequest, opts ...grpc.CallOption) (*UpdateEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEventResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) RescheduleEvent(ctx context.Context, in *RescheduleEventRequest, opts ...grpc.CallOption) (*RescheduleEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleEventResponse)
+	err := c.cc.Invoke(ctx, EventService_RescheduleEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetPurchaseLimit(ctx context.Context, in *SetPurchaseLimitRequest, opts ...grpc.CallOption) (*SetPurchaseLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPurchaseLimitResponse)
+	err := c.cc.Invoke(ctx, EventService_SetPurchaseLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetQueueMode(ctx context.Context, in *SetQueueModeRequest, opts ...grpc.CallOption) (*SetQueueModeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQueueModeResponse)
+	err := c.cc.Invoke(ctx, EventService_SetQueueMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetEventCapacity(ctx context.Context, in *SetEventCapacityRequest, opts ...grpc.CallOption) (*SetEventCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEventCapacityResponse)
+	err := c.cc.Invoke(ctx, EventService_SetEventCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateEventStatus(ctx context.Context, in *UpdateEventStatusRequest, opts ...grpc.CallOption) (*UpdateEventStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEventStatusResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateEventStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*CancelEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelEventResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*CreateVenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVenueResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetVenue(ctx context.Context, in *GetVenueRequest, opts ...grpc.CallOption) (*GetVenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVenueResponse)
+	err := c.cc.Invoke(ctx, EventService_GetVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListVenues(ctx context.Context, in *ListVenuesRequest, opts ...grpc.CallOption) (*ListVenuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVenuesResponse)
+	err := c.cc.Invoke(ctx, EventService_ListVenues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateVenue(ctx context.Context, in *UpdateVenueRequest, opts ...grpc.CallOption) (*UpdateVenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVenueResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteVenue(ctx context.Context, in *DeleteVenueRequest, opts ...grpc.CallOption) (*DeleteVenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVenueResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateOrganizer(ctx context.Context, in *CreateOrganizerRequest, opts ...grpc.CallOption) (*CreateOrganizerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOrganizerResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateOrganizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetOrganizer(ctx context.Context, in *GetOrganizerRequest, opts ...grpc.CallOption) (*GetOrganizerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrganizerResponse)
+	err := c.cc.Invoke(ctx, EventService_GetOrganizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) ListOrganizers(ctx context.Context, in *ListOrganizersRequest, opts ...grpc.CallOption) (*ListOrganizersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrganizersResponse)
+	err := c.cc.Invoke(ctx, EventService_ListOrganizers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) UpdateOrganizer(ctx context.Context, in *UpdateOrganizerRequest, opts ...grpc.CallOption) (*UpdateOrganizerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateOrganizerResponse)
+	err := c.cc.Invoke(ctx, EventService_UpdateOrganizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) DeleteOrganizer(ctx context.Context, in *DeleteOrganizerRequest, opts ...grpc.CallOption) (*DeleteOrganizerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOrganizerResponse)
+	err := c.cc.Invoke(ctx, EventService_DeleteOrganizer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CreateEventSeries(ctx context.Context, in *CreateEventSeriesRequest, opts ...grpc.CallOption) (*CreateEventSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEventSeriesResponse)
+	err := c.cc.Invoke(ctx, EventService_CreateEventSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) GetEventSeries(ctx context.Context, in *GetEventSeriesRequest, opts ...grpc.CallOption) (*GetEventSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventSeriesResponse)
+	err := c.cc.Invoke(ctx, EventService_GetEventSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) SetEventSeriesCapacity(ctx context.Context, in *SetEventSeriesCapacityRequest, opts ...grpc.CallOption) (*SetEventSeriesCapacityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEventSeriesCapacityResponse)
+	err := c.cc.Invoke(ctx, EventService_SetEventSeriesCapacity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) CancelEventSeries(ctx context.Context, in *CancelEventSeriesRequest, opts ...grpc.CallOption) (*CancelEventSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelEventSeriesResponse)
+	err := c.cc.Invoke(ctx, EventService_CancelEventSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventServiceClient) WatchEventAvailability(ctx context.Context, in *WatchEventAvailabilityRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EventAvailability], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_WatchEventAvailability_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventAvailabilityRequest, EventAvailability]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventAvailabilityClient = grpc.ServerStreamingClient[EventAvailability]
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility.
+type EventServiceServer interface {
+	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
+	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error)
+	UpdateAvailableTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error)
+	ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error)
+	ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error)
+	ResizeReservation(context.Context, *ResizeReservationRequest) (*ResizeReservationResponse, error)
+	GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error)
+	CreateVenueLayout(context.Context, *CreateVenueLayoutRequest) (*CreateVenueLayoutResponse, error)
+	GetVenueLayout(context.Context, *GetVenueLayoutRequest) (*GetVenueLayoutResponse, error)
+	CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error)
+	ListTicketTypes(context.Context, *ListTicketTypesRequest) (*ListTicketTypesResponse, error)
+	UpdateTicketType(context.Context, *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error)
+	UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error)
+	RescheduleEvent(context.Context, *RescheduleEventRequest) (*RescheduleEventResponse, error)
+	SetPurchaseLimit(context.Context, *SetPurchaseLimitRequest) (*SetPurchaseLimitResponse, error)
+	SetQueueMode(context.Context, *SetQueueModeRequest) (*SetQueueModeResponse, error)
+	SetEventCapacity(context.Context, *SetEventCapacityRequest) (*SetEventCapacityResponse, error)
+	UpdateEventStatus(context.Context, *UpdateEventStatusRequest) (*UpdateEventStatusResponse, error)
+	CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error)
+	CreateVenue(context.Context, *CreateVenueRequest) (*CreateVenueResponse, error)
+	GetVenue(context.Context, *GetVenueRequest) (*GetVenueResponse, error)
+	ListVenues(context.Context, *ListVenuesRequest) (*ListVenuesResponse, error)
+	UpdateVenue(context.Context, *UpdateVenueRequest) (*UpdateVenueResponse, error)
+	DeleteVenue(context.Context, *DeleteVenueRequest) (*DeleteVenueResponse, error)
+	CreateOrganizer(context.Context, *CreateOrganizerRequest) (*CreateOrganizerResponse, error)
+	GetOrganizer(context.Context, *GetOrganizerRequest) (*GetOrganizerResponse, error)
+	ListOrganizers(context.Context, *ListOrganizersRequest) (*ListOrganizersResponse, error)
+	UpdateOrganizer(context.Context, *UpdateOrganizerRequest) (*UpdateOrganizerResponse, error)
+	DeleteOrganizer(context.Context, *DeleteOrganizerRequest) (*DeleteOrganizerResponse, error)
+	CreateEventSeries(context.Context, *CreateEventSeriesRequest) (*CreateEventSeriesResponse, error)
+	GetEventSeries(context.Context, *GetEventSeriesRequest) (*GetEventSeriesResponse, error)
+	SetEventSeriesCapacity(context.Context, *SetEventSeriesCapacityRequest) (*SetEventSeriesCapacityResponse, error)
+	CancelEventSeries(context.Context, *CancelEventSeriesRequest) (*CancelEventSeriesResponse, error)
+	// Streams the event's available seats: once on subscribing, after every
+	// committed change, and as a heartbeat while nothing changes. Over HTTP it
+	// is served as Server-Sent Events at /v1/events/{event_id}/availability.
+	WatchEventAvailability(*WatchEventAvailabilityRequest, grpc.ServerStreamingServer[EventAvailability]) error
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServiceServer struct{}
+
+func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEvent not implemented")
+}
+func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventServiceServer) SearchEvents(context.Context, *SearchEventsRequest) (*SearchEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchEvents not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateAvailableTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateAvailableTickets not implemented")
+}
+func (UnimplementedEventServiceServer) ReserveSeats(context.Context, *ReserveSeatsRequest) (*ReserveSeatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveSeats not implemented")
+}
+func (UnimplementedEventServiceServer) ReleaseSeats(context.Context, *ReleaseSeatsRequest) (*ReleaseSeatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseSeats not implemented")
+}
+func (UnimplementedEventServiceServer) ResizeReservation(context.Context, *ResizeReservationRequest) (*ResizeReservationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResizeReservation not implemented")
+}
+func (UnimplementedEventServiceServer) GetSeatMap(context.Context, *GetSeatMapRequest) (*GetSeatMapResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeatMap not implemented")
+}
+func (UnimplementedEventServiceServer) CreateVenueLayout(context.Context, *CreateVenueLayoutRequest) (*CreateVenueLayoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVenueLayout not implemented")
+}
+func (UnimplementedEventServiceServer) GetVenueLayout(context.Context, *GetVenueLayoutRequest) (*GetVenueLayoutResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVenueLayout not implemented")
+}
+func (UnimplementedEventServiceServer) CreateTicketType(context.Context, *CreateTicketTypeRequest) (*CreateTicketTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) ListTicketTypes(context.Context, *ListTicketTypesRequest) (*ListTicketTypesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTicketTypes not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateTicketType(context.Context, *UpdateTicketTypeRequest) (*UpdateTicketTypeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTicketType not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateEvent(context.Context, *UpdateEventRequest) (*UpdateEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEvent not implemented")
+}
+func (UnimplementedEventServiceServer) RescheduleEvent(context.Context, *RescheduleEventRequest) (*RescheduleEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RescheduleEvent not implemented")
+}
+func (UnimplementedEventServiceServer) SetPurchaseLimit(context.Context, *SetPurchaseLimitRequest) (*SetPurchaseLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPurchaseLimit not implemented")
+}
+func (UnimplementedEventServiceServer) SetQueueMode(context.Context, *SetQueueModeRequest) (*SetQueueModeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetQueueMode not implemented")
+}
+func (UnimplementedEventServiceServer) SetEventCapacity(context.Context, *SetEventCapacityRequest) (*SetEventCapacityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEventCapacity not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateEventStatus(context.Context, *UpdateEventStatusRequest) (*UpdateEventStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateEventStatus not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEvent(context.Context, *CancelEventRequest) (*CancelEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedEventServiceServer) CreateVenue(context.Context, *CreateVenueRequest) (*CreateVenueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVenue not implemented")
+}
+func (UnimplementedEventServiceServer) GetVenue(context.Context, *GetVenueRequest) (*GetVenueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVenue not implemented")
+}
+func (UnimplementedEventServiceServer) ListVenues(context.Context, *ListVenuesRequest) (*ListVenuesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVenues not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateVenue(context.Context, *UpdateVenueRequest) (*UpdateVenueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateVenue not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteVenue(context.Context, *DeleteVenueRequest) (*DeleteVenueResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVenue not implemented")
+}
+func (UnimplementedEventServiceServer) CreateOrganizer(context.Context, *CreateOrganizerRequest) (*CreateOrganizerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOrganizer not implemented")
+}
+func (UnimplementedEventServiceServer) GetOrganizer(context.Context, *GetOrganizerRequest) (*GetOrganizerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOrganizer not implemented")
+}
+func (UnimplementedEventServiceServer) ListOrganizers(context.Context, *ListOrganizersRequest) (*ListOrganizersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOrganizers not implemented")
+}
+func (UnimplementedEventServiceServer) UpdateOrganizer(context.Context, *UpdateOrganizerRequest) (*UpdateOrganizerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateOrganizer not implemented")
+}
+func (UnimplementedEventServiceServer) DeleteOrganizer(context.Context, *DeleteOrganizerRequest) (*DeleteOrganizerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteOrganizer not implemented")
+}
+func (UnimplementedEventServiceServer) CreateEventSeries(context.Context, *CreateEventSeriesRequest) (*CreateEventSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateEventSeries not implemented")
+}
+func (UnimplementedEventServiceServer) GetEventSeries(context.Context, *GetEventSeriesRequest) (*GetEventSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEventSeries not implemented")
+}
+func (UnimplementedEventServiceServer) SetEventSeriesCapacity(context.Context, *SetEventSeriesCapacityRequest) (*SetEventSeriesCapacityResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEventSeriesCapacity not implemented")
+}
+func (UnimplementedEventServiceServer) CancelEventSeries(context.Context, *CancelEventSeriesRequest) (*CancelEventSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelEventSeries not implemented")
+}
+func (UnimplementedEventServiceServer) WatchEventAvailability(*WatchEventAvailabilityRequest, grpc.ServerStreamingServer[EventAvailability]) error {
+	return status.Error(codes.Unimplemented, "method WatchEventAvailability not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	// If the following call panics, it indicates UnimplementedEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SearchEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SearchEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SearchEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SearchEvents(ctx, req.(*SearchEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateAvailableTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateAvailableTickets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateAvailableTickets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateAvailableTickets(ctx, req.(*UpdateTicketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReserveSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReserveSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReserveSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReserveSeats(ctx, req.(*ReserveSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ReleaseSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ReleaseSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ReleaseSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ReleaseSeats(ctx, req.(*ReleaseSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ResizeReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ResizeReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ResizeReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ResizeReservation(ctx, req.(*ResizeReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetSeatMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeatMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetSeatMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetSeatMap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetSeatMap(ctx, req.(*GetSeatMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateVenueLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVenueLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateVenueLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateVenueLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateVenueLayout(ctx, req.(*CreateVenueLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_GetVenueLayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVenueLayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetVenueLayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetVenueLayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetVenueLayout(ctx, req.(*GetVenueLayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CreateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateTicketType(ctx, req.(*CreateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_ListTicketTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTicketTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListTicketTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListTicketTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListTicketTypes(ctx, req.(*ListTicketTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateTicketType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTicketTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateTicketType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateTicketType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateTicketType(ctx, req.(*UpdateTicketTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEvent(ctx, req.(*UpdateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_RescheduleEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).RescheduleEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_RescheduleEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).RescheduleEvent(ctx, req.(*RescheduleEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetPurchaseLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPurchaseLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetPurchaseLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetPurchaseLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetPurchaseLimit(ctx, req.(*SetPurchaseLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetQueueMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueueModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetQueueMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetQueueMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetQueueMode(ctx, req.(*SetQueueModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetEventCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetEventCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetEventCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetEventCapacity(ctx, req.(*SetEventCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_UpdateEventStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEventStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateEventStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateEventStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateEventStatus(ctx, req.(*UpdateEventStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEvent(ctx, req.(*CancelEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// EventServiceServer is the server API for EventService service.
-// All implementations must embed UnimplementedEventServiceServer
-// for forward compatibility.
-type EventServiceServer interface {
-	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
-	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
-	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
-	UpdateAvailableTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error)
-	mustEmbedUnimplementedEventServiceServer()
+func _EventService_CreateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateVenue(ctx, req.(*CreateVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedEventServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedEventServiceServer struct{}
+func _EventService_GetVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetVenue(ctx, req.(*GetVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedEventServiceServer) CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateEvent not implemented")
+func _EventService_ListVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListVenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListVenues(ctx, req.(*ListVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEventServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetEvent not implemented")
+
+func _EventService_UpdateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).UpdateVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_UpdateVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).UpdateVenue(ctx, req.(*UpdateVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEventServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListEvents not implemented")
+
+func _EventService_DeleteVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).DeleteVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_DeleteVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).DeleteVenue(ctx, req.(*DeleteVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEventServiceServer) UpdateAvailableTickets(context.Context, *UpdateTicketsRequest) (*UpdateTicketsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateAvailableTickets not implemented")
+
+func _EventService_CreateOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CreateOrganizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CreateOrganizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CreateOrganizer(ctx, req.(*CreateOrganizerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
-func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
 
-// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to EventServiceServer will
-// result in compilation errors.
-type UnsafeEventServiceServer interface {
-	mustEmbedUnimplementedEventServiceServer()
+func _EventService_GetOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).GetOrganizer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_GetOrganizer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).GetOrganizer(ctx, req.(*GetOrganizerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
-	// If the following call panics, it indicates UnimplementedEventServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _EventService_ListOrganizers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&EventService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(EventServiceServer).ListOrganizers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_ListOrganizers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).ListOrganizers(ctx, req.(*ListOrganizersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateEventRequest)
+func _EventService_UpdateOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).CreateEvent(ctx, in)
+		return srv.(EventServiceServer).UpdateOrganizer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_CreateEvent_FullMethodName,
+		FullMethod: EventService_UpdateOrganizer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).CreateEvent(ctx, req.(*CreateEventRequest))
+		return srv.(EventServiceServer).UpdateOrganizer(ctx, req.(*UpdateOrganizerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventRequest)
+func _EventService_DeleteOrganizer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).GetEvent(ctx, in)
+		return srv.(EventServiceServer).DeleteOrganizer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_GetEvent_FullMethodName,
+		FullMethod: EventService_DeleteOrganizer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+		return srv.(EventServiceServer).DeleteOrganizer(ctx, req.(*DeleteOrganizerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListEventsRequest)
+func _EventService_CreateEventSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).ListEvents(ctx, in)
+		return srv.(EventServiceServer).CreateEventSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_ListEvents_FullMethodName,
+		FullMethod: EventService_CreateEventSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+		return srv.(EventServiceServer).CreateEventSeries(ctx, req.(*CreateEventSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EventService_UpdateAvailableTickets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTicketsRequest)
+func _EventService_GetEventSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EventServiceServer).UpdateAvailableTickets(ctx, in)
+		return srv.(EventServiceServer).GetEventSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EventService_UpdateAvailableTickets_FullMethodName,
+		FullMethod: EventService_GetEventSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServiceServer).UpdateAvailableTickets(ctx, req.(*UpdateTicketsRequest))
+		return srv.(EventServiceServer).GetEventSeries(ctx, req.(*GetEventSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_SetEventSeriesCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventSeriesCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).SetEventSeriesCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_SetEventSeriesCapacity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).SetEventSeriesCapacity(ctx, req.(*SetEventSeriesCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventService_CancelEventSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServiceServer).CancelEventSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EventService_CancelEventSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServiceServer).CancelEventSeries(ctx, req.(*CancelEventSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EventService_WatchEventAvailability_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventAvailabilityRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).WatchEventAvailability(m, &grpc.GenericServerStream[WatchEventAvailabilityRequest, EventAvailability]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_WatchEventAvailabilityServer = grpc.ServerStreamingServer[EventAvailability]
+
 // EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,11 +1321,141 @@ var EventService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvents",
 			Handler:    _EventService_ListEvents_Handler,
 		},
+		{
+			MethodName: "SearchEvents",
+			Handler:    _EventService_SearchEvents_Handler,
+		},
 		{
 			MethodName: "UpdateAvailableTickets",
 			Handler:    _EventService_UpdateAvailableTickets_Handler,
 		},
+		{
+			MethodName: "ReserveSeats",
+			Handler:    _EventService_ReserveSeats_Handler,
+		},
+		{
+			MethodName: "ReleaseSeats",
+			Handler:    _EventService_ReleaseSeats_Handler,
+		},
+		{
+			MethodName: "ResizeReservation",
+			Handler:    _EventService_ResizeReservation_Handler,
+		},
+		{
+			MethodName: "GetSeatMap",
+			Handler:    _EventService_GetSeatMap_Handler,
+		},
+		{
+			MethodName: "CreateVenueLayout",
+			Handler:    _EventService_CreateVenueLayout_Handler,
+		},
+		{
+			MethodName: "GetVenueLayout",
+			Handler:    _EventService_GetVenueLayout_Handler,
+		},
+		{
+			MethodName: "CreateTicketType",
+			Handler:    _EventService_CreateTicketType_Handler,
+		},
+		{
+			MethodName: "ListTicketTypes",
+			Handler:    _EventService_ListTicketTypes_Handler,
+		},
+		{
+			MethodName: "UpdateTicketType",
+			Handler:    _EventService_UpdateTicketType_Handler,
+		},
+		{
+			MethodName: "UpdateEvent",
+			Handler:    _EventService_UpdateEvent_Handler,
+		},
+		{
+			MethodName: "RescheduleEvent",
+			Handler:    _EventService_RescheduleEvent_Handler,
+		},
+		{
+			MethodName: "SetPurchaseLimit",
+			Handler:    _EventService_SetPurchaseLimit_Handler,
+		},
+		{
+			MethodName: "SetQueueMode",
+			Handler:    _EventService_SetQueueMode_Handler,
+		},
+		{
+			MethodName: "SetEventCapacity",
+			Handler:    _EventService_SetEventCapacity_Handler,
+		},
+		{
+			MethodName: "UpdateEventStatus",
+			Handler:    _EventService_UpdateEventStatus_Handler,
+		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _EventService_CancelEvent_Handler,
+		},
+		{
+			MethodName: "CreateVenue",
+			Handler:    _EventService_CreateVenue_Handler,
+		},
+		{
+			MethodName: "GetVenue",
+			Handler:    _EventService_GetVenue_Handler,
+		},
+		{
+			MethodName: "ListVenues",
+			Handler:    _EventService_ListVenues_Handler,
+		},
+		{
+			MethodName: "UpdateVenue",
+			Handler:    _EventService_UpdateVenue_Handler,
+		},
+		{
+			MethodName: "DeleteVenue",
+			Handler:    _EventService_DeleteVenue_Handler,
+		},
+		{
+			MethodName: "CreateOrganizer",
+			Handler:    _EventService_CreateOrganizer_Handler,
+		},
+		{
+			MethodName: "GetOrganizer",
+			Handler:    _EventService_GetOrganizer_Handler,
+		},
+		{
+			MethodName: "ListOrganizers",
+			Handler:    _EventService_ListOrganizers_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizer",
+			Handler:    _EventService_UpdateOrganizer_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizer",
+			Handler:    _EventService_DeleteOrganizer_Handler,
+		},
+		{
+			MethodName: "CreateEventSeries",
+			Handler:    _EventService_CreateEventSeries_Handler,
+		},
+		{
+			MethodName: "GetEventSeries",
+			Handler:    _EventService_GetEventSeries_Handler,
+		},
+		{
+			MethodName: "SetEventSeriesCapacity",
+			Handler:    _EventService_SetEventSeriesCapacity_Handler,
+		},
+		{
+			MethodName: "CancelEventSeries",
+			Handler:    _EventService_CancelEventSeries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEventAvailability",
+			Handler:       _EventService_WatchEventAvailability_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "event.proto",
 }